@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/itiky/charge_scheduler/api/grpc/pb"
+	"github.com/itiky/charge_scheduler/common"
+)
+
+const (
+	// watchAgendaRangePadding extends the WatchAgenda period to cover the first / last agenda days (cut at the local midnight).
+	watchAgendaRangePadding = 24 * time.Hour
+)
+
+func (s *Server) AddSingleEvent(ctx context.Context, req *pb.AddSingleEventRequest) (*pb.AddSingleEventResponse, error) {
+	eventType, err := toEventType(req.Type)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	eventStart, err := toTime("start", req.Start)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	eventDur, err := toDuration("duration", req.Duration, 0)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	var warnings common.Warnings
+	if err := s.svc.AddSingleEvent(common.WithWarnings(ctx, &warnings), toChargePointId(req.ChargePointId), eventType, eventStart, eventDur); err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	return &pb.AddSingleEventResponse{Warnings: warnings}, nil
+}
+
+func (s *Server) AddPeriodicEvent(ctx context.Context, req *pb.AddPeriodicEventRequest) (*pb.AddPeriodicEventResponse, error) {
+	eventType, err := toEventType(req.Type)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	eventStart, err := toTime("start", req.Start)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	eventDur, err := toDuration("duration", req.Duration, 0)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	var warnings common.Warnings
+	ctx = common.WithWarnings(ctx, &warnings)
+	if req.Rrule == "" {
+		err = s.svc.AddPeriodicEvent(ctx, toChargePointId(req.ChargePointId), eventType, eventStart, eventDur)
+	} else {
+		err = s.svc.AddPeriodicEventWithRule(ctx, toChargePointId(req.ChargePointId), eventType, eventStart, req.Rrule, eventDur)
+	}
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	return &pb.AddPeriodicEventResponse{Warnings: warnings}, nil
+}
+
+func (s *Server) GetAvailableAgenda(ctx context.Context, req *pb.GetAvailableAgendaRequest) (*pb.AgendaResults, error) {
+	agendaReq, err := newAgendaRequest(req)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	agendas, err := s.svc.GetAvailableAgenda(ctx, agendaReq.ChargePointId, agendaReq.PeriodStart, agendaReq.PeriodDur, agendaReq.ChargeDur)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	return fromAgendaResults(agendas), nil
+}
+
+func (s *Server) GetEvents(ctx context.Context, req *pb.GetEventsRequest) (*pb.GetEventsResponse, error) {
+	periodStart, err := toTime("period_start", req.PeriodStart)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+	periodEnd, err := toTime("period_end", req.PeriodEnd)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	sEvents, pEvents, err := s.svc.GetEvents(ctx, toChargePointId(req.ChargePointId), periodStart, periodEnd)
+	if err != nil {
+		return nil, s.toStatusError(err)
+	}
+
+	resp := &pb.GetEventsResponse{
+		SingleEvents:   make([]*pb.SingleEvent, 0, len(sEvents)),
+		PeriodicEvents: make([]*pb.PeriodicEvent, 0, len(pEvents)),
+	}
+	for _, event := range sEvents {
+		resp.SingleEvents = append(resp.SingleEvents, fromSingleEvent(event))
+	}
+	for _, event := range pEvents {
+		resp.PeriodicEvents = append(resp.PeriodicEvents, fromPeriodicEvent(event))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) WatchAgenda(req *pb.GetAvailableAgendaRequest, stream pb.Scheduler_WatchAgendaServer) error {
+	ctx := stream.Context()
+
+	agendaReq, err := newAgendaRequest(req)
+	if err != nil {
+		return s.toStatusError(err)
+	}
+
+	// Subscribe before the initial agenda is built, so changes made in between are not missed
+	rangeStart := agendaReq.PeriodStart.Add(-watchAgendaRangePadding)
+	rangeEnd := agendaReq.PeriodStart.Add(agendaReq.PeriodDur).Add(watchAgendaRangePadding)
+	notifyCh, err := s.svc.WatchEvents(ctx, agendaReq.ChargePointId, rangeStart, rangeEnd)
+	if err != nil {
+		return s.toStatusError(err)
+	}
+
+	for {
+		agendas, err := s.svc.GetAvailableAgenda(ctx, agendaReq.ChargePointId, agendaReq.PeriodStart, agendaReq.PeriodDur, agendaReq.ChargeDur)
+		if err != nil {
+			return s.toStatusError(err)
+		}
+		if err := stream.Send(fromAgendaResults(agendas)); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-notifyCh:
+			if !ok {
+				return nil
+			}
+		}
+	}
+}
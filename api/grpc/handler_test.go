@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/itiky/charge_scheduler/api/grpc/pb"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServerTestSuite) Test_Events() {
+	t := s.T()
+	ctx := s.ctx
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// fail: wrong inputs
+	{
+		_, err := s.client.AddSingleEvent(ctx, &pb.AddSingleEventRequest{
+			Start:    timestamppb.New(time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC)),
+			Duration: durationpb.New(time.Hour),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.client.AddSingleEvent(ctx, &pb.AddSingleEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_OCCUPIED,
+			Duration: durationpb.New(time.Hour),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.client.AddPeriodicEvent(ctx, &pb.AddPeriodicEventRequest{
+			Type:  pb.EventType_EVENT_TYPE_AVAILABLE,
+			Start: timestamppb.New(time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.client.AddPeriodicEvent(ctx, &pb.AddPeriodicEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_AVAILABLE,
+			Start:    timestamppb.New(time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)),
+			Duration: durationpb.New(4 * time.Hour),
+			Rrule:    "FREQ=UNKNOWN",
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.client.GetEvents(ctx, &pb.GetEventsRequest{
+			PeriodStart: timestamppb.New(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// ok: create
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	// 05.08.2014 (TUE) 14:00 - 18:00 daily
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied
+	{
+		_, err := s.client.AddPeriodicEvent(ctx, &pb.AddPeriodicEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_AVAILABLE,
+			Start:    timestamppb.New(time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)),
+			Duration: durationpb.New(4 * time.Hour),
+		})
+		require.NoError(t, err)
+
+		_, err = s.client.AddPeriodicEvent(ctx, &pb.AddPeriodicEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_AVAILABLE,
+			Start:    timestamppb.New(time.Date(2014, 8, 5, 14, 0, 0, 0, time.UTC)),
+			Duration: durationpb.New(4 * time.Hour),
+			Rrule:    "FREQ=DAILY;COUNT=3",
+		})
+		require.NoError(t, err)
+
+		_, err = s.client.AddSingleEvent(ctx, &pb.AddSingleEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_OCCUPIED,
+			Start:    timestamppb.New(time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC)),
+			Duration: durationpb.New(time.Hour),
+		})
+		require.NoError(t, err)
+	}
+
+	// fail: create intersecting
+	{
+		_, err := s.client.AddSingleEvent(ctx, &pb.AddSingleEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_OCCUPIED,
+			Start:    timestamppb.New(time.Date(2014, 8, 11, 11, 0, 0, 0, time.UTC)),
+			Duration: durationpb.New(time.Hour),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// ok: GetEvents
+	{
+		resp, err := s.client.GetEvents(ctx, &pb.GetEventsRequest{
+			PeriodStart: timestamppb.New(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)),
+			PeriodEnd:   timestamppb.New(time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, resp.SingleEvents, 1)
+		require.Equal(t, schema.DefaultChargePointId, resp.SingleEvents[0].ChargePointId)
+		require.Equal(t, pb.EventType_EVENT_TYPE_OCCUPIED, resp.SingleEvents[0].Type)
+		require.Equal(t, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), resp.SingleEvents[0].Start.AsTime())
+		require.Equal(t, time.Hour, resp.SingleEvents[0].Duration.AsDuration())
+
+		require.Len(t, resp.PeriodicEvents, 2)
+		rrules := []string{resp.PeriodicEvents[0].Rrule, resp.PeriodicEvents[1].Rrule}
+		require.ElementsMatch(t, []string{"FREQ=WEEKLY", "FREQ=DAILY;COUNT=3"}, rrules)
+	}
+
+	// ok: GetAvailableAgenda
+	{
+		resp, err := s.client.GetAvailableAgenda(ctx, &pb.GetAvailableAgendaRequest{
+			PeriodStart:    timestamppb.New(time.Date(2014, 8, 10, 0, 0, 0, 0, time.UTC)),
+			PeriodDuration: durationpb.New(10 * 24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Agendas, 10)
+		require.Equal(t, "2014-08-11", resp.Agendas[1].Date)
+		require.Len(t, resp.Agendas[1].Slots, 6)
+		require.Equal(t, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), resp.Agendas[1].Slots[0].Start.AsTime())
+		require.Equal(t, 30*time.Minute, resp.Agendas[1].Slots[0].Duration.AsDuration())
+	}
+
+	// fail: GetAvailableAgenda: unknown charge point
+	{
+		_, err := s.client.GetAvailableAgenda(ctx, &pb.GetAvailableAgendaRequest{
+			ChargePointId:  1000,
+			PeriodStart:    timestamppb.New(time.Date(2014, 8, 10, 0, 0, 0, 0, time.UTC)),
+			PeriodDuration: durationpb.New(24 * time.Hour),
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (s *ServerTestSuite) Test_WatchAgenda() {
+	t := s.T()
+	require.NoError(t, s.r.StorageRes.Storage.DropData(s.ctx))
+
+	ctx, ctxCancel := context.WithTimeout(s.ctx, 10*time.Second)
+	defer ctxCancel()
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	{
+		require.NoError(t, s.r.Svc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+	}
+
+	// fail: wrong inputs
+	{
+		stream, err := s.client.WatchAgenda(ctx, &pb.GetAvailableAgendaRequest{
+			PeriodStart: timestamppb.New(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)),
+		})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// 11.08.2014 (MON) window
+	stream, err := s.client.WatchAgenda(ctx, &pb.GetAvailableAgendaRequest{
+		PeriodStart:    timestamppb.New(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)),
+		PeriodDuration: durationpb.New(24 * time.Hour),
+		ChargeDuration: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// ok: initial agenda
+	{
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, resp.Agendas, 1)
+		require.Len(t, resp.Agendas[0].Slots, 4)
+	}
+
+	// ok: booking within the window pushes a fresh agenda
+	var bookingId int64
+	{
+		bookingId, err = s.r.Svc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), time.Hour, "driver-42")
+		require.NoError(t, err)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, resp.Agendas, 1)
+		require.Len(t, resp.Agendas[0].Slots, 3)
+		require.Equal(t, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), resp.Agendas[0].Slots[0].Start.AsTime())
+	}
+
+	// ok: events outside the window are not pushed, the booking removal is
+	{
+		_, err := s.client.AddSingleEvent(ctx, &pb.AddSingleEventRequest{
+			Type:     pb.EventType_EVENT_TYPE_OCCUPIED,
+			Start:    timestamppb.New(time.Date(2014, 8, 18, 10, 0, 0, 0, time.UTC)),
+			Duration: durationpb.New(time.Hour),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, s.r.Svc.DeleteSingleEvent(ctx, bookingId, 1))
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Len(t, resp.Agendas, 1)
+		require.Len(t, resp.Agendas[0].Slots, 4)
+	}
+
+	// ok: stream ends once the client cancels it
+	{
+		ctxCancel()
+		_, err := stream.Recv()
+		require.Equal(t, codes.Canceled, status.Code(err))
+	}
+}
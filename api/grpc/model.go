@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/itiky/charge_scheduler/api/grpc/pb"
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// defaultChargeDur is the agenda desired charging duration if not set (matches the CLI default).
+	defaultChargeDur = 30 * time.Minute
+)
+
+// agendaRequest is the parsed pb.GetAvailableAgendaRequest.
+type agendaRequest struct {
+	ChargePointId int64
+	PeriodStart   time.Time
+	PeriodDur     time.Duration
+	ChargeDur     time.Duration
+}
+
+func newAgendaRequest(req *pb.GetAvailableAgendaRequest) (retReq agendaRequest, retErr error) {
+	retReq.ChargePointId = toChargePointId(req.ChargePointId)
+
+	if retReq.PeriodStart, retErr = toTime("period_start", req.PeriodStart); retErr != nil {
+		return
+	}
+	if retReq.PeriodDur, retErr = toDuration("period_duration", req.PeriodDuration, 0); retErr != nil {
+		return
+	}
+	if retReq.PeriodDur <= 0 {
+		retErr = fmt.Errorf("%s: must be GT 0: %w", "period_duration", common.ErrInvalidInput)
+		return
+	}
+	retReq.ChargeDur, retErr = toDuration("charge_duration", req.ChargeDuration, defaultChargeDur)
+
+	return
+}
+
+// toEventType converts the pb.EventType.
+func toEventType(eventType pb.EventType) (schema.SingleEventType, error) {
+	switch eventType {
+	case pb.EventType_EVENT_TYPE_AVAILABLE:
+		return schema.SingleEventTypeAvailable, nil
+	case pb.EventType_EVENT_TYPE_OCCUPIED:
+		return schema.SingleEventTypeOccupied, nil
+	default:
+		return "", fmt.Errorf("%s: unknown: %w", "type", common.ErrInvalidInput)
+	}
+}
+
+// fromEventType converts the schema.SingleEventType.
+func fromEventType(eventType schema.SingleEventType) pb.EventType {
+	switch eventType {
+	case schema.SingleEventTypeAvailable:
+		return pb.EventType_EVENT_TYPE_AVAILABLE
+	case schema.SingleEventTypeOccupied:
+		return pb.EventType_EVENT_TYPE_OCCUPIED
+	default:
+		return pb.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+// toTime converts the required timestamp.
+func toTime(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, fmt.Errorf("%s: nil: %w", field, common.ErrInvalidInput)
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, fmt.Errorf("%s: invalid (%v): %w", field, err, common.ErrInvalidInput)
+	}
+
+	return ts.AsTime(), nil
+}
+
+// toDuration converts the duration (defaultDur if not set).
+func toDuration(field string, dur *durationpb.Duration, defaultDur time.Duration) (time.Duration, error) {
+	if dur == nil {
+		return defaultDur, nil
+	}
+	if err := dur.CheckValid(); err != nil {
+		return 0, fmt.Errorf("%s: invalid (%v): %w", field, err, common.ErrInvalidInput)
+	}
+
+	return dur.AsDuration(), nil
+}
+
+// toChargePointId returns the request charge point ID (schema.DefaultChargePointId if not set).
+func toChargePointId(chargePointId int64) int64 {
+	if chargePointId == 0 {
+		return schema.DefaultChargePointId
+	}
+
+	return chargePointId
+}
+
+// fromSingleEvent converts the schema.SingleEvent.
+func fromSingleEvent(event schema.SingleEvent) *pb.SingleEvent {
+	return &pb.SingleEvent{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          fromEventType(event.Type),
+		Start:         timestamppb.New(event.StartDateTime),
+		Duration:      durationpb.New(event.Duration),
+		OwnerRef:      event.OwnerRef,
+		CreatedAt:     timestamppb.New(event.CreatedAt),
+	}
+}
+
+// fromPeriodicEvent converts the schema.PeriodicEvent.
+func fromPeriodicEvent(event schema.PeriodicEvent) *pb.PeriodicEvent {
+	resp := &pb.PeriodicEvent{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          fromEventType(event.Type),
+		Start:         timestamppb.New(event.Rrule.OrigOptions.Dtstart),
+		Rrule:         event.Rrule.OrigOptions.RRuleString(),
+		Duration:      durationpb.New(event.Duration),
+		CreatedAt:     timestamppb.New(event.CreatedAt),
+	}
+
+	for _, exception := range event.Exceptions {
+		exceptionResp := &pb.PeriodicEventException{
+			Id:              exception.Id,
+			OccurrenceStart: timestamppb.New(exception.OccurrenceStart),
+		}
+		if exception.IsOverride() {
+			exceptionResp.OverrideStart = timestamppb.New(*exception.OverrideStart)
+			exceptionResp.OverrideDuration = durationpb.New(exception.OverrideDuration)
+		}
+		resp.Exceptions = append(resp.Exceptions, exceptionResp)
+	}
+
+	return resp
+}
+
+// fromAgendaResults converts the schema.AgendaResults.
+func fromAgendaResults(agendas schema.AgendaResults) *pb.AgendaResults {
+	resp := &pb.AgendaResults{
+		Agendas: make([]*pb.AgendaResult, 0, len(agendas)),
+	}
+	for _, agenda := range agendas {
+		agendaResp := &pb.AgendaResult{
+			Date:  agenda.Date.Format("2006-01-02"),
+			Slots: make([]*pb.TimeSlot, 0, len(agenda.TimeSlots)),
+		}
+		for _, slot := range agenda.TimeSlots {
+			agendaResp.Slots = append(agendaResp.Slots, &pb.TimeSlot{
+				Start:    timestamppb.New(slot.Start),
+				Duration: durationpb.New(slot.Duration),
+			})
+		}
+		resp.Agendas = append(resp.Agendas, agendaResp)
+	}
+
+	return resp
+}
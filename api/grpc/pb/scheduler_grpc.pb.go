@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SchedulerClient is the client API for Scheduler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SchedulerClient interface {
+	// AddSingleEvent creates a new non-intersecting with existing charge point events single event.
+	AddSingleEvent(ctx context.Context, in *AddSingleEventRequest, opts ...grpc.CallOption) (*AddSingleEventResponse, error)
+	// AddPeriodicEvent creates a new non-intersecting with existing charge point events periodic event (weekly if rrule is empty).
+	AddPeriodicEvent(ctx context.Context, in *AddPeriodicEventRequest, opts ...grpc.CallOption) (*AddPeriodicEventResponse, error)
+	// GetAvailableAgenda returns available charge point charging slots for the period and the desired charging duration.
+	GetAvailableAgenda(ctx context.Context, in *GetAvailableAgendaRequest, opts ...grpc.CallOption) (*AgendaResults, error)
+	// GetEvents returns charge point single events registered within the range and all periodic events.
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// WatchAgenda sends the current agenda and a fresh one whenever an event touching the period is created / updated / removed.
+	WatchAgenda(ctx context.Context, in *GetAvailableAgendaRequest, opts ...grpc.CallOption) (Scheduler_WatchAgendaClient, error)
+}
+
+type schedulerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulerClient(cc grpc.ClientConnInterface) SchedulerClient {
+	return &schedulerClient{cc}
+}
+
+func (c *schedulerClient) AddSingleEvent(ctx context.Context, in *AddSingleEventRequest, opts ...grpc.CallOption) (*AddSingleEventResponse, error) {
+	out := new(AddSingleEventResponse)
+	err := c.cc.Invoke(ctx, "/charge_scheduler.v1.Scheduler/AddSingleEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) AddPeriodicEvent(ctx context.Context, in *AddPeriodicEventRequest, opts ...grpc.CallOption) (*AddPeriodicEventResponse, error) {
+	out := new(AddPeriodicEventResponse)
+	err := c.cc.Invoke(ctx, "/charge_scheduler.v1.Scheduler/AddPeriodicEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetAvailableAgenda(ctx context.Context, in *GetAvailableAgendaRequest, opts ...grpc.CallOption) (*AgendaResults, error) {
+	out := new(AgendaResults)
+	err := c.cc.Invoke(ctx, "/charge_scheduler.v1.Scheduler/GetAvailableAgenda", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/charge_scheduler.v1.Scheduler/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) WatchAgenda(ctx context.Context, in *GetAvailableAgendaRequest, opts ...grpc.CallOption) (Scheduler_WatchAgendaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Scheduler_ServiceDesc.Streams[0], "/charge_scheduler.v1.Scheduler/WatchAgenda", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schedulerWatchAgendaClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Scheduler_WatchAgendaClient interface {
+	Recv() (*AgendaResults, error)
+	grpc.ClientStream
+}
+
+type schedulerWatchAgendaClient struct {
+	grpc.ClientStream
+}
+
+func (x *schedulerWatchAgendaClient) Recv() (*AgendaResults, error) {
+	m := new(AgendaResults)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SchedulerServer is the server API for Scheduler service.
+// All implementations must embed UnimplementedSchedulerServer
+// for forward compatibility
+type SchedulerServer interface {
+	// AddSingleEvent creates a new non-intersecting with existing charge point events single event.
+	AddSingleEvent(context.Context, *AddSingleEventRequest) (*AddSingleEventResponse, error)
+	// AddPeriodicEvent creates a new non-intersecting with existing charge point events periodic event (weekly if rrule is empty).
+	AddPeriodicEvent(context.Context, *AddPeriodicEventRequest) (*AddPeriodicEventResponse, error)
+	// GetAvailableAgenda returns available charge point charging slots for the period and the desired charging duration.
+	GetAvailableAgenda(context.Context, *GetAvailableAgendaRequest) (*AgendaResults, error)
+	// GetEvents returns charge point single events registered within the range and all periodic events.
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// WatchAgenda sends the current agenda and a fresh one whenever an event touching the period is created / updated / removed.
+	WatchAgenda(*GetAvailableAgendaRequest, Scheduler_WatchAgendaServer) error
+	mustEmbedUnimplementedSchedulerServer()
+}
+
+// UnimplementedSchedulerServer must be embedded to have forward compatible implementations.
+type UnimplementedSchedulerServer struct {
+}
+
+func (UnimplementedSchedulerServer) AddSingleEvent(context.Context, *AddSingleEventRequest) (*AddSingleEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSingleEvent not implemented")
+}
+func (UnimplementedSchedulerServer) AddPeriodicEvent(context.Context, *AddPeriodicEventRequest) (*AddPeriodicEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeriodicEvent not implemented")
+}
+func (UnimplementedSchedulerServer) GetAvailableAgenda(context.Context, *GetAvailableAgendaRequest) (*AgendaResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableAgenda not implemented")
+}
+func (UnimplementedSchedulerServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedSchedulerServer) WatchAgenda(*GetAvailableAgendaRequest, Scheduler_WatchAgendaServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAgenda not implemented")
+}
+func (UnimplementedSchedulerServer) mustEmbedUnimplementedSchedulerServer() {}
+
+// UnsafeSchedulerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchedulerServer will
+// result in compilation errors.
+type UnsafeSchedulerServer interface {
+	mustEmbedUnimplementedSchedulerServer()
+}
+
+func RegisterSchedulerServer(s grpc.ServiceRegistrar, srv SchedulerServer) {
+	s.RegisterService(&Scheduler_ServiceDesc, srv)
+}
+
+func _Scheduler_AddSingleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSingleEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).AddSingleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charge_scheduler.v1.Scheduler/AddSingleEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AddSingleEvent(ctx, req.(*AddSingleEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_AddPeriodicEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeriodicEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).AddPeriodicEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charge_scheduler.v1.Scheduler/AddPeriodicEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).AddPeriodicEvent(ctx, req.(*AddPeriodicEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetAvailableAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetAvailableAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charge_scheduler.v1.Scheduler/GetAvailableAgenda",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetAvailableAgenda(ctx, req.(*GetAvailableAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/charge_scheduler.v1.Scheduler/GetEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_WatchAgenda_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAvailableAgendaRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchedulerServer).WatchAgenda(m, &schedulerWatchAgendaServer{stream})
+}
+
+type Scheduler_WatchAgendaServer interface {
+	Send(*AgendaResults) error
+	grpc.ServerStream
+}
+
+type schedulerWatchAgendaServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulerWatchAgendaServer) Send(m *AgendaResults) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Scheduler_ServiceDesc is the grpc.ServiceDesc for Scheduler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Scheduler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "charge_scheduler.v1.Scheduler",
+	HandlerType: (*SchedulerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSingleEvent",
+			Handler:    _Scheduler_AddSingleEvent_Handler,
+		},
+		{
+			MethodName: "AddPeriodicEvent",
+			Handler:    _Scheduler_AddPeriodicEvent_Handler,
+		},
+		{
+			MethodName: "GetAvailableAgenda",
+			Handler:    _Scheduler_GetAvailableAgenda_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _Scheduler_GetEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAgenda",
+			Handler:       _Scheduler_WatchAgenda_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "scheduler.proto",
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/rs/zerolog"
+	googleGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/itiky/charge_scheduler/api/grpc/pb"
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/service/scheduler"
+)
+
+// actorMetadataKey is the request metadata key carrying the events history actor.
+const actorMetadataKey = "x-actor"
+
+// Server is a gRPC API server for the scheduler.Scheduler service.
+type Server struct {
+	pb.UnimplementedSchedulerServer
+
+	logger  zerolog.Logger
+	svc     scheduler.Scheduler
+	grpcSrv *googleGrpc.Server
+}
+
+// Serve accepts connections on the listener until Shutdown is called (nil is returned in that case).
+func (s *Server) Serve(listener net.Listener) error {
+	s.logger.Info().Str("addr", listener.Addr().String()).Msg("server started")
+	if err := s.grpcSrv.Serve(listener); err != nil && !errors.Is(err, googleGrpc.ErrServerStopped) {
+		return fmt.Errorf("grpcSrv.Serve: %w", err)
+	}
+
+	return nil
+}
+
+// ListenAndServe listens on the addr and serves requests until Shutdown is called (nil is returned in that case).
+func (s *Server) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("net.Listen(%s): %w", addr, err)
+	}
+
+	return s.Serve(listener)
+}
+
+// Shutdown gracefully stops the server waiting for active RPCs to finish (until ctx is done, streams are cancelled then).
+func (s *Server) Shutdown(ctx context.Context) error {
+	stoppedCh := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(stoppedCh)
+	}()
+
+	select {
+	case <-stoppedCh:
+	case <-ctx.Done():
+		s.grpcSrv.Stop()
+		<-stoppedCh
+	}
+	s.logger.Info().Msg("server stopped")
+
+	return nil
+}
+
+// setActor passes the actorMetadataKey value to the request context (recorded to the events history).
+func setActor(ctx context.Context, req interface{}, _ *googleGrpc.UnaryServerInfo, handler googleGrpc.UnaryHandler) (interface{}, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(actorMetadataKey); len(values) > 0 {
+			ctx = common.WithActor(ctx, values[0])
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+// toStatusError maps the service error to the gRPC status error.
+// Internal errors details are logged and not exposed.
+func (s *Server) toStatusError(err error) error {
+	switch {
+	case errors.Is(err, common.ErrInvalidInput):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, common.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, common.ErrSlotUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	s.logger.Error().Err(err).Msg("request failed")
+
+	return status.Error(codes.Internal, "internal error")
+}
+
+func NewServer(logger zerolog.Logger, svc scheduler.Scheduler) (*Server, error) {
+	if svc == nil {
+		return nil, fmt.Errorf("%s: nil", "svc")
+	}
+
+	s := &Server{
+		logger:  logger.With().Str("component", "gRPC server").Logger(),
+		svc:     svc,
+		grpcSrv: googleGrpc.NewServer(googleGrpc.UnaryInterceptor(setActor)),
+	}
+	pb.RegisterSchedulerServer(s.grpcSrv, s)
+
+	return s, nil
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	googleGrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/itiky/charge_scheduler/api/grpc/pb"
+	"github.com/itiky/charge_scheduler/service/scheduler/testutil"
+	v1 "github.com/itiky/charge_scheduler/service/scheduler/v1"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+const (
+	bufConnSize = 1 << 20
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	baseSt *sqlite_base.SQLiteBase
+	r      *testutil.SchedulerServiceTestResource
+	server *Server
+	conn   *googleGrpc.ClientConn
+	client pb.SchedulerClient
+}
+
+func (s *ServerTestSuite) SetupSuite() {
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(s.T().TempDir())
+	if err != nil {
+		panic(fmt.Errorf("base storage init: %w", err))
+	}
+
+	r, err := v1.NewTestResource(baseSt)
+	if err != nil {
+		panic(fmt.Errorf("resource init: %w", err))
+	}
+
+	server, err := NewServer(zerolog.Nop(), r.Svc)
+	if err != nil {
+		panic(fmt.Errorf("server init: %w", err))
+	}
+
+	// In-process listener
+	listener := bufconn.Listen(bufConnSize)
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			panic(fmt.Errorf("server serve: %w", err))
+		}
+	}()
+
+	conn, err := googleGrpc.DialContext(context.TODO(), "bufnet",
+		googleGrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		googleGrpc.WithInsecure(),
+	)
+	if err != nil {
+		panic(fmt.Errorf("client dial: %w", err))
+	}
+
+	s.ctx = context.TODO()
+	s.baseSt = baseSt
+	s.r = r
+	s.server = server
+	s.conn = conn
+	s.client = pb.NewSchedulerClient(conn)
+}
+
+// nolint:errcheck
+func (s *ServerTestSuite) TearDownSuite() {
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	if s.server != nil {
+		s.server.Shutdown(context.TODO())
+	}
+	if s.baseSt != nil {
+		s.baseSt.Close()
+	}
+}
+
+func TestSuite_GRPCServer(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
@@ -0,0 +1,369 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+// contentLine is a parsed (unfolded) "NAME;PARAM=VALUE:VALUE" line.
+type contentLine struct {
+	Num    int
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// durationRe matches the RFC 5545 DURATION value.
+var durationRe = regexp.MustCompile(`^([+-]?)P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseCalendar parses VEVENTs of the iCalendar stream.
+// Floating (no TZID / UTC) dateTimes and dates are defined within the defaultLoc.
+// Invalid VEVENTs are returned with the Err set, RECURRENCE-ID VEVENTs are attached to the recurring ones (by UID).
+func ParseCalendar(r io.Reader, defaultLoc *time.Location) ([]Event, error) {
+	lines, err := readContentLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events      []Event
+		eventLines  []contentLine
+		inEvent     bool
+		nestedDepth int
+	)
+	for _, line := range lines {
+		switch {
+		case line.Name == "BEGIN" && strings.EqualFold(line.Value, "VEVENT") && !inEvent:
+			inEvent, eventLines = true, []contentLine{line}
+		case !inEvent:
+			continue
+		case line.Name == "BEGIN":
+			// Nested components (VALARM) are skipped
+			nestedDepth++
+		case line.Name == "END" && nestedDepth > 0:
+			nestedDepth--
+		case line.Name == "END" && strings.EqualFold(line.Value, "VEVENT"):
+			inEvent = false
+			events = append(events, parseEvent(eventLines, defaultLoc))
+		case nestedDepth == 0:
+			eventLines = append(eventLines, line)
+		}
+	}
+	if inEvent {
+		return nil, fmt.Errorf("line %d: VEVENT is not closed: %w", eventLines[0].Num, common.ErrInvalidInput)
+	}
+
+	return attachOverrides(events), nil
+}
+
+// attachOverrides moves RECURRENCE-ID events to the recurring ones with the same UID.
+func attachOverrides(events []Event) []Event {
+	recurringIdxs := make(map[string]int)
+	for i, event := range events {
+		if event.Err == nil && event.IsRecurring() && event.UID != "" {
+			recurringIdxs[event.UID] = i
+		}
+	}
+
+	results := make([]Event, 0, len(events))
+	overrides := make(map[int][]Event)
+	for i, event := range events {
+		if event.Err != nil || event.RecurrenceId.IsZero() {
+			continue
+		}
+
+		idx, found := recurringIdxs[event.UID]
+		if !found {
+			event.Err = fmt.Errorf("RECURRENCE-ID: recurring VEVENT with the same UID not found: %w", common.ErrInvalidInput)
+			events[i] = event
+			continue
+		}
+		overrides[idx] = append(overrides[idx], event)
+	}
+
+	for i, event := range events {
+		if event.Err == nil && !event.RecurrenceId.IsZero() {
+			continue
+		}
+		event.Overrides = overrides[i]
+		results = append(results, event)
+	}
+
+	return results
+}
+
+// parseEvent parses the VEVENT lines (BEGIN line included).
+func parseEvent(lines []contentLine, defaultLoc *time.Location) (retEvent Event) {
+	retEvent.Line = lines[0].Num
+
+	var (
+		eventEnd     time.Time
+		durationStr  string
+		allDay       bool
+		categoryType string
+	)
+	for _, line := range lines[1:] {
+		var err error
+		switch line.Name {
+		case "UID":
+			retEvent.UID = line.Value
+		case typeProperty:
+			eventType, ok := parseEventType(unescapeText(line.Value))
+			if !ok {
+				err = fmt.Errorf("unknown type %q: %w", line.Value, common.ErrInvalidInput)
+				break
+			}
+			retEvent.Type = eventType
+		case "CATEGORIES":
+			for _, category := range splitList(line.Value) {
+				if eventType, ok := parseEventType(unescapeText(category)); ok {
+					categoryType = eventType.String()
+				}
+			}
+		case "DTSTART":
+			retEvent.Start, allDay, err = parseDateTime(line, defaultLoc)
+		case "DTEND":
+			eventEnd, _, err = parseDateTime(line, defaultLoc)
+		case "DURATION":
+			durationStr = line.Value
+		case "RRULE":
+			retEvent.RRule = line.Value
+		case "EXDATE":
+			for _, value := range splitList(line.Value) {
+				valueLine := line
+				valueLine.Value = value
+
+				exDate, _, parseErr := parseDateTime(valueLine, defaultLoc)
+				if parseErr != nil {
+					err = parseErr
+					break
+				}
+				retEvent.ExDates = append(retEvent.ExDates, exDate)
+			}
+		case "RECURRENCE-ID":
+			retEvent.RecurrenceId, _, err = parseDateTime(line, defaultLoc)
+		}
+		if err != nil {
+			retEvent.Err = fmt.Errorf("line %d: %s: %w", line.Num, line.Name, err)
+			return
+		}
+	}
+
+	if retEvent.Type == "" && categoryType != "" {
+		retEvent.Type, _ = parseEventType(categoryType)
+	}
+
+	// Checks
+	if retEvent.Start.IsZero() {
+		retEvent.Err = fmt.Errorf("%s: not set: %w", "DTSTART", common.ErrInvalidInput)
+		return
+	}
+	switch {
+	case !eventEnd.IsZero() && durationStr != "":
+		retEvent.Err = fmt.Errorf("%s / %s: only one must be set: %w", "DTEND", "DURATION", common.ErrInvalidInput)
+		return
+	case !eventEnd.IsZero():
+		retEvent.Duration = eventEnd.Sub(retEvent.Start)
+	case durationStr != "":
+		dur, err := parseDuration(retEvent.Start, durationStr)
+		if err != nil {
+			retEvent.Err = fmt.Errorf("%s: %w", "DURATION", err)
+			return
+		}
+		retEvent.Duration = dur
+	case allDay:
+		// RFC 5545: all-day event without DTEND / DURATION lasts one day
+		retEvent.Duration = retEvent.Start.AddDate(0, 0, 1).Sub(retEvent.Start)
+	default:
+		retEvent.Err = fmt.Errorf("%s / %s: not set: %w", "DTEND", "DURATION", common.ErrInvalidInput)
+		return
+	}
+	if retEvent.RRule != "" && !retEvent.RecurrenceId.IsZero() {
+		retEvent.Err = fmt.Errorf("%s / %s: only one must be set: %w", "RRULE", "RECURRENCE-ID", common.ErrInvalidInput)
+		return
+	}
+
+	return
+}
+
+// readContentLines reads and unfolds the content lines.
+func readContentLines(r io.Reader) ([]contentLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		lines      []contentLine
+		rawLine    strings.Builder
+		rawLineNum int
+	)
+	flush := func() error {
+		if rawLine.Len() == 0 {
+			return nil
+		}
+
+		line, err := parseContentLine(rawLineNum, rawLine.String())
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line)
+		rawLine.Reset()
+
+		return nil
+	}
+
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+
+		// Folded line continuation
+		if strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t") {
+			rawLine.WriteString(text[1:])
+			continue
+		}
+
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		rawLine.WriteString(text)
+		rawLineNum = lineNum
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// parseContentLine parses the "NAME;PARAM=VALUE;PARAM="QUOTED":VALUE" line.
+func parseContentLine(num int, text string) (contentLine, error) {
+	line := contentLine{
+		Num:    num,
+		Params: make(map[string]string),
+	}
+
+	// Value starts after the first not quoted colon
+	inQuotes, valueIdx := false, -1
+	for i, c := range text {
+		if c == '"' {
+			inQuotes = !inQuotes
+		}
+		if c == ':' && !inQuotes {
+			valueIdx = i
+			break
+		}
+	}
+	if valueIdx < 0 {
+		return contentLine{}, fmt.Errorf("line %d: value not found: %w", num, common.ErrInvalidInput)
+	}
+	line.Value = text[valueIdx+1:]
+
+	parts := strings.Split(text[:valueIdx], ";")
+	line.Name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return contentLine{}, fmt.Errorf("line %d: invalid param %q: %w", num, param, common.ErrInvalidInput)
+		}
+		line.Params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+	}
+
+	return line, nil
+}
+
+// parseDateTime parses the DATE / DATE-TIME value (UTC, TZID or floating within the defaultLoc).
+func parseDateTime(line contentLine, defaultLoc *time.Location) (retTime time.Time, retAllDay bool, retErr error) {
+	loc := defaultLoc
+	if tzId := line.Params["TZID"]; tzId != "" {
+		tzLoc, err := time.LoadLocation(strings.TrimPrefix(tzId, "/"))
+		if err != nil {
+			retErr = fmt.Errorf("TZID %q: unknown IANA time zone: %w", tzId, common.ErrInvalidInput)
+			return
+		}
+		loc = tzLoc
+	}
+
+	value := line.Value
+	switch {
+	case strings.EqualFold(line.Params["VALUE"], "DATE") || len(value) == len(dateFmt):
+		retTime, retErr = time.ParseInLocation(dateFmt, value, loc)
+		retAllDay = true
+	case strings.HasSuffix(value, "Z"):
+		retTime, retErr = time.Parse(utcDateTimeFmt, value)
+	default:
+		retTime, retErr = time.ParseInLocation(localDateTimeFmt, value, loc)
+	}
+	if retErr != nil {
+		retErr = fmt.Errorf("invalid DATE / DATE-TIME %q: %w", value, common.ErrInvalidInput)
+	}
+
+	return
+}
+
+// parseDuration parses the RFC 5545 DURATION value ("P1DT2H30M", "PT90M", "P1W") starting from eventStart.
+// Weeks and days are nominal (calendar days within the eventStart location), hours / minutes / seconds are exact.
+func parseDuration(eventStart time.Time, value string) (time.Duration, error) {
+	match := durationRe.FindStringSubmatch(value)
+	if match == nil || strings.HasSuffix(value, "P") || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid %q: %w", value, common.ErrInvalidInput)
+	}
+
+	// [weeks, days, hours, minutes, seconds]
+	var units [5]int
+	for i := range units {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid %q: %w", value, common.ErrInvalidInput)
+		}
+		units[i] = n
+	}
+
+	sign := 1
+	if match[1] == "-" {
+		sign = -1
+	}
+	eventEnd := eventStart.
+		AddDate(0, 0, sign*(7*units[0]+units[1])).
+		Add(time.Duration(sign) * (time.Duration(units[2])*time.Hour + time.Duration(units[3])*time.Minute + time.Duration(units[4])*time.Second))
+
+	return eventEnd.Sub(eventStart), nil
+}
+
+// splitList splits the comma separated value (escaped commas are kept).
+func splitList(value string) []string {
+	var (
+		items []string
+		item  strings.Builder
+	)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			item.WriteByte(value[i])
+			item.WriteByte(value[i+1])
+			i++
+		case value[i] == ',':
+			items = append(items, item.String())
+			item.Reset()
+		default:
+			item.WriteByte(value[i])
+		}
+	}
+
+	return append(items, item.String())
+}
+
+// unescapeText unescapes the TEXT value.
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n").Replace(value)
+}
@@ -0,0 +1,148 @@
+package ics
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ICSTestSuite) Test_ParseCalendar() {
+	t := s.T()
+
+	berlinLoc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// fail: not closed VEVENT
+	{
+		_, err := ParseCalendar(strings.NewReader("BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\n"), time.UTC)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok
+	calendar := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		// Recurring with folded RRULE and EXDATE list
+		"BEGIN:VEVENT",
+		"UID:weekly@test",
+		"DTSTART;TZID=Europe/Berlin:20140804T093000",
+		"DTEND;TZID=Europe/Berlin:20140804T133000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO",
+		" ,TU",
+		"EXDATE;TZID=Europe/Berlin:20140811T093000,20140812T093000",
+		"X-CHARGE-SCHEDULER-TYPE:Available",
+		"BEGIN:VALARM",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"END:VEVENT",
+		// Floating with nominal day DURATION over DST end, type from CATEGORIES
+		"BEGIN:VEVENT",
+		"UID:single@test",
+		"DTSTART:20141025T120000",
+		"DURATION:P1DT1H",
+		"CATEGORIES:Maintenance,occupied",
+		"END:VEVENT",
+		// Moved occurrence
+		"BEGIN:VEVENT",
+		"UID:weekly@test",
+		"RECURRENCE-ID:20140818T073000Z",
+		"DTSTART:20140818T100000Z",
+		"DURATION:PT2H",
+		"END:VEVENT",
+		// All-day, no type
+		"BEGIN:VEVENT",
+		"UID:day@test",
+		`SUMMARY:Holiday\, closed`,
+		"DTSTART;VALUE=DATE:20140901",
+		"END:VEVENT",
+		// Invalid ones
+		"BEGIN:VEVENT",
+		"UID:no-end@test",
+		"DTSTART:20140901T100000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:unknown-tz@test",
+		"DTSTART;TZID=Mars/Base:20140901T100000",
+		"DURATION:PT1H",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:orphan@test",
+		"RECURRENCE-ID:20140818T073000Z",
+		"DTSTART:20140818T100000Z",
+		"DURATION:PT1H",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := ParseCalendar(strings.NewReader(calendar), berlinLoc)
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+
+	{
+		event := events[0]
+		require.NoError(t, event.Err)
+		require.Equal(t, 3, event.Line)
+		require.Equal(t, "weekly@test", event.UID)
+		require.Equal(t, schema.SingleEventTypeAvailable, event.Type)
+		require.True(t, event.Start.Equal(time.Date(2014, 8, 4, 9, 30, 0, 0, berlinLoc)))
+		require.Equal(t, 4*time.Hour, event.Duration)
+		require.Equal(t, "FREQ=WEEKLY;BYDAY=MO,TU", event.RRule)
+		require.Len(t, event.ExDates, 2)
+		require.True(t, event.ExDates[1].Equal(time.Date(2014, 8, 12, 9, 30, 0, 0, berlinLoc)))
+
+		require.Len(t, event.Overrides, 1)
+		require.True(t, event.Overrides[0].RecurrenceId.Equal(time.Date(2014, 8, 18, 7, 30, 0, 0, time.UTC)))
+		require.True(t, event.Overrides[0].Start.Equal(time.Date(2014, 8, 18, 10, 0, 0, 0, time.UTC)))
+		require.Equal(t, 2*time.Hour, event.Overrides[0].Duration)
+	}
+	{
+		event := events[1]
+		require.NoError(t, event.Err)
+		require.Equal(t, schema.SingleEventTypeOccupied, event.Type)
+		require.True(t, event.Start.Equal(time.Date(2014, 10, 25, 12, 0, 0, 0, berlinLoc)))
+		require.Equal(t, 26*time.Hour, event.Duration)
+		require.False(t, event.IsRecurring())
+	}
+	{
+		event := events[2]
+		require.NoError(t, event.Err)
+		require.Empty(t, event.Type)
+		require.True(t, event.Start.Equal(time.Date(2014, 9, 1, 0, 0, 0, 0, berlinLoc)))
+		require.Equal(t, 24*time.Hour, event.Duration)
+	}
+	for _, event := range events[3:] {
+		require.True(t, errors.Is(event.Err, common.ErrInvalidInput), event.Name())
+	}
+}
+
+func (s *ICSTestSuite) Test_parseDuration() {
+	t := s.T()
+
+	berlinLoc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	eventStart := time.Date(2014, 10, 25, 12, 0, 0, 0, berlinLoc)
+
+	// ok
+	for value, expected := range map[string]time.Duration{
+		"PT90M":     90 * time.Minute,
+		"PT1H30M5S": time.Hour + 30*time.Minute + 5*time.Second,
+		"P1D":       25 * time.Hour,
+		"P1W":       7*24*time.Hour + time.Hour,
+		"-PT1H":     -time.Hour,
+	} {
+		dur, err := parseDuration(eventStart, value)
+		require.NoError(t, err, value)
+		require.Equal(t, expected, dur, value)
+	}
+
+	// fail
+	for _, value := range []string{"", "P", "PT", "1H", "PT1H1D", "P1.5D"} {
+		_, err := parseDuration(eventStart, value)
+		require.True(t, errors.Is(err, common.ErrInvalidInput), value)
+	}
+}
@@ -0,0 +1,174 @@
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// maxLineOctets is the RFC 5545 content line length limit (longer lines are folded).
+	maxLineOctets = 75
+)
+
+// calendarWriter writes folded CRLF content lines.
+type calendarWriter struct {
+	w   *bufio.Writer
+	loc *time.Location
+}
+
+// WriteCalendar writes charge point events as an iCalendar VCALENDAR stream.
+// DateTimes are written within the charge point loc (TZID, VTIMEZONE is not included) or in UTC.
+// Periodic event skipped occurrences are written as EXDATEs, moved ones as RECURRENCE-ID VEVENTs with the same UID.
+func WriteCalendar(w io.Writer, loc *time.Location, sEvents []schema.SingleEvent, pEvents []schema.PeriodicEvent) error {
+	cw := calendarWriter{
+		w:   bufio.NewWriter(w),
+		loc: loc,
+	}
+
+	cw.writeLine("BEGIN", "VCALENDAR")
+	cw.writeLine("VERSION", "2.0")
+	cw.writeLine("PRODID", prodId)
+	cw.writeLine("CALSCALE", "GREGORIAN")
+	for _, event := range pEvents {
+		cw.writePeriodicEvent(event)
+	}
+	for _, event := range sEvents {
+		cw.writeSingleEvent(event)
+	}
+	cw.writeLine("END", "VCALENDAR")
+
+	if err := cw.w.Flush(); err != nil {
+		return fmt.Errorf("writing: %w", err)
+	}
+
+	return nil
+}
+
+// writeSingleEvent writes the schema.SingleEvent VEVENT.
+func (cw calendarWriter) writeSingleEvent(event schema.SingleEvent) {
+	cw.writeLine("BEGIN", "VEVENT")
+	cw.writeLine("UID", fmt.Sprintf("single-%d@%s", event.Id, uidDomain))
+	cw.writeLine("DTSTAMP", event.CreatedAt.UTC().Format(utcDateTimeFmt))
+	cw.writeDateTime("DTSTART", event.StartDateTime)
+	cw.writeLine("DURATION", formatDuration(event.Duration))
+	cw.writeEventType(event.Type)
+	cw.writeLine("END", "VEVENT")
+}
+
+// writePeriodicEvent writes the schema.PeriodicEvent VEVENT and its moved occurrences VEVENTs.
+func (cw calendarWriter) writePeriodicEvent(event schema.PeriodicEvent) {
+	uid := fmt.Sprintf("periodic-%d@%s", event.Id, uidDomain)
+
+	cw.writeLine("BEGIN", "VEVENT")
+	cw.writeLine("UID", uid)
+	cw.writeLine("DTSTAMP", event.CreatedAt.UTC().Format(utcDateTimeFmt))
+	cw.writeDateTime("DTSTART", event.Rrule.OrigOptions.Dtstart)
+	cw.writeLine("DURATION", formatDuration(event.Duration))
+	cw.writeLine("RRULE", event.Rrule.OrigOptions.RRuleString())
+	for _, exception := range event.Exceptions {
+		if !exception.IsOverride() {
+			cw.writeDateTime("EXDATE", exception.OccurrenceStart)
+		}
+	}
+	cw.writeEventType(event.Type)
+	cw.writeLine("END", "VEVENT")
+
+	for _, exception := range event.Exceptions {
+		if !exception.IsOverride() {
+			continue
+		}
+
+		cw.writeLine("BEGIN", "VEVENT")
+		cw.writeLine("UID", uid)
+		cw.writeLine("DTSTAMP", exception.CreatedAt.UTC().Format(utcDateTimeFmt))
+		cw.writeDateTime("RECURRENCE-ID", exception.OccurrenceStart)
+		cw.writeDateTime("DTSTART", *exception.OverrideStart)
+		cw.writeLine("DURATION", formatDuration(exception.OverrideDuration))
+		cw.writeEventType(event.Type)
+		cw.writeLine("END", "VEVENT")
+	}
+}
+
+// writeEventType writes the event type properties.
+// Available windows are transparent (do not block the calendar free / busy time), occupied ones are opaque.
+func (cw calendarWriter) writeEventType(eventType schema.SingleEventType) {
+	transp := "OPAQUE"
+	if eventType == schema.SingleEventTypeAvailable {
+		transp = "TRANSPARENT"
+	}
+
+	cw.writeLine("SUMMARY", escapeText(eventType.String()))
+	cw.writeLine("CATEGORIES", escapeText(eventType.String()))
+	cw.writeLine(typeProperty, eventType.String())
+	cw.writeLine("TRANSP", transp)
+}
+
+// writeDateTime writes the DATE-TIME property within the calendar location (UTC dateTimes use the "Z" form).
+func (cw calendarWriter) writeDateTime(name string, t time.Time) {
+	t = t.In(cw.loc)
+	if cw.loc == time.UTC {
+		cw.writeLine(name, t.Format(utcDateTimeFmt))
+		return
+	}
+
+	cw.writeLine(fmt.Sprintf("%s;TZID=%s", name, cw.loc.String()), t.Format(localDateTimeFmt))
+}
+
+// writeLine writes the content line folding it by maxLineOctets (UTF-8 sequences are not split).
+// Buffered writer errors are sticky and reported by the Flush call.
+func (cw calendarWriter) writeLine(name, value string) {
+	line := name + ":" + value
+
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		cw.w.WriteString(line[:cut]) // nolint:errcheck
+		cw.w.WriteString("\r\n ")    // nolint:errcheck
+		line = line[cut:]
+		// Continuation lines start with a space
+		limit = maxLineOctets - 1
+	}
+	cw.w.WriteString(line)   // nolint:errcheck
+	cw.w.WriteString("\r\n") // nolint:errcheck
+}
+
+// formatDuration formats the exact duration as an RFC 5545 DURATION value (nominal days / weeks are not used).
+func formatDuration(dur time.Duration) string {
+	str := strings.Builder{}
+	if dur < 0 {
+		str.WriteString("-")
+		dur = -dur
+	}
+	str.WriteString("PT")
+
+	hours, minutes, seconds := int64(dur/time.Hour), int64(dur%time.Hour/time.Minute), int64(dur%time.Minute/time.Second)
+	if hours > 0 {
+		str.WriteString(fmt.Sprintf("%dH", hours))
+	}
+	if minutes > 0 {
+		str.WriteString(fmt.Sprintf("%dM", minutes))
+	}
+	if seconds > 0 || (hours == 0 && minutes == 0) {
+		str.WriteString(fmt.Sprintf("%dS", seconds))
+	}
+
+	return str.String()
+}
+
+// escapeText escapes the TEXT value.
+func escapeText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\n", `\n`).Replace(value)
+}
+
+// isRuneStart checks if the byte is not a UTF-8 continuation one.
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
@@ -0,0 +1,68 @@
+package ics
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// prodId is the exported calendar PRODID.
+	prodId = "-//itiky//charge_scheduler//EN"
+	// typeProperty is the X- property storing schema.SingleEventType (CATEGORIES is also set for calendar apps).
+	typeProperty = "X-CHARGE-SCHEDULER-TYPE"
+	// uidDomain is the exported VEVENT UID suffix.
+	uidDomain = "charge-scheduler"
+
+	dateFmt          = "20060102"
+	localDateTimeFmt = "20060102T150405"
+	utcDateTimeFmt   = "20060102T150405Z"
+)
+
+// Event is a parsed VEVENT.
+// Recurring events have a non-empty RRule, Overrides are the RECURRENCE-ID VEVENTs with the same UID.
+type Event struct {
+	// Line is the BEGIN:VEVENT line number (for reports).
+	Line int
+	UID  string
+	// Type is defined by the X-CHARGE-SCHEDULER-TYPE property or CATEGORIES (empty if not set).
+	Type     schema.SingleEventType
+	Start    time.Time
+	Duration time.Duration
+	// RRule is an RFC 5545 RRULE without DTSTART.
+	RRule string
+	// ExDates are skipped occurrences starts.
+	ExDates []time.Time
+	// RecurrenceId is the original occurrence start for moved occurrences (zero otherwise).
+	RecurrenceId time.Time
+	Overrides    []Event
+	// Err is set if the VEVENT can't be parsed.
+	Err error
+}
+
+// IsRecurring checks if the event is a periodic one.
+func (e Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// Name returns the event description for reports.
+func (e Event) Name() string {
+	if e.UID == "" {
+		return fmt.Sprintf("VEVENT (line %d)", e.Line)
+	}
+
+	return fmt.Sprintf("VEVENT %s (line %d)", e.UID, e.Line)
+}
+
+// parseEventType parses the schema.SingleEventType case-insensitively.
+func parseEventType(value string) (schema.SingleEventType, bool) {
+	for _, eventType := range []schema.SingleEventType{schema.SingleEventTypeAvailable, schema.SingleEventTypeOccupied} {
+		if strings.EqualFold(strings.TrimSpace(value), eventType.String()) {
+			return eventType, true
+		}
+	}
+
+	return "", false
+}
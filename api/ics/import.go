@@ -0,0 +1,231 @@
+package ics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/service/scheduler"
+)
+
+type (
+	// ImportResult is a single VEVENT import result.
+	ImportResult struct {
+		Event  Event
+		Status ImportStatus
+		// Err is set for the ImportStatusFailed status.
+		Err error
+		// Warnings are the created event non-critical issues (e.g. not covered by availability).
+		Warnings common.Warnings
+		// ExceptionErrs are the created recurring event EXDATE failures (the event is kept without those exceptions).
+		ExceptionErrs []error
+	}
+
+	ImportStatus string
+)
+
+const (
+	ImportStatusCreated ImportStatus = "created"
+	// ImportStatusExists is set if the same event already exists (repeated import).
+	ImportStatusExists ImportStatus = "exists"
+	ImportStatusFailed ImportStatus = "failed"
+)
+
+func (r ImportResult) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", r.Event.Name(), r.Status, r.Err)
+	}
+	if len(r.ExceptionErrs) > 0 {
+		return fmt.Sprintf("%s: %s: EXDATE exceptions failed: %v", r.Event.Name(), r.Status, r.ExceptionErrs)
+	}
+	if len(r.Warnings) > 0 {
+		return fmt.Sprintf("%s: %s: %v", r.Event.Name(), r.Status, r.Warnings)
+	}
+
+	return fmt.Sprintf("%s: %s", r.Event.Name(), r.Status)
+}
+
+// IsFailed checks if the VEVENT (or some of its EXDATE exceptions) wasn't imported.
+func (r ImportResult) IsFailed() bool {
+	return r.Status == ImportStatusFailed || len(r.ExceptionErrs) > 0
+}
+
+// Import creates parsed events for the charge point via the scheduler (conflicts are checked by it).
+// Events without a type are created with the defaultType.
+// Each VEVENT is imported separately: a failed one doesn't abort the import, results are sorted by the VEVENT line.
+// Recurring events are created first, so single events replacing their skipped occurrences don't conflict with them.
+func Import(ctx context.Context, svc scheduler.Scheduler, chargePointId int64, defaultType schema.SingleEventType, events []Event) ([]ImportResult, error) {
+	if svc == nil {
+		return nil, fmt.Errorf("%s: nil", "svc")
+	}
+	if !defaultType.IsValid() {
+		return nil, fmt.Errorf("%s: unknown (%s): %w", "defaultType", defaultType, common.ErrInvalidInput)
+	}
+
+	importer := importer{
+		svc:           svc,
+		chargePointId: chargePointId,
+		defaultType:   defaultType,
+	}
+
+	results := make([]ImportResult, 0, len(events))
+	for _, event := range events {
+		if event.Err == nil && event.IsRecurring() {
+			results = append(results, importer.importPeriodicEvent(ctx, event)...)
+		}
+	}
+	for _, event := range events {
+		switch {
+		case event.Err != nil:
+			results = append(results, newFailedResult(event, event.Err))
+		case !event.IsRecurring():
+			results = append(results, importer.importSingleEvent(ctx, event))
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Event.Line < results[j].Event.Line
+	})
+
+	return results, nil
+}
+
+// importer imports events to the charge point.
+type importer struct {
+	svc           scheduler.Scheduler
+	chargePointId int64
+	defaultType   schema.SingleEventType
+}
+
+// importSingleEvent creates the schema.SingleEvent.
+func (i importer) importSingleEvent(ctx context.Context, event Event) ImportResult {
+	eventType := i.getEventType(event)
+
+	// Check for the same event
+	sEvents, _, err := i.svc.GetEvents(ctx, i.chargePointId, event.Start, event.Start.Add(event.Duration))
+	if err != nil {
+		return newFailedResult(event, fmt.Errorf("svc.GetEvents: %w", err))
+	}
+	for _, sEvent := range sEvents {
+		if sEvent.Type == eventType && sEvent.StartDateTime.Equal(event.Start) && sEvent.Duration == event.Duration {
+			return ImportResult{Event: event, Status: ImportStatusExists}
+		}
+	}
+
+	var warnings common.Warnings
+	if err := i.svc.AddSingleEvent(common.WithWarnings(ctx, &warnings), i.chargePointId, eventType, event.Start, event.Duration); err != nil {
+		return newFailedResult(event, fmt.Errorf("svc.AddSingleEvent: %w", err))
+	}
+
+	return ImportResult{Event: event, Status: ImportStatusCreated, Warnings: warnings}
+}
+
+// importPeriodicEvent creates the schema.PeriodicEvent with its EXDATE exceptions and RECURRENCE-ID overrides.
+// Returns the recurring VEVENT result followed by its overrides results.
+// Exceptions are added one by one: the created event is kept (reported as created) if some of them fail,
+// failed EXDATEs are listed within the result, failed overrides are reported separately.
+func (i importer) importPeriodicEvent(ctx context.Context, event Event) []ImportResult {
+	eventType := i.getEventType(event)
+	failAll := func(err error) []ImportResult {
+		results := []ImportResult{newFailedResult(event, err)}
+		for _, override := range event.Overrides {
+			results = append(results, newFailedResult(override, fmt.Errorf("recurring VEVENT not imported")))
+		}
+
+		return results
+	}
+
+	rruleOpts, err := rrule.StrToROptionInLocation(event.RRule, event.Start.Location())
+	if err != nil {
+		return failAll(fmt.Errorf("%s: parsing (%v): %w", "RRULE", err, common.ErrInvalidInput))
+	}
+
+	// Check for the same event
+	pEvent, err := i.findPeriodicEvent(ctx, eventType, event.Start)
+	if err != nil {
+		return failAll(err)
+	}
+	if pEvent != nil && pEvent.Duration == event.Duration && pEvent.Rrule.OrigOptions.RRuleString() == rruleOpts.RRuleString() {
+		results := []ImportResult{{Event: event, Status: ImportStatusExists}}
+		for _, override := range event.Overrides {
+			results = append(results, ImportResult{Event: override, Status: ImportStatusExists})
+		}
+
+		return results
+	}
+
+	// Create and find the created event (same type events can't share the start, so the search is unique)
+	var warnings common.Warnings
+	if err := i.svc.AddPeriodicEventWithRule(common.WithWarnings(ctx, &warnings), i.chargePointId, eventType, event.Start, event.RRule, event.Duration); err != nil {
+		return failAll(fmt.Errorf("svc.AddPeriodicEventWithRule: %w", err))
+	}
+
+	pEvent, err = i.findPeriodicEvent(ctx, eventType, event.Start)
+	if err != nil {
+		return failAll(err)
+	}
+	if pEvent == nil {
+		return failAll(fmt.Errorf("created periodic event: %w", common.ErrNotFound))
+	}
+
+	// Exceptions (every added one increments the event version)
+	results := []ImportResult{{Event: event, Status: ImportStatusCreated, Warnings: warnings}}
+	version := pEvent.Version
+	for _, exDate := range event.ExDates {
+		if _, err := i.svc.AddPeriodicEventException(common.WithWarnings(ctx, &results[0].Warnings), pEvent.Id, version, exDate); err != nil {
+			results[0].ExceptionErrs = append(results[0].ExceptionErrs, fmt.Errorf("EXDATE %s: svc.AddPeriodicEventException: %w", exDate.Format(time.RFC3339), err))
+			continue
+		}
+		version++
+	}
+	for _, override := range event.Overrides {
+		var overrideWarnings common.Warnings
+		if _, err := i.svc.AddPeriodicEventOverride(common.WithWarnings(ctx, &overrideWarnings), pEvent.Id, version, override.RecurrenceId, override.Start, override.Duration); err != nil {
+			results = append(results, newFailedResult(override, fmt.Errorf("svc.AddPeriodicEventOverride: %w", err)))
+			continue
+		}
+		version++
+		results = append(results, ImportResult{Event: override, Status: ImportStatusCreated, Warnings: overrideWarnings})
+	}
+
+	return results
+}
+
+// findPeriodicEvent returns the charge point schema.PeriodicEvent with the same type and start (nil if not found).
+func (i importer) findPeriodicEvent(ctx context.Context, eventType schema.SingleEventType, eventStart time.Time) (*schema.PeriodicEvent, error) {
+	// Periodic events are returned regardless of the range
+	_, pEvents, err := i.svc.GetEvents(ctx, i.chargePointId, eventStart, eventStart.Add(time.Second))
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetEvents: %w", err)
+	}
+
+	for _, pEvent := range pEvents {
+		if pEvent.Type == eventType && pEvent.Rrule.OrigOptions.Dtstart.Equal(eventStart) {
+			return &pEvent, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getEventType returns the VEVENT type or the default one.
+func (i importer) getEventType(event Event) schema.SingleEventType {
+	if event.Type != "" {
+		return event.Type
+	}
+
+	return i.defaultType
+}
+
+func newFailedResult(event Event, err error) ImportResult {
+	return ImportResult{
+		Event:  event,
+		Status: ImportStatusFailed,
+		Err:    err,
+	}
+}
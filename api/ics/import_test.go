@@ -0,0 +1,189 @@
+package ics
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ICSTestSuite) Test_ExportImport() {
+	t := s.T()
+	ctx := s.ctx
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	berlinLoc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	srcPointId, err := s.r.Svc.CreateChargePoint(ctx, "Source", "Berlin", 2, "Europe/Berlin", 0)
+	require.NoError(t, err)
+	dstPointId, err := s.r.Svc.CreateChargePoint(ctx, "Target", "Berlin", 2, "Europe/Berlin", 0)
+	require.NoError(t, err)
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly, 18.08 skipped, 25.08 moved to 12:00 - 14:00
+	// 05.08.2014 (TUE) 14:00 - 18:00 daily x3
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied
+	{
+		require.NoError(t, s.r.Svc.AddPeriodicEvent(ctx, srcPointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, berlinLoc), 4*time.Hour))
+		require.NoError(t, s.r.Svc.AddPeriodicEventWithRule(ctx, srcPointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 5, 14, 0, 0, 0, berlinLoc), "FREQ=DAILY;COUNT=3", 4*time.Hour))
+		require.NoError(t, s.r.Svc.AddSingleEvent(ctx, srcPointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 30, 0, 0, berlinLoc), time.Hour))
+
+		_, pEvents, err := s.r.Svc.GetEvents(ctx, srcPointId, time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		weeklyId := pEvents[0].Id
+		if pEvents[1].Duration == 4*time.Hour && pEvents[1].Rrule.OrigOptions.Count == 0 {
+			weeklyId = pEvents[1].Id
+		}
+
+		_, err = s.r.Svc.AddPeriodicEventException(ctx, weeklyId, 1, time.Date(2014, 8, 18, 9, 30, 0, 0, berlinLoc))
+		require.NoError(t, err)
+		_, err = s.r.Svc.AddPeriodicEventOverride(ctx, weeklyId, 2, time.Date(2014, 8, 25, 9, 30, 0, 0, berlinLoc), time.Date(2014, 8, 25, 12, 0, 0, 0, berlinLoc), 2*time.Hour)
+		require.NoError(t, err)
+	}
+
+	exportCalendar := func(chargePointId int64) string {
+		sEvents, pEvents, err := s.r.Svc.GetEvents(ctx, chargePointId, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteCalendar(&buf, berlinLoc, sEvents, pEvents))
+
+		return buf.String()
+	}
+	getAgenda := func(chargePointId int64) schema.AgendaResults {
+		agenda, err := s.r.Svc.GetAvailableAgenda(ctx, chargePointId, time.Date(2014, 8, 4, 0, 0, 0, 0, berlinLoc), 28*24*time.Hour, 30*time.Minute)
+		require.NoError(t, err)
+
+		return agenda
+	}
+
+	// ok: export
+	srcCalendar := exportCalendar(srcPointId)
+	{
+		require.True(t, strings.HasPrefix(srcCalendar, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		require.Contains(t, srcCalendar, "DTSTART;TZID=Europe/Berlin:20140804T093000\r\n")
+		require.Contains(t, srcCalendar, "RRULE:FREQ=DAILY;COUNT=3\r\n")
+		require.Contains(t, srcCalendar, "EXDATE;TZID=Europe/Berlin:20140818T093000\r\n")
+		require.Contains(t, srcCalendar, "RECURRENCE-ID;TZID=Europe/Berlin:20140825T093000\r\nDTSTART;TZID=Europe/Berlin:20140825T120000\r\nDURATION:PT2H\r\n")
+		require.Contains(t, srcCalendar, "X-CHARGE-SCHEDULER-TYPE:Occupied\r\n")
+		require.Equal(t, 4, strings.Count(srcCalendar, "BEGIN:VEVENT"))
+	}
+
+	// ok: import to another charge point gives the same schedule
+	{
+		events, err := ParseCalendar(strings.NewReader(srcCalendar), berlinLoc)
+		require.NoError(t, err)
+
+		results, err := Import(ctx, s.r.Svc, dstPointId, schema.SingleEventTypeAvailable, events)
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+		for _, result := range results {
+			require.Equal(t, ImportStatusCreated, result.Status, result.String())
+		}
+
+		require.Equal(t, getAgenda(srcPointId), getAgenda(dstPointId))
+	}
+
+	// ok: repeated import doesn't duplicate events
+	{
+		events, err := ParseCalendar(strings.NewReader(srcCalendar), berlinLoc)
+		require.NoError(t, err)
+
+		results, err := Import(ctx, s.r.Svc, dstPointId, schema.SingleEventTypeAvailable, events)
+		require.NoError(t, err)
+		for _, result := range results {
+			require.Equal(t, ImportStatusExists, result.Status, result.String())
+		}
+	}
+
+	// ok / fail: conflicts are reported per VEVENT
+	{
+		calendar := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"UID:conflict",
+			"DTSTART;TZID=Europe/Berlin:20140811T110000",
+			"DURATION:PT1H",
+			"CATEGORIES:Occupied",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:invalid",
+			"DTSTART:20140811T110000Z",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:free",
+			"DTSTART:20140812T100000",
+			"DTEND:20140812T110000",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:closed",
+			"DTSTART;TZID=Europe/Berlin:20140901T093000",
+			"DURATION:PT4H",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"UID:wrong-exdate",
+			"DTSTART;TZID=Europe/Berlin:20141004T093000",
+			"DURATION:PT2H",
+			"RRULE:FREQ=WEEKLY;COUNT=2",
+			"EXDATE;TZID=Europe/Berlin:20141005T093000",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")
+
+		events, err := ParseCalendar(strings.NewReader(calendar), berlinLoc)
+		require.NoError(t, err)
+
+		results, err := Import(ctx, s.r.Svc, dstPointId, schema.SingleEventTypeOccupied, events)
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+
+		require.Equal(t, "conflict", results[0].Event.UID)
+		require.Equal(t, ImportStatusFailed, results[0].Status)
+		require.True(t, errors.Is(results[0].Err, common.ErrInvalidInput))
+
+		require.Equal(t, "invalid", results[1].Event.UID)
+		require.Equal(t, ImportStatusFailed, results[1].Status)
+
+		require.Equal(t, "free", results[2].Event.UID)
+		require.Equal(t, ImportStatusCreated, results[2].Status)
+
+		require.Equal(t, "closed", results[3].Event.UID)
+		require.Equal(t, ImportStatusCreated, results[3].Status)
+
+		// the created event is kept without the not an occurrence EXDATE
+		require.Equal(t, "wrong-exdate", results[4].Event.UID)
+		require.Equal(t, ImportStatusCreated, results[4].Status)
+		require.True(t, results[4].IsFailed())
+		require.Len(t, results[4].ExceptionErrs, 1)
+		require.True(t, errors.Is(results[4].ExceptionErrs[0], common.ErrInvalidInput))
+
+		_, pEvents, err := s.r.Svc.GetEvents(ctx, dstPointId, time.Date(2014, 10, 4, 0, 0, 0, 0, berlinLoc), time.Date(2014, 10, 5, 0, 0, 0, 0, berlinLoc))
+		require.NoError(t, err)
+		var wrongExDateEvent *schema.PeriodicEvent
+		for i := range pEvents {
+			if pEvents[i].Rrule.OrigOptions.Dtstart.Equal(time.Date(2014, 10, 4, 9, 30, 0, 0, berlinLoc)) {
+				wrongExDateEvent = &pEvents[i]
+			}
+		}
+		require.NotNil(t, wrongExDateEvent)
+		require.Empty(t, wrongExDateEvent.Exceptions)
+
+		sEvents, _, err := s.r.Svc.GetEvents(ctx, dstPointId, time.Date(2014, 8, 12, 0, 0, 0, 0, berlinLoc), time.Date(2014, 8, 13, 0, 0, 0, 0, berlinLoc))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.Equal(t, schema.SingleEventTypeOccupied, sEvents[0].Type)
+		require.True(t, sEvents[0].StartDateTime.Equal(time.Date(2014, 8, 12, 10, 0, 0, 0, berlinLoc)))
+	}
+
+	// fail: invalid inputs
+	{
+		_, err := Import(ctx, s.r.Svc, dstPointId, "Unknown", nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
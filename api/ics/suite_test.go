@@ -0,0 +1,47 @@
+package ics
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/service/scheduler/testutil"
+	v1 "github.com/itiky/charge_scheduler/service/scheduler/v1"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+type ICSTestSuite struct {
+	suite.Suite
+	ctx    context.Context
+	baseSt *sqlite_base.SQLiteBase
+	r      *testutil.SchedulerServiceTestResource
+}
+
+func (s *ICSTestSuite) SetupSuite() {
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(s.T().TempDir())
+	if err != nil {
+		panic(fmt.Errorf("base storage init: %w", err))
+	}
+
+	r, err := v1.NewTestResource(baseSt)
+	if err != nil {
+		panic(fmt.Errorf("resource init: %w", err))
+	}
+
+	s.ctx = context.TODO()
+	s.baseSt = baseSt
+	s.r = r
+}
+
+// nolint:errcheck
+func (s *ICSTestSuite) TearDownSuite() {
+	if s.baseSt != nil {
+		s.baseSt.Close()
+	}
+}
+
+func TestSuite_ICS(t *testing.T) {
+	suite.Run(t, new(ICSTestSuite))
+}
@@ -0,0 +1,233 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+type Format string
+
+const (
+	// FormatText is the human-readable indented text (schema objects String()).
+	FormatText  Format = "text"
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatCSV   Format = "csv"
+	FormatTable Format = "table"
+)
+
+// Formats lists all supported formats.
+var Formats = []Format{FormatText, FormatJSON, FormatYAML, FormatCSV, FormatTable}
+
+// ParseFormat parses and validates the Format.
+func ParseFormat(formatStr string) (Format, error) {
+	for _, format := range Formats {
+		if strings.EqualFold(formatStr, string(format)) {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: unknown (%s): %w", "format", formatStr, common.ErrInvalidInput)
+}
+
+// WriteAgenda writes schema.AgendaResults in the format.
+// JSON / YAML view is a list of {"date", "slots"} objects, CSV / table ones have a row per slot
+// (a row with empty slot columns for a day without slots).
+func WriteAgenda(w io.Writer, format Format, agenda schema.AgendaResults) error {
+	views := NewAgendaViews(agenda)
+
+	switch format {
+	case FormatText:
+		return writeString(w, agenda.String())
+	case FormatJSON:
+		return writeJSON(w, views)
+	case FormatYAML:
+		return writeYAML(w, views)
+	case FormatCSV, FormatTable:
+		rows := make([][]string, 0, len(views))
+		for _, view := range views {
+			rows = append(rows, view.Rows()...)
+		}
+
+		return writeRows(w, format, agendaColumns, rows)
+	default:
+		return fmt.Errorf("%s: unknown (%s): %w", "format", format, common.ErrInvalidInput)
+	}
+}
+
+// WriteEnergyAgenda writes schema.EnergyAgendaResults in the format.
+// JSON / YAML view is an {"energy_kwh", "power_kw", "charge_duration", "agenda"} object,
+// CSV / table ones are the WriteAgenda rows with the slot energy column.
+func WriteEnergyAgenda(w io.Writer, format Format, results schema.EnergyAgendaResults) error {
+	view := NewEnergyAgendaView(results)
+
+	switch format {
+	case FormatText:
+		return writeString(w, results.String())
+	case FormatJSON:
+		return writeJSON(w, view)
+	case FormatYAML:
+		return writeYAML(w, view)
+	case FormatCSV, FormatTable:
+		return writeRows(w, format, energyAgendaColumns, view.Rows())
+	default:
+		return fmt.Errorf("%s: unknown (%s): %w", "format", format, common.ErrInvalidInput)
+	}
+}
+
+// WriteSlots writes schema.TimeSlots (free windows, found slots) in the format.
+// JSON / YAML view is a list of {"start", "end", "duration"} objects, CSV / table ones have a row per slot.
+func WriteSlots(w io.Writer, format Format, slots schema.TimeSlots) error {
+	views := NewSlotViews(slots)
+
+	switch format {
+	case FormatText:
+		return writeString(w, slots.String())
+	case FormatJSON:
+		return writeJSON(w, views)
+	case FormatYAML:
+		return writeYAML(w, views)
+	case FormatCSV, FormatTable:
+		rows := make([][]string, 0, len(views))
+		for _, view := range views {
+			rows = append(rows, view.Row())
+		}
+
+		return writeRows(w, format, slotColumns, rows)
+	default:
+		return fmt.Errorf("%s: unknown (%s): %w", "format", format, common.ErrInvalidInput)
+	}
+}
+
+// WriteEvents writes schema.SingleEvent and schema.PeriodicEvent objects in the format.
+// JSON / YAML view is an {"single_events", "periodic_events"} object, CSV / table ones have a row per event.
+func WriteEvents(w io.Writer, format Format, sEvents []schema.SingleEvent, pEvents []schema.PeriodicEvent) error {
+	view := NewEventsView(sEvents, pEvents)
+
+	switch format {
+	case FormatText:
+		str := strings.Builder{}
+		for _, event := range sEvents {
+			str.WriteString(event.String())
+		}
+		for _, event := range pEvents {
+			str.WriteString(event.String())
+		}
+
+		return writeString(w, str.String())
+	case FormatJSON:
+		return writeJSON(w, view)
+	case FormatYAML:
+		return writeYAML(w, view)
+	case FormatCSV, FormatTable:
+		return writeRows(w, format, eventColumns, view.Rows())
+	default:
+		return fmt.Errorf("%s: unknown (%s): %w", "format", format, common.ErrInvalidInput)
+	}
+}
+
+// WriteEventHistory writes schema.EventHistoryEntry objects in the format.
+// JSON / YAML view is a list of entries with the decoded event snapshots, CSV / table ones have a row per entry
+// with the JSON snapshots.
+func WriteEventHistory(w io.Writer, format Format, entries []schema.EventHistoryEntry) error {
+	views, err := NewEventHistoryViews(entries)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case FormatText:
+		str := strings.Builder{}
+		for _, entry := range entries {
+			str.WriteString(entry.String())
+		}
+
+		return writeString(w, str.String())
+	case FormatJSON:
+		return writeJSON(w, views)
+	case FormatYAML:
+		return writeYAML(w, views)
+	case FormatCSV, FormatTable:
+		rows := make([][]string, 0, len(views))
+		for _, view := range views {
+			rows = append(rows, view.Row())
+		}
+
+		return writeRows(w, format, historyColumns, rows)
+	default:
+		return fmt.Errorf("%s: unknown (%s): %w", "format", format, common.ErrInvalidInput)
+	}
+}
+
+func writeString(w io.Writer, str string) error {
+	if _, err := io.WriteString(w, str); err != nil {
+		return fmt.Errorf("writing: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, obj interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(obj); err != nil {
+		return fmt.Errorf("JSON encoding: %w", err)
+	}
+
+	return nil
+}
+
+func writeYAML(w io.Writer, obj interface{}) error {
+	encoder := yaml.NewEncoder(w)
+	if err := encoder.Encode(obj); err != nil {
+		return fmt.Errorf("YAML encoding: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("YAML encoding: %w", err)
+	}
+
+	return nil
+}
+
+// writeRows writes the header and rows as CSV or an aligned table.
+func writeRows(w io.Writer, format Format, header []string, rows [][]string) error {
+	if format == FormatCSV {
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(header); err != nil {
+			return fmt.Errorf("CSV encoding: %w", err)
+		}
+		if err := csvWriter.WriteAll(rows); err != nil {
+			return fmt.Errorf("CSV encoding: %w", err)
+		}
+
+		return nil
+	}
+
+	tabWriter := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, row := range append([][]string{header}, rows...) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = cell
+			if cell == "" {
+				cells[i] = "-"
+			}
+		}
+		if _, err := fmt.Fprintln(tabWriter, strings.Join(cells, "\t")); err != nil {
+			return fmt.Errorf("writing: %w", err)
+		}
+	}
+	if err := tabWriter.Flush(); err != nil {
+		return fmt.Errorf("writing: %w", err)
+	}
+
+	return nil
+}
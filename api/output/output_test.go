@@ -0,0 +1,392 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/teambition/rrule-go"
+	"gopkg.in/yaml.v2"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+type OutputTestSuite struct {
+	suite.Suite
+	agenda  schema.AgendaResults
+	sEvents []schema.SingleEvent
+	pEvents []schema.PeriodicEvent
+}
+
+func (s *OutputTestSuite) SetupSuite() {
+	berlinLoc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		panic(err)
+	}
+
+	// 10.08.2014: no slots, 11.08.2014: 09:30 - 10:00 and 11:30 - 12:00 (Europe/Berlin)
+	s.agenda = schema.AgendaResults{
+		{
+			Date: time.Date(2014, 8, 10, 0, 0, 0, 0, berlinLoc),
+		},
+		{
+			Date: time.Date(2014, 8, 11, 0, 0, 0, 0, berlinLoc),
+			TimeSlots: []schema.TimeSlot{
+				{Start: time.Date(2014, 8, 11, 9, 30, 0, 0, berlinLoc), Duration: 30 * time.Minute},
+				{Start: time.Date(2014, 8, 11, 11, 30, 0, 0, berlinLoc), Duration: 30 * time.Minute},
+			},
+		},
+	}
+
+	rule, err := rrule.NewRRule(rrule.ROption{
+		Freq:    rrule.WEEKLY,
+		Dtstart: time.Date(2014, 8, 4, 9, 30, 0, 0, berlinLoc),
+	})
+	if err != nil {
+		panic(err)
+	}
+	overrideStart := time.Date(2014, 8, 25, 12, 0, 0, 0, berlinLoc)
+
+	s.sEvents = []schema.SingleEvent{
+		{
+			Id:            1,
+			ChargePointId: 2,
+			Type:          schema.SingleEventTypeOccupied,
+			StartDateTime: time.Date(2014, 8, 11, 10, 30, 0, 0, berlinLoc),
+			Duration:      time.Hour,
+			OwnerRef:      "driver, 42",
+			CreatedAt:     time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC),
+			Version:       2,
+		},
+	}
+	s.pEvents = []schema.PeriodicEvent{
+		{
+			Id:            3,
+			ChargePointId: 2,
+			Type:          schema.SingleEventTypeAvailable,
+			Rrule:         *rule,
+			Duration:      4 * time.Hour,
+			CreatedAt:     time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC),
+			Version:       1,
+			Exceptions: []schema.PeriodicEventException{
+				{Id: 1, PeriodicEventId: 3, OccurrenceStart: time.Date(2014, 8, 18, 9, 30, 0, 0, berlinLoc)},
+				{Id: 2, PeriodicEventId: 3, OccurrenceStart: time.Date(2014, 8, 25, 9, 30, 0, 0, berlinLoc), OverrideStart: &overrideStart, OverrideDuration: 2 * time.Hour},
+			},
+		},
+	}
+}
+
+func (s *OutputTestSuite) Test_ParseFormat() {
+	t := s.T()
+
+	// ok
+	{
+		format, err := ParseFormat("JSON")
+		require.NoError(t, err)
+		require.Equal(t, FormatJSON, format)
+	}
+
+	// fail
+	{
+		_, err := ParseFormat("xml")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = WriteAgenda(&bytes.Buffer{}, "xml", s.agenda)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *OutputTestSuite) Test_WriteAgenda() {
+	t := s.T()
+
+	// ok: JSON keeps the availabilities[i]["date"] / ["slots"] shape
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteAgenda(&buf, FormatJSON, s.agenda))
+
+		var availabilities []map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &availabilities))
+		require.Len(t, availabilities, 2)
+		require.Equal(t, "2014-08-10", availabilities[0]["date"])
+		require.Equal(t, []interface{}{}, availabilities[0]["slots"])
+		require.Equal(t, "2014-08-11", availabilities[1]["date"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"start": "2014-08-11T09:30:00+02:00", "end": "2014-08-11T10:00:00+02:00", "duration": "30m0s"},
+			map[string]interface{}{"start": "2014-08-11T11:30:00+02:00", "end": "2014-08-11T12:00:00+02:00", "duration": "30m0s"},
+		}, availabilities[1]["slots"])
+	}
+
+	// ok: YAML
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteAgenda(&buf, FormatYAML, s.agenda))
+
+		var views []AgendaView
+		require.NoError(t, yaml.Unmarshal(buf.Bytes(), &views))
+		require.Equal(t, NewAgendaViews(s.agenda), views)
+	}
+
+	// ok: CSV
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteAgenda(&buf, FormatCSV, s.agenda))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"date", "start", "end", "duration"},
+			{"2014-08-10", "", "", ""},
+			{"2014-08-11", "2014-08-11T09:30:00+02:00", "2014-08-11T10:00:00+02:00", "30m0s"},
+			{"2014-08-11", "2014-08-11T11:30:00+02:00", "2014-08-11T12:00:00+02:00", "30m0s"},
+		}, records)
+	}
+
+	// ok: table
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteAgenda(&buf, FormatTable, s.agenda))
+		require.Equal(t, ""+
+			"date        start                      end                        duration\n"+
+			"2014-08-10  -                          -                          -\n"+
+			"2014-08-11  2014-08-11T09:30:00+02:00  2014-08-11T10:00:00+02:00  30m0s\n"+
+			"2014-08-11  2014-08-11T11:30:00+02:00  2014-08-11T12:00:00+02:00  30m0s\n",
+			buf.String())
+	}
+
+	// ok: text
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteAgenda(&buf, FormatText, s.agenda))
+		require.Equal(t, s.agenda.String(), buf.String())
+	}
+}
+
+func (s *OutputTestSuite) Test_WriteEnergyAgenda() {
+	t := s.T()
+
+	results := schema.EnergyAgendaResults{
+		EnergyKWh:      5.5,
+		PowerKW:        11,
+		ChargeDuration: 30 * time.Minute,
+		Agenda:         make(schema.AgendaResults, 0, len(s.agenda)),
+	}
+	for _, result := range s.agenda {
+		slots := make([]schema.TimeSlot, 0, len(result.TimeSlots))
+		for _, slot := range result.TimeSlots {
+			slot.EnergyKWh = 5.5
+			slots = append(slots, slot)
+		}
+		results.Agenda = append(results.Agenda, schema.AgendaResult{Date: result.Date, TimeSlots: slots})
+	}
+
+	// ok: JSON
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEnergyAgenda(&buf, FormatJSON, results))
+		require.JSONEq(t, `{
+			"energy_kwh": 5.5,
+			"power_kw": 11,
+			"charge_duration": "30m0s",
+			"agenda": [
+				{"date": "2014-08-10", "slots": []},
+				{"date": "2014-08-11", "slots": [
+					{"start": "2014-08-11T09:30:00+02:00", "end": "2014-08-11T10:00:00+02:00", "duration": "30m0s", "energy_kwh": 5.5},
+					{"start": "2014-08-11T11:30:00+02:00", "end": "2014-08-11T12:00:00+02:00", "duration": "30m0s", "energy_kwh": 5.5}
+				]}
+			]
+		}`, buf.String())
+	}
+
+	// ok: CSV
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEnergyAgenda(&buf, FormatCSV, results))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"date", "start", "end", "duration", "energy_kwh"},
+			{"2014-08-10", "", "", "", ""},
+			{"2014-08-11", "2014-08-11T09:30:00+02:00", "2014-08-11T10:00:00+02:00", "30m0s", "5.5"},
+			{"2014-08-11", "2014-08-11T11:30:00+02:00", "2014-08-11T12:00:00+02:00", "30m0s", "5.5"},
+		}, records)
+	}
+
+	// ok: text
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEnergyAgenda(&buf, FormatText, results))
+		require.Equal(t, results.String(), buf.String())
+	}
+}
+
+func (s *OutputTestSuite) Test_WriteSlots() {
+	t := s.T()
+
+	slots := schema.TimeSlots(s.agenda[1].TimeSlots)
+
+	// ok: JSON
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteSlots(&buf, FormatJSON, slots))
+		require.JSONEq(t, `[
+			{"start": "2014-08-11T09:30:00+02:00", "end": "2014-08-11T10:00:00+02:00", "duration": "30m0s"},
+			{"start": "2014-08-11T11:30:00+02:00", "end": "2014-08-11T12:00:00+02:00", "duration": "30m0s"}
+		]`, buf.String())
+
+		buf.Reset()
+		require.NoError(t, WriteSlots(&buf, FormatJSON, nil))
+		require.JSONEq(t, `[]`, buf.String())
+	}
+
+	// ok: table
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteSlots(&buf, FormatTable, slots))
+		require.Equal(t, ""+
+			"start                      end                        duration\n"+
+			"2014-08-11T09:30:00+02:00  2014-08-11T10:00:00+02:00  30m0s\n"+
+			"2014-08-11T11:30:00+02:00  2014-08-11T12:00:00+02:00  30m0s\n",
+			buf.String())
+	}
+
+	// ok: text
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteSlots(&buf, FormatText, slots))
+		require.Equal(t, ""+
+			"Slots:\n"+
+			"  - 11.08.2014 09:30:00 CEST -> 11.08.2014 10:00:00 CEST (30m0s)\n"+
+			"  - 11.08.2014 11:30:00 CEST -> 11.08.2014 12:00:00 CEST (30m0s)\n",
+			buf.String())
+	}
+}
+
+func (s *OutputTestSuite) Test_WriteEvents() {
+	t := s.T()
+
+	// ok: JSON
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEvents(&buf, FormatJSON, s.sEvents, s.pEvents))
+
+		var view EventsView
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &view))
+		require.Equal(t, EventsView{
+			SingleEvents: []SingleEventView{
+				{
+					Id:            1,
+					ChargePointId: 2,
+					Type:          "Occupied",
+					Start:         "2014-08-11T10:30:00+02:00",
+					End:           "2014-08-11T11:30:00+02:00",
+					Duration:      "1h0m0s",
+					OwnerRef:      "driver, 42",
+					CreatedAt:     "2014-08-01T00:00:00Z",
+					Version:       2,
+				},
+			},
+			PeriodicEvents: []PeriodicEventView{
+				{
+					Id:            3,
+					ChargePointId: 2,
+					Type:          "Available",
+					Start:         "2014-08-04T09:30:00+02:00",
+					RRule:         "FREQ=WEEKLY",
+					Duration:      "4h0m0s",
+					Exceptions: []PeriodicExceptionView{
+						{Id: 1, OccurrenceStart: "2014-08-18T09:30:00+02:00"},
+						{Id: 2, OccurrenceStart: "2014-08-25T09:30:00+02:00", OverrideStart: "2014-08-25T12:00:00+02:00", OverrideDuration: "2h0m0s"},
+					},
+					CreatedAt: "2014-08-01T00:00:00Z",
+					Version:   1,
+				},
+			},
+		}, view)
+	}
+
+	// ok: empty lists are kept
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEvents(&buf, FormatJSON, nil, nil))
+		require.JSONEq(t, `{"single_events": [], "periodic_events": []}`, buf.String())
+	}
+
+	// ok: CSV
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEvents(&buf, FormatCSV, s.sEvents, s.pEvents))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			eventColumns,
+			{"single", "1", "2", "Occupied", "2014-08-11T10:30:00+02:00", "2014-08-11T11:30:00+02:00", "1h0m0s", "", "driver, 42", "", "2014-08-01T00:00:00Z", "2", ""},
+			{"periodic", "3", "2", "Available", "2014-08-04T09:30:00+02:00", "", "4h0m0s", "FREQ=WEEKLY", "", "2014-08-18T09:30:00+02:00 2014-08-25T09:30:00+02:00=2014-08-25T12:00:00+02:00/2h0m0s", "2014-08-01T00:00:00Z", "1", ""},
+		}, records)
+	}
+}
+
+func (s *OutputTestSuite) Test_WriteEventHistory() {
+	t := s.T()
+
+	// Init fixtures
+	sEventSnapshot, err := json.Marshal(s.sEvents[0])
+	require.NoError(t, err)
+	pEventSnapshot, err := json.Marshal(s.pEvents[0])
+	require.NoError(t, err)
+
+	entries := []schema.EventHistoryEntry{
+		{Id: 1, EventId: 1, ChargePointId: 2, Operation: schema.EventOperationCreate, Actor: "alice", After: sEventSnapshot, CreatedAt: time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{Id: 2, EventId: 3, Periodic: true, ChargePointId: 2, Operation: schema.EventOperationDelete, Actor: "bob", Before: pEventSnapshot, CreatedAt: time.Date(2014, 8, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// ok: JSON snapshots are decoded event views
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEventHistory(&buf, FormatJSON, entries))
+
+		var views []map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &views))
+		require.Len(t, views, 2)
+		require.Equal(t, "single", views[0]["kind"])
+		require.Equal(t, "create", views[0]["operation"])
+		require.Equal(t, "alice", views[0]["actor"])
+		require.NotContains(t, views[0], "before")
+		require.Equal(t, "2014-08-11T10:30:00+02:00", views[0]["after"].(map[string]interface{})["start"])
+		require.Equal(t, "periodic", views[1]["kind"])
+		require.NotContains(t, views[1], "after")
+		require.Equal(t, "2014-08-04T09:30:00+02:00", views[1]["before"].(map[string]interface{})["start"])
+		require.Len(t, views[1]["before"].(map[string]interface{})["exceptions"], 2)
+	}
+
+	// ok: CSV snapshots are stored JSON
+	{
+		buf := bytes.Buffer{}
+		require.NoError(t, WriteEventHistory(&buf, FormatCSV, entries))
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			historyColumns,
+			{"1", "single", "1", "2", "create", "alice", "2014-08-01T00:00:00Z", "", string(sEventSnapshot)},
+			{"2", "periodic", "3", "2", "delete", "bob", "2014-08-02T00:00:00Z", string(pEventSnapshot), ""},
+		}, records)
+	}
+
+	// fail: invalid snapshot
+	{
+		invalidEntries := []schema.EventHistoryEntry{{Id: 1, Operation: schema.EventOperationCreate, After: json.RawMessage(`{"rrule": 1}`), Periodic: true}}
+		require.Error(t, WriteEventHistory(&bytes.Buffer{}, FormatJSON, invalidEntries))
+	}
+}
+
+func TestSuite_Output(t *testing.T) {
+	suite.Run(t, new(OutputTestSuite))
+}
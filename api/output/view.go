@@ -0,0 +1,384 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// dateFmt is the agenda day format.
+	dateFmt = "2006-01-02"
+
+	eventKindSingle   = "single"
+	eventKindPeriodic = "periodic"
+)
+
+var (
+	agendaColumns       = []string{"date", "start", "end", "duration"}
+	energyAgendaColumns = append(append([]string{}, agendaColumns...), "energy_kwh")
+	slotColumns         = []string{"start", "end", "duration"}
+	eventColumns        = []string{"kind", "id", "charge_point_id", "type", "start", "end", "duration", "rrule", "owner_ref", "exceptions", "created_at", "version", "deleted_at"}
+	historyColumns      = []string{"id", "kind", "event_id", "charge_point_id", "operation", "actor", "created_at", "before", "after"}
+)
+
+type (
+	// AgendaView is the schema.AgendaResult machine-readable representation.
+	AgendaView struct {
+		Date  string     `json:"date" yaml:"date"`
+		Slots []SlotView `json:"slots" yaml:"slots"`
+	}
+
+	// SlotView is the schema.TimeSlot machine-readable representation.
+	SlotView struct {
+		Start    string `json:"start" yaml:"start"`
+		End      string `json:"end" yaml:"end"`
+		Duration string `json:"duration" yaml:"duration"`
+		// EnergyKWh is set for the energy-based agenda only.
+		EnergyKWh float64 `json:"energy_kwh,omitempty" yaml:"energy_kwh,omitempty"`
+	}
+
+	// EnergyAgendaView is the schema.EnergyAgendaResults machine-readable representation.
+	EnergyAgendaView struct {
+		EnergyKWh      float64      `json:"energy_kwh" yaml:"energy_kwh"`
+		PowerKW        float64      `json:"power_kw" yaml:"power_kw"`
+		ChargeDuration string       `json:"charge_duration" yaml:"charge_duration"`
+		Agenda         []AgendaView `json:"agenda" yaml:"agenda"`
+	}
+
+	// EventsView is the list events machine-readable representation.
+	EventsView struct {
+		SingleEvents   []SingleEventView   `json:"single_events" yaml:"single_events"`
+		PeriodicEvents []PeriodicEventView `json:"periodic_events" yaml:"periodic_events"`
+	}
+
+	// SingleEventView is the schema.SingleEvent machine-readable representation.
+	SingleEventView struct {
+		Id            int64  `json:"id" yaml:"id"`
+		ChargePointId int64  `json:"charge_point_id" yaml:"charge_point_id"`
+		Type          string `json:"type" yaml:"type"`
+		Start         string `json:"start" yaml:"start"`
+		End           string `json:"end" yaml:"end"`
+		Duration      string `json:"duration" yaml:"duration"`
+		OwnerRef      string `json:"owner_ref,omitempty" yaml:"owner_ref,omitempty"`
+		CreatedAt     string `json:"created_at" yaml:"created_at"`
+		Version       int64  `json:"version" yaml:"version"`
+		DeletedAt     string `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty"`
+	}
+
+	// PeriodicEventView is the schema.PeriodicEvent machine-readable representation.
+	PeriodicEventView struct {
+		Id            int64                   `json:"id" yaml:"id"`
+		ChargePointId int64                   `json:"charge_point_id" yaml:"charge_point_id"`
+		Type          string                  `json:"type" yaml:"type"`
+		Start         string                  `json:"start" yaml:"start"`
+		RRule         string                  `json:"rrule" yaml:"rrule"`
+		Duration      string                  `json:"duration" yaml:"duration"`
+		Exceptions    []PeriodicExceptionView `json:"exceptions,omitempty" yaml:"exceptions,omitempty"`
+		CreatedAt     string                  `json:"created_at" yaml:"created_at"`
+		Version       int64                   `json:"version" yaml:"version"`
+		DeletedAt     string                  `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty"`
+	}
+
+	// EventHistoryEntryView is the schema.EventHistoryEntry machine-readable representation.
+	// Before / After are SingleEventView / PeriodicEventView snapshots.
+	EventHistoryEntryView struct {
+		Id            int64       `json:"id" yaml:"id"`
+		Kind          string      `json:"kind" yaml:"kind"`
+		EventId       int64       `json:"event_id" yaml:"event_id"`
+		ChargePointId int64       `json:"charge_point_id" yaml:"charge_point_id"`
+		Operation     string      `json:"operation" yaml:"operation"`
+		Actor         string      `json:"actor" yaml:"actor"`
+		Before        interface{} `json:"before,omitempty" yaml:"before,omitempty"`
+		After         interface{} `json:"after,omitempty" yaml:"after,omitempty"`
+		CreatedAt     string      `json:"created_at" yaml:"created_at"`
+		// beforeJSON / afterJSON are the stored snapshots (CSV / table columns)
+		beforeJSON, afterJSON string
+	}
+
+	// PeriodicExceptionView is the schema.PeriodicEventException machine-readable representation.
+	PeriodicExceptionView struct {
+		Id               int64  `json:"id" yaml:"id"`
+		OccurrenceStart  string `json:"occurrence_start" yaml:"occurrence_start"`
+		OverrideStart    string `json:"override_start,omitempty" yaml:"override_start,omitempty"`
+		OverrideDuration string `json:"override_duration,omitempty" yaml:"override_duration,omitempty"`
+	}
+)
+
+// NewAgendaViews converts schema.AgendaResults.
+func NewAgendaViews(agenda schema.AgendaResults) []AgendaView {
+	views := make([]AgendaView, 0, len(agenda))
+	for _, result := range agenda {
+		views = append(views, AgendaView{
+			Date:  result.Date.Format(dateFmt),
+			Slots: NewSlotViews(result.TimeSlots),
+		})
+	}
+
+	return views
+}
+
+// NewSlotViews converts schema.TimeSlots.
+func NewSlotViews(slots schema.TimeSlots) []SlotView {
+	views := make([]SlotView, 0, len(slots))
+	for _, slot := range slots {
+		views = append(views, NewSlotView(slot))
+	}
+
+	return views
+}
+
+// NewSlotView converts schema.TimeSlot.
+func NewSlotView(slot schema.TimeSlot) SlotView {
+	return SlotView{
+		Start:     formatTime(slot.Start),
+		End:       formatTime(slot.Start.Add(slot.Duration)),
+		Duration:  slot.Duration.String(),
+		EnergyKWh: slot.EnergyKWh,
+	}
+}
+
+// NewEnergyAgendaView converts schema.EnergyAgendaResults.
+func NewEnergyAgendaView(results schema.EnergyAgendaResults) EnergyAgendaView {
+	return EnergyAgendaView{
+		EnergyKWh:      results.EnergyKWh,
+		PowerKW:        results.PowerKW,
+		ChargeDuration: results.ChargeDuration.String(),
+		Agenda:         NewAgendaViews(results.Agenda),
+	}
+}
+
+// NewEventsView converts schema.SingleEvent and schema.PeriodicEvent objects.
+func NewEventsView(sEvents []schema.SingleEvent, pEvents []schema.PeriodicEvent) EventsView {
+	view := EventsView{
+		SingleEvents:   make([]SingleEventView, 0, len(sEvents)),
+		PeriodicEvents: make([]PeriodicEventView, 0, len(pEvents)),
+	}
+
+	for _, event := range sEvents {
+		view.SingleEvents = append(view.SingleEvents, NewSingleEventView(event))
+	}
+
+	for _, event := range pEvents {
+		view.PeriodicEvents = append(view.PeriodicEvents, NewPeriodicEventView(event))
+	}
+
+	return view
+}
+
+// NewSingleEventView converts schema.SingleEvent.
+func NewSingleEventView(event schema.SingleEvent) SingleEventView {
+	view := SingleEventView{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          event.Type.String(),
+		Start:         formatTime(event.StartDateTime),
+		End:           formatTime(event.EndDateTime()),
+		Duration:      event.Duration.String(),
+		OwnerRef:      event.OwnerRef,
+		CreatedAt:     formatTime(event.CreatedAt),
+		Version:       event.Version,
+	}
+	if event.DeletedAt != nil {
+		view.DeletedAt = formatTime(*event.DeletedAt)
+	}
+
+	return view
+}
+
+// NewPeriodicEventView converts schema.PeriodicEvent with its exceptions.
+func NewPeriodicEventView(event schema.PeriodicEvent) PeriodicEventView {
+	view := PeriodicEventView{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          event.Type.String(),
+		Start:         formatTime(event.Rrule.OrigOptions.Dtstart),
+		RRule:         event.Rrule.OrigOptions.RRuleString(),
+		Duration:      event.Duration.String(),
+		CreatedAt:     formatTime(event.CreatedAt),
+		Version:       event.Version,
+	}
+	if event.DeletedAt != nil {
+		view.DeletedAt = formatTime(*event.DeletedAt)
+	}
+	for _, exception := range event.Exceptions {
+		exceptionView := PeriodicExceptionView{
+			Id:              exception.Id,
+			OccurrenceStart: formatTime(exception.OccurrenceStart),
+		}
+		if exception.IsOverride() {
+			exceptionView.OverrideStart = formatTime(*exception.OverrideStart)
+			exceptionView.OverrideDuration = exception.OverrideDuration.String()
+		}
+		view.Exceptions = append(view.Exceptions, exceptionView)
+	}
+
+	return view
+}
+
+// NewEventHistoryViews converts schema.EventHistoryEntry objects decoding their snapshots.
+func NewEventHistoryViews(entries []schema.EventHistoryEntry) ([]EventHistoryEntryView, error) {
+	views := make([]EventHistoryEntryView, 0, len(entries))
+	for _, entry := range entries {
+		view := EventHistoryEntryView{
+			Id:            entry.Id,
+			Kind:          eventKindSingle,
+			EventId:       entry.EventId,
+			ChargePointId: entry.ChargePointId,
+			Operation:     entry.Operation.String(),
+			Actor:         entry.Actor,
+			CreatedAt:     formatTime(entry.CreatedAt),
+		}
+		if entry.Periodic {
+			view.Kind = eventKindPeriodic
+		}
+
+		var err error
+		if view.Before, err = newEventSnapshotView(entry.Periodic, entry.Before); err != nil {
+			return nil, fmt.Errorf("entry (%d): before snapshot: %w", entry.Id, err)
+		}
+		if view.After, err = newEventSnapshotView(entry.Periodic, entry.After); err != nil {
+			return nil, fmt.Errorf("entry (%d): after snapshot: %w", entry.Id, err)
+		}
+		view.beforeJSON, view.afterJSON = string(entry.Before), string(entry.After)
+
+		views = append(views, view)
+	}
+
+	return views, nil
+}
+
+// newEventSnapshotView decodes the event JSON snapshot into SingleEventView / PeriodicEventView (nil if there is no snapshot).
+func newEventSnapshotView(periodic bool, snapshot json.RawMessage) (interface{}, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	if periodic {
+		var event schema.PeriodicEvent
+		if err := json.Unmarshal(snapshot, &event); err != nil {
+			return nil, err
+		}
+
+		return NewPeriodicEventView(event), nil
+	}
+
+	var event schema.SingleEvent
+	if err := json.Unmarshal(snapshot, &event); err != nil {
+		return nil, err
+	}
+
+	return NewSingleEventView(event), nil
+}
+
+// Rows returns agendaColumns rows.
+func (v AgendaView) Rows() [][]string {
+	if len(v.Slots) == 0 {
+		return [][]string{{v.Date, "", "", ""}}
+	}
+
+	rows := make([][]string, 0, len(v.Slots))
+	for _, slot := range v.Slots {
+		rows = append(rows, []string{v.Date, slot.Start, slot.End, slot.Duration})
+	}
+
+	return rows
+}
+
+// Row returns the slotColumns row.
+func (v SlotView) Row() []string {
+	return []string{v.Start, v.End, v.Duration}
+}
+
+// Rows returns energyAgendaColumns rows.
+func (v EnergyAgendaView) Rows() [][]string {
+	rows := make([][]string, 0, len(v.Agenda))
+	for _, agendaView := range v.Agenda {
+		if len(agendaView.Slots) == 0 {
+			rows = append(rows, []string{agendaView.Date, "", "", "", ""})
+			continue
+		}
+
+		for _, slot := range agendaView.Slots {
+			rows = append(rows, []string{agendaView.Date, slot.Start, slot.End, slot.Duration, strconv.FormatFloat(slot.EnergyKWh, 'f', -1, 64)})
+		}
+	}
+
+	return rows
+}
+
+// Rows returns eventColumns rows.
+// Periodic event exceptions are space separated: skipped occurrence start or "{occurrenceStart}={overrideStart}/{overrideDuration}".
+func (v EventsView) Rows() [][]string {
+	rows := make([][]string, 0, len(v.SingleEvents)+len(v.PeriodicEvents))
+	for _, event := range v.SingleEvents {
+		rows = append(rows, []string{
+			eventKindSingle,
+			strconv.FormatInt(event.Id, 10),
+			strconv.FormatInt(event.ChargePointId, 10),
+			event.Type,
+			event.Start,
+			event.End,
+			event.Duration,
+			"",
+			event.OwnerRef,
+			"",
+			event.CreatedAt,
+			strconv.FormatInt(event.Version, 10),
+			event.DeletedAt,
+		})
+	}
+
+	for _, event := range v.PeriodicEvents {
+		exceptions := make([]string, 0, len(event.Exceptions))
+		for _, exception := range event.Exceptions {
+			if exception.OverrideStart == "" {
+				exceptions = append(exceptions, exception.OccurrenceStart)
+				continue
+			}
+			exceptions = append(exceptions, exception.OccurrenceStart+"="+exception.OverrideStart+"/"+exception.OverrideDuration)
+		}
+
+		rows = append(rows, []string{
+			eventKindPeriodic,
+			strconv.FormatInt(event.Id, 10),
+			strconv.FormatInt(event.ChargePointId, 10),
+			event.Type,
+			event.Start,
+			"",
+			event.Duration,
+			event.RRule,
+			"",
+			strings.Join(exceptions, " "),
+			event.CreatedAt,
+			strconv.FormatInt(event.Version, 10),
+			event.DeletedAt,
+		})
+	}
+
+	return rows
+}
+
+// Row returns the historyColumns row (snapshots are JSON encoded).
+func (v EventHistoryEntryView) Row() []string {
+	return []string{
+		strconv.FormatInt(v.Id, 10),
+		v.Kind,
+		strconv.FormatInt(v.EventId, 10),
+		strconv.FormatInt(v.ChargePointId, 10),
+		v.Operation,
+		v.Actor,
+		v.CreatedAt,
+		v.beforeJSON,
+		v.afterJSON,
+	}
+}
+
+// formatTime formats the dateTime as RFC 3339 keeping its offset.
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// defaultChargeDur is the agenda desired charging duration if not set (matches the CLI default).
+	defaultChargeDur = 30 * time.Minute
+)
+
+// handleAgenda handles:
+//
+//	GET /v1/agenda?charge_point_id=&start=&period=&charge_duration=&step=&align= - get available charging slots;
+func (s *Server) handleAgenda(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	query := r.URL.Query()
+	chargePointId, err := parseQueryChargePointId(query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodStart, err := parseQueryTime(query, "start")
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodDur, err := parseQueryDuration(query, "period", 0)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	chargeDur, err := parseQueryDuration(query, "charge_duration", defaultChargeDur)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	opts, err := parseQueryAgendaOptions(query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	agendas, err := s.svc.GetAvailableAgendaWithOptions(r.Context(), chargePointId, periodStart, periodDur, chargeDur, opts)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, NewAgendaResponse(agendas))
+}
+
+// handleEnergyAgenda handles:
+//
+//	GET /v1/agenda/energy?charge_point_id=&start=&period=&step=&align=&energy=&vehicle_power=&battery_capacity=&start_soc= - get available charging slots for the requested energy;
+func (s *Server) handleEnergyAgenda(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	query := r.URL.Query()
+	chargePointId, err := parseQueryChargePointId(query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodStart, err := parseQueryTime(query, "start")
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodDur, err := parseQueryDuration(query, "period", 0)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	opts, err := parseQueryAgendaOptions(query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	request := schema.EnergyRequest{}
+	for param, value := range map[string]*float64{
+		"energy":           &request.EnergyKWh,
+		"vehicle_power":    &request.VehicleMaxPowerKW,
+		"battery_capacity": &request.BatteryCapacityKWh,
+		"start_soc":        &request.StartSoCPercent,
+	} {
+		if *value, err = parseQueryFloat(query, param); err != nil {
+			s.writeError(w, err)
+			return
+		}
+	}
+
+	results, err := s.svc.GetAvailableEnergyAgenda(r.Context(), chargePointId, periodStart, periodDur, request, opts)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, NewEnergyAgendaResponse(results))
+}
+
+// handleBookings handles:
+//
+//	POST /v1/bookings - book an available charging slot (409 if the slot is unavailable);
+func (s *Server) handleBookings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req BookingRequest
+	if err := readJSON(w, r, &req); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	slotDur, err := parseDuration("duration", req.Duration)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	id, err := s.svc.BookSlot(r.Context(), req.GetChargePointId(), req.Start, slotDur, req.OwnerRef)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusCreated, IdResponse{Id: id})
+}
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServerTestSuite) Test_AgendaAndBookings() {
+	t := s.T()
+	require.NoError(t, s.r.StorageRes.Storage.DropData(s.ctx))
+
+	agendaPath := "/v1/agenda?start=2014-08-11T00:00:00Z&period=24h&charge_duration=1h"
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	{
+		require.NoError(t, s.r.Svc.AddPeriodicEvent(s.ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+	}
+
+	// fail: wrong inputs
+	{
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, "/v1/agenda?start=2014-08-11T00:00:00Z", nil, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, "/v1/agenda?start=2014-08-11T00:00:00Z&period=1d", nil, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, agendaPath+"&charge_point_id=1000", nil, nil))
+
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/bookings", BookingRequest{
+			Start:    time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC),
+			Duration: "",
+		}, nil))
+	}
+
+	// ok: agenda
+	{
+		var resp []AgendaResponse
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, agendaPath, nil, &resp))
+		require.Len(t, resp, 1)
+		require.Equal(t, "2014-08-11", resp[0].Date)
+		require.Len(t, resp[0].Slots, 4)
+		require.True(t, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC).Equal(resp[0].Slots[0].Start))
+		require.Equal(t, "1h0m0s", resp[0].Slots[0].Duration)
+
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, agendaPath+"&step=30m", nil, &resp))
+		require.Len(t, resp[0].Slots, 7)
+		require.True(t, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC).Equal(resp[0].Slots[1].Start))
+
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, agendaPath+"&align=7m", nil, nil))
+	}
+
+	// ok: energy agenda (11 kWh / 11 kW = 1h)
+	{
+		var resp EnergyAgendaResponse
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, "/v1/agenda/energy?start=2014-08-11T00:00:00Z&period=24h&energy=11", nil, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, "/v1/agenda/energy?start=2014-08-11T00:00:00Z&period=24h&energy=11&vehicle_power=x", nil, nil))
+
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, "/v1/agenda/energy?start=2014-08-11T00:00:00Z&period=24h&energy=11&vehicle_power=11", nil, &resp))
+		require.Equal(t, 11.0, resp.PowerKW)
+		require.Equal(t, "1h0m0s", resp.ChargeDuration)
+		require.Len(t, resp.Agenda, 1)
+		require.Len(t, resp.Agenda[0].Slots, 4)
+		require.Equal(t, 11.0, resp.Agenda[0].Slots[0].EnergyKWh)
+	}
+
+	// ok: book
+	var bookingId int64
+	{
+		var resp IdResponse
+		require.Equal(t, http.StatusCreated, s.doRequest(http.MethodPost, "/v1/bookings", BookingRequest{
+			Start:    time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC),
+			Duration: "1h",
+			OwnerRef: "driver-42",
+		}, &resp))
+		require.NotEmpty(t, resp.Id)
+		bookingId = resp.Id
+
+		var agendaResp []AgendaResponse
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, agendaPath, nil, &agendaResp))
+		require.Len(t, agendaResp, 1)
+		require.Len(t, agendaResp[0].Slots, 3)
+		require.True(t, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC).Equal(agendaResp[0].Slots[0].Start))
+	}
+
+	// fail: book the same slot
+	{
+		var errResp ErrorResponse
+		require.Equal(t, http.StatusConflict, s.doRequest(http.MethodPost, "/v1/bookings", BookingRequest{
+			Start:    time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC),
+			Duration: "30m",
+			OwnerRef: "driver-43",
+		}, &errResp))
+		require.NotEmpty(t, errResp.Error)
+	}
+
+	// ok: cancelled booking frees the slot
+	{
+		require.Equal(t, http.StatusNoContent, s.doRequest(http.MethodDelete, fmt.Sprintf("/v1/single-events/%d?version=1", bookingId), nil, nil))
+		require.Equal(t, http.StatusCreated, s.doRequest(http.MethodPost, "/v1/bookings", BookingRequest{
+			Start:    time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC),
+			Duration: "30m",
+			OwnerRef: "driver-43",
+		}, nil))
+	}
+}
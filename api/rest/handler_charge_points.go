@@ -0,0 +1,36 @@
+package rest
+
+import (
+	"net/http"
+)
+
+// handleChargePoints handles:
+//
+//	GET /v1/charge-points - list charge points;
+//	POST /v1/charge-points - create a charge point;
+func (s *Server) handleChargePoints(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		chargePoints, err := s.svc.GetChargePoints(r.Context())
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, chargePoints)
+	case http.MethodPost:
+		var req ChargePointRequest
+		if err := readJSON(w, r, &req); err != nil {
+			s.writeError(w, err)
+			return
+		}
+
+		id, err := s.svc.CreateChargePoint(r.Context(), req.Name, req.Site, req.ConnectorsCount, req.TimeZone, req.MaxPowerKW)
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		s.writeJSON(w, http.StatusCreated, IdResponse{Id: id})
+	default:
+		s.writeMethodNotAllowed(w, http.MethodGet, http.MethodPost)
+	}
+}
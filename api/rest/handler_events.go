@@ -0,0 +1,236 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+const (
+	singleEventPathPrefix   = "/v1/single-events/"
+	periodicEventPathPrefix = "/v1/periodic-events/"
+)
+
+// handleEvents handles:
+//
+//	GET /v1/events?charge_point_id=&start=&end= - list charge point events within the range;
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	query := r.URL.Query()
+	chargePointId, err := parseQueryChargePointId(query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodStart, err := parseQueryTime(query, "start")
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	periodEnd, err := parseQueryTime(query, "end")
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	sEvents, pEvents, err := s.svc.GetEvents(r.Context(), chargePointId, periodStart, periodEnd)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, NewEventsResponse(sEvents, pEvents))
+}
+
+// handleSingleEvents handles:
+//
+//	POST /v1/single-events - create a single event;
+func (s *Server) handleSingleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req EventRequest
+	if err := readJSON(w, r, &req); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	eventType, err := req.EventType()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	eventDur, err := req.EventDuration()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	var warnings common.Warnings
+	if err := s.svc.AddSingleEvent(common.WithWarnings(r.Context(), &warnings), req.GetChargePointId(), eventType, req.Start, eventDur); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeWarnings(w, warnings)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// handleSingleEvent handles:
+//
+//	PUT /v1/single-events/{id} - update a single event (the known version is required);
+//	DELETE /v1/single-events/{id}?version= - delete a single event;
+func (s *Server) handleSingleEvent(w http.ResponseWriter, r *http.Request) {
+	eventId, err := parsePathId(r, singleEventPathPrefix)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var req EventRequest
+		if err := readJSON(w, r, &req); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		eventType, err := req.EventType()
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		eventDur, err := req.EventDuration()
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+
+		var warnings common.Warnings
+		if err := s.svc.UpdateSingleEvent(common.WithWarnings(r.Context(), &warnings), eventId, req.Version, eventType, req.Start, eventDur); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		writeWarnings(w, warnings)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		version, err := parseQueryVersion(r.URL.Query())
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+
+		var warnings common.Warnings
+		if err := s.svc.DeleteSingleEvent(common.WithWarnings(r.Context(), &warnings), eventId, version); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		writeWarnings(w, warnings)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeMethodNotAllowed(w, http.MethodPut, http.MethodDelete)
+	}
+}
+
+// handlePeriodicEvents handles:
+//
+//	POST /v1/periodic-events - create a periodic event (weekly if RRULE is not set);
+func (s *Server) handlePeriodicEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeMethodNotAllowed(w, http.MethodPost)
+		return
+	}
+
+	var req EventRequest
+	if err := readJSON(w, r, &req); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	eventType, err := req.EventType()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	eventDur, err := req.EventDuration()
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	var warnings common.Warnings
+	ctx := common.WithWarnings(r.Context(), &warnings)
+	if req.RRule == "" {
+		err = s.svc.AddPeriodicEvent(ctx, req.GetChargePointId(), eventType, req.Start, eventDur)
+	} else {
+		err = s.svc.AddPeriodicEventWithRule(ctx, req.GetChargePointId(), eventType, req.Start, req.RRule, eventDur)
+	}
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeWarnings(w, warnings)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// handlePeriodicEvent handles:
+//
+//	PUT /v1/periodic-events/{id} - update a periodic event (period is kept if RRULE is not set, the known version is required);
+//	DELETE /v1/periodic-events/{id}?version= - delete a periodic event;
+func (s *Server) handlePeriodicEvent(w http.ResponseWriter, r *http.Request) {
+	eventId, err := parsePathId(r, periodicEventPathPrefix)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		var req EventRequest
+		if err := readJSON(w, r, &req); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		eventType, err := req.EventType()
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		eventDur, err := req.EventDuration()
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+
+		var warnings common.Warnings
+		ctx := common.WithWarnings(r.Context(), &warnings)
+		if req.RRule == "" {
+			err = s.svc.UpdatePeriodicEvent(ctx, eventId, req.Version, eventType, req.Start, eventDur)
+		} else {
+			err = s.svc.UpdatePeriodicEventWithRule(ctx, eventId, req.Version, eventType, req.Start, req.RRule, eventDur)
+		}
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+		writeWarnings(w, warnings)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		version, err := parseQueryVersion(r.URL.Query())
+		if err != nil {
+			s.writeError(w, err)
+			return
+		}
+
+		var warnings common.Warnings
+		if err := s.svc.DeletePeriodicEvent(common.WithWarnings(r.Context(), &warnings), eventId, version); err != nil {
+			s.writeError(w, err)
+			return
+		}
+		writeWarnings(w, warnings)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.writeMethodNotAllowed(w, http.MethodPut, http.MethodDelete)
+	}
+}
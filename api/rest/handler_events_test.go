@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServerTestSuite) Test_Events() {
+	t := s.T()
+	require.NoError(t, s.r.StorageRes.Storage.DropData(s.ctx))
+
+	listPath := "/v1/events?" + url.Values{
+		"start": []string{"2014-08-11T00:00:00Z"},
+		"end":   []string{"2014-08-12T00:00:00Z"},
+	}.Encode()
+
+	// fail: wrong inputs
+	{
+		var errResp ErrorResponse
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/single-events", EventRequest{
+			Type:     "Unknown",
+			Start:    time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC),
+			Duration: "1h",
+		}, &errResp))
+		require.Contains(t, errResp.Error, "type")
+
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/single-events", EventRequest{
+			Type:  schema.SingleEventTypeOccupied.String(),
+			Start: time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC),
+		}, nil))
+
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/single-events", EventRequest{
+			Type:     schema.SingleEventTypeOccupied.String(),
+			Start:    time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC),
+			Duration: "-1h",
+		}, nil))
+
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/single-events", `{"unknown_field": 1}`, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/periodic-events", `{`, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodDelete, "/v1/single-events/abc", nil, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodGet, "/v1/events?start=2014-08-11", nil, nil))
+
+		require.Equal(t, http.StatusMethodNotAllowed, s.doRequest(http.MethodGet, "/v1/single-events", nil, nil))
+		require.Equal(t, http.StatusMethodNotAllowed, s.doRequest(http.MethodPost, listPath, nil, nil))
+	}
+
+	// ok: create
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied
+	{
+		require.Equal(t, http.StatusCreated, s.doRequest(http.MethodPost, "/v1/periodic-events", EventRequest{
+			Type:     schema.SingleEventTypeAvailable.String(),
+			Start:    time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC),
+			Duration: "4h",
+		}, nil))
+
+		eventEnd := time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC)
+		require.Equal(t, http.StatusCreated, s.doRequest(http.MethodPost, "/v1/single-events", EventRequest{
+			Type:  schema.SingleEventTypeOccupied.String(),
+			Start: time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC),
+			End:   &eventEnd,
+		}, nil))
+	}
+
+	// fail: create intersecting
+	{
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/single-events", EventRequest{
+			Type:     schema.SingleEventTypeOccupied.String(),
+			Start:    time.Date(2014, 8, 11, 11, 0, 0, 0, time.UTC),
+			Duration: "1h",
+		}, nil))
+	}
+
+	// ok: list
+	var sEventId, pEventId int64
+	{
+		var resp EventsResponse
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, listPath, nil, &resp))
+
+		require.Len(t, resp.SingleEvents, 1)
+		require.Equal(t, schema.SingleEventTypeOccupied.String(), resp.SingleEvents[0].Type)
+		require.True(t, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC).Equal(resp.SingleEvents[0].Start))
+		require.True(t, time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC).Equal(resp.SingleEvents[0].End))
+		require.Equal(t, "1h0m0s", resp.SingleEvents[0].Duration)
+		require.EqualValues(t, 1, resp.SingleEvents[0].Version)
+		sEventId = resp.SingleEvents[0].Id
+
+		require.Len(t, resp.PeriodicEvents, 1)
+		require.Equal(t, schema.SingleEventTypeAvailable.String(), resp.PeriodicEvents[0].Type)
+		require.True(t, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC).Equal(resp.PeriodicEvents[0].Start))
+		require.Equal(t, "FREQ=WEEKLY", resp.PeriodicEvents[0].RRule)
+		require.Equal(t, "4h0m0s", resp.PeriodicEvents[0].Duration)
+		pEventId = resp.PeriodicEvents[0].Id
+	}
+
+	// ok: update
+	{
+		require.Equal(t, http.StatusNoContent, s.doRequest(http.MethodPut, fmt.Sprintf("/v1/single-events/%d", sEventId), EventRequest{
+			Type:     schema.SingleEventTypeOccupied.String(),
+			Start:    time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC),
+			Duration: "30m",
+			Version:  1,
+		}, nil))
+
+		require.Equal(t, http.StatusNoContent, s.doRequest(http.MethodPut, fmt.Sprintf("/v1/periodic-events/%d", pEventId), EventRequest{
+			Type:     schema.SingleEventTypeAvailable.String(),
+			Start:    time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC),
+			Duration: "5h",
+			RRule:    "FREQ=WEEKLY;BYDAY=MO,TU",
+			Version:  1,
+		}, nil))
+
+		var resp EventsResponse
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, listPath, nil, &resp))
+		require.Len(t, resp.SingleEvents, 1)
+		require.True(t, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC).Equal(resp.SingleEvents[0].Start))
+		require.EqualValues(t, 2, resp.SingleEvents[0].Version)
+		require.Len(t, resp.PeriodicEvents, 1)
+		require.Equal(t, "FREQ=WEEKLY;BYDAY=MO,TU", resp.PeriodicEvents[0].RRule)
+		require.Equal(t, "5h0m0s", resp.PeriodicEvents[0].Duration)
+		require.EqualValues(t, 2, resp.PeriodicEvents[0].Version)
+	}
+
+	// fail: update / delete with a stale or missing version
+	{
+		var errResp ErrorResponse
+		require.Equal(t, http.StatusConflict, s.doRequest(http.MethodPut, fmt.Sprintf("/v1/single-events/%d", sEventId), EventRequest{
+			Type:     schema.SingleEventTypeOccupied.String(),
+			Start:    time.Date(2014, 8, 11, 13, 0, 0, 0, time.UTC),
+			Duration: "30m",
+			Version:  1,
+		}, &errResp))
+		require.Contains(t, errResp.Error, "version conflict")
+
+		require.Equal(t, http.StatusConflict, s.doRequest(http.MethodDelete, fmt.Sprintf("/v1/periodic-events/%d?version=1", pEventId), nil, nil))
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodDelete, fmt.Sprintf("/v1/periodic-events/%d", pEventId), nil, nil))
+	}
+
+	// fail: update / delete non-existing
+	{
+		require.Equal(t, http.StatusNotFound, s.doRequest(http.MethodPut, "/v1/single-events/1000", EventRequest{
+			Type:     schema.SingleEventTypeOccupied.String(),
+			Start:    time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC),
+			Duration: "30m",
+		}, nil))
+		require.Equal(t, http.StatusNotFound, s.doRequest(http.MethodDelete, "/v1/periodic-events/1000?version=1", nil, nil))
+	}
+
+	// ok: delete
+	{
+		require.Equal(t, http.StatusNoContent, s.doRequest(http.MethodDelete, fmt.Sprintf("/v1/single-events/%d?version=2", sEventId), nil, nil))
+		require.Equal(t, http.StatusNoContent, s.doRequest(http.MethodDelete, fmt.Sprintf("/v1/periodic-events/%d?version=2", pEventId), nil, nil))
+
+		var resp EventsResponse
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, listPath, nil, &resp))
+		require.Empty(t, resp.SingleEvents)
+		require.Empty(t, resp.PeriodicEvents)
+	}
+}
@@ -0,0 +1,256 @@
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+type (
+	// ErrorResponse is returned for all non-2xx responses.
+	ErrorResponse struct {
+		Error string `json:"error"`
+	}
+
+	// IdResponse is returned by create requests.
+	IdResponse struct {
+		Id int64 `json:"id"`
+	}
+
+	// ChargePointRequest is a create charge point request.
+	ChargePointRequest struct {
+		Name            string  `json:"name"`
+		Site            string  `json:"site"`
+		ConnectorsCount uint    `json:"connectors_count"`
+		TimeZone        string  `json:"time_zone"`
+		MaxPowerKW      float64 `json:"max_power_kw"`
+	}
+
+	// EventRequest is a create / update single or periodic event request.
+	// Event end is defined either with End or Duration, Version is required for updates only.
+	EventRequest struct {
+		ChargePointId int64      `json:"charge_point_id"`
+		Type          string     `json:"type"`
+		Start         time.Time  `json:"start"`
+		End           *time.Time `json:"end,omitempty"`
+		Duration      string     `json:"duration,omitempty"`
+		// RRule is an RFC 5545 RRULE without DTSTART (periodic events only, weekly / kept on update if empty).
+		RRule string `json:"rrule,omitempty"`
+		// Version is the updated event version (as listed), 409 is returned if the event was changed since.
+		Version int64 `json:"version,omitempty"`
+	}
+
+	// BookingRequest is a book charging slot request.
+	BookingRequest struct {
+		ChargePointId int64     `json:"charge_point_id"`
+		Start         time.Time `json:"start"`
+		Duration      string    `json:"duration"`
+		OwnerRef      string    `json:"owner_ref"`
+	}
+
+	// EventsResponse is a list events response.
+	EventsResponse struct {
+		SingleEvents   []SingleEventResponse   `json:"single_events"`
+		PeriodicEvents []PeriodicEventResponse `json:"periodic_events"`
+	}
+
+	// SingleEventResponse is the schema.SingleEvent API representation.
+	SingleEventResponse struct {
+		Id            int64     `json:"id"`
+		ChargePointId int64     `json:"charge_point_id"`
+		Type          string    `json:"type"`
+		Start         time.Time `json:"start"`
+		End           time.Time `json:"end"`
+		Duration      string    `json:"duration"`
+		OwnerRef      string    `json:"owner_ref,omitempty"`
+		CreatedAt     time.Time `json:"created_at"`
+		Version       int64     `json:"version"`
+	}
+
+	// PeriodicEventResponse is the schema.PeriodicEvent API representation.
+	PeriodicEventResponse struct {
+		Id            int64                            `json:"id"`
+		ChargePointId int64                            `json:"charge_point_id"`
+		Type          string                           `json:"type"`
+		Start         time.Time                        `json:"start"`
+		RRule         string                           `json:"rrule"`
+		Duration      string                           `json:"duration"`
+		Exceptions    []PeriodicEventExceptionResponse `json:"exceptions,omitempty"`
+		CreatedAt     time.Time                        `json:"created_at"`
+		Version       int64                            `json:"version"`
+	}
+
+	// PeriodicEventExceptionResponse is the schema.PeriodicEventException API representation.
+	PeriodicEventExceptionResponse struct {
+		Id               int64      `json:"id"`
+		OccurrenceStart  time.Time  `json:"occurrence_start"`
+		OverrideStart    *time.Time `json:"override_start,omitempty"`
+		OverrideDuration string     `json:"override_duration,omitempty"`
+	}
+
+	// AgendaResponse is the schema.AgendaResult API representation.
+	AgendaResponse struct {
+		Date  string         `json:"date"`
+		Slots []SlotResponse `json:"slots"`
+	}
+
+	// SlotResponse is the schema.TimeSlot API representation.
+	SlotResponse struct {
+		Start    time.Time `json:"start"`
+		Duration string    `json:"duration"`
+		// EnergyKWh is set for the energy-based agenda only.
+		EnergyKWh float64 `json:"energy_kwh,omitempty"`
+	}
+
+	// EnergyAgendaResponse is the schema.EnergyAgendaResults API representation.
+	EnergyAgendaResponse struct {
+		EnergyKWh      float64          `json:"energy_kwh"`
+		PowerKW        float64          `json:"power_kw"`
+		ChargeDuration string           `json:"charge_duration"`
+		Agenda         []AgendaResponse `json:"agenda"`
+	}
+)
+
+// EventType returns the validated event type.
+func (r EventRequest) EventType() (schema.SingleEventType, error) {
+	eventType := schema.SingleEventType(r.Type)
+	if !eventType.IsValid() {
+		return "", fmt.Errorf("%s: unknown: %w", "type", common.ErrInvalidInput)
+	}
+
+	return eventType, nil
+}
+
+// EventDuration returns the event duration defined with End or Duration.
+func (r EventRequest) EventDuration() (time.Duration, error) {
+	switch {
+	case r.End != nil && r.Duration != "":
+		return 0, fmt.Errorf("%s / %s: only one must be set: %w", "end", "duration", common.ErrInvalidInput)
+	case r.End != nil:
+		return r.End.Sub(r.Start), nil
+	case r.Duration != "":
+		return parseDuration("duration", r.Duration)
+	default:
+		return 0, fmt.Errorf("%s / %s: empty: %w", "end", "duration", common.ErrInvalidInput)
+	}
+}
+
+// GetChargePointId returns the request charge point ID (schema.DefaultChargePointId if not set).
+func (r EventRequest) GetChargePointId() int64 {
+	if r.ChargePointId == 0 {
+		return schema.DefaultChargePointId
+	}
+
+	return r.ChargePointId
+}
+
+// GetChargePointId returns the request charge point ID (schema.DefaultChargePointId if not set).
+func (r BookingRequest) GetChargePointId() int64 {
+	if r.ChargePointId == 0 {
+		return schema.DefaultChargePointId
+	}
+
+	return r.ChargePointId
+}
+
+// NewSingleEventResponse converts schema.SingleEvent.
+func NewSingleEventResponse(event schema.SingleEvent) SingleEventResponse {
+	return SingleEventResponse{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          event.Type.String(),
+		Start:         event.StartDateTime,
+		End:           event.EndDateTime(),
+		Duration:      event.Duration.String(),
+		OwnerRef:      event.OwnerRef,
+		CreatedAt:     event.CreatedAt,
+		Version:       event.Version,
+	}
+}
+
+// NewPeriodicEventResponse converts schema.PeriodicEvent.
+func NewPeriodicEventResponse(event schema.PeriodicEvent) PeriodicEventResponse {
+	resp := PeriodicEventResponse{
+		Id:            event.Id,
+		ChargePointId: event.ChargePointId,
+		Type:          event.Type.String(),
+		Start:         event.Rrule.OrigOptions.Dtstart,
+		RRule:         event.Rrule.OrigOptions.RRuleString(),
+		Duration:      event.Duration.String(),
+		CreatedAt:     event.CreatedAt,
+		Version:       event.Version,
+	}
+
+	for _, exception := range event.Exceptions {
+		exceptionResp := PeriodicEventExceptionResponse{
+			Id:              exception.Id,
+			OccurrenceStart: exception.OccurrenceStart,
+			OverrideStart:   exception.OverrideStart,
+		}
+		if exception.IsOverride() {
+			exceptionResp.OverrideDuration = exception.OverrideDuration.String()
+		}
+		resp.Exceptions = append(resp.Exceptions, exceptionResp)
+	}
+
+	return resp
+}
+
+// NewEventsResponse converts GetEvents results.
+func NewEventsResponse(sEvents []schema.SingleEvent, pEvents []schema.PeriodicEvent) EventsResponse {
+	resp := EventsResponse{
+		SingleEvents:   make([]SingleEventResponse, 0, len(sEvents)),
+		PeriodicEvents: make([]PeriodicEventResponse, 0, len(pEvents)),
+	}
+	for _, event := range sEvents {
+		resp.SingleEvents = append(resp.SingleEvents, NewSingleEventResponse(event))
+	}
+	for _, event := range pEvents {
+		resp.PeriodicEvents = append(resp.PeriodicEvents, NewPeriodicEventResponse(event))
+	}
+
+	return resp
+}
+
+// NewAgendaResponse converts schema.AgendaResults.
+func NewAgendaResponse(agendas schema.AgendaResults) []AgendaResponse {
+	resp := make([]AgendaResponse, 0, len(agendas))
+	for _, agenda := range agendas {
+		agendaResp := AgendaResponse{
+			Date:  agenda.Date.Format("2006-01-02"),
+			Slots: make([]SlotResponse, 0, len(agenda.TimeSlots)),
+		}
+		for _, slot := range agenda.TimeSlots {
+			agendaResp.Slots = append(agendaResp.Slots, SlotResponse{
+				Start:     slot.Start,
+				Duration:  slot.Duration.String(),
+				EnergyKWh: slot.EnergyKWh,
+			})
+		}
+		resp = append(resp, agendaResp)
+	}
+
+	return resp
+}
+
+// NewEnergyAgendaResponse converts schema.EnergyAgendaResults.
+func NewEnergyAgendaResponse(results schema.EnergyAgendaResults) EnergyAgendaResponse {
+	return EnergyAgendaResponse{
+		EnergyKWh:      results.EnergyKWh,
+		PowerKW:        results.PowerKW,
+		ChargeDuration: results.ChargeDuration.String(),
+		Agenda:         NewAgendaResponse(results.Agenda),
+	}
+}
+
+// parseDuration parses a Go duration string (8h30m).
+func parseDuration(field, durStr string) (time.Duration, error) {
+	dur, err := time.ParseDuration(durStr)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid duration: %w", field, common.ErrInvalidInput)
+	}
+
+	return dur, nil
+}
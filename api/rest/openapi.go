@@ -0,0 +1,331 @@
+package rest
+
+import (
+	"io"
+	"net/http"
+)
+
+// handleOpenAPI handles:
+//
+//	GET /openapi.json - the API OpenAPI 3 document;
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeMethodNotAllowed(w, http.MethodGet)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := io.WriteString(w, openAPIDocument); err != nil {
+		s.logger.Warn().Err(err).Msg("writing response")
+	}
+}
+
+// openAPIDocument describes the API (keep in sync with Handler routes and model.go).
+const openAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "Charge scheduler API",
+    "version": "1.0.0",
+    "description": "Charge points availability scheduling. DateTimes are RFC 3339, durations are Go duration strings (8h30m). charge_point_id defaults to 1."
+  },
+  "paths": {
+    "/v1/charge-points": {
+      "get": {
+        "summary": "List charge points",
+        "responses": {
+          "200": {"description": "Charge points", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/ChargePoint"}}}}},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "post": {
+        "summary": "Create a charge point",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ChargePointRequest"}}}},
+        "responses": {
+          "201": {"$ref": "#/components/responses/Id"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/events": {
+      "get": {
+        "summary": "List charge point single events within the range and all periodic events",
+        "parameters": [
+          {"$ref": "#/components/parameters/ChargePointId"},
+          {"name": "start", "in": "query", "required": true, "schema": {"type": "string", "format": "date-time"}},
+          {"name": "end", "in": "query", "required": true, "schema": {"type": "string", "format": "date-time"}}
+        ],
+        "responses": {
+          "200": {"description": "Events", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EventsResponse"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/single-events": {
+      "post": {
+        "summary": "Create a single event",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EventRequest"}}}},
+        "responses": {
+          "201": {"description": "Created"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/single-events/{id}": {
+      "parameters": [{"$ref": "#/components/parameters/Id"}],
+      "put": {
+        "summary": "Update a single event",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EventRequest"}}}},
+        "responses": {
+          "204": {"description": "Updated"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a single event (cancels a booking)",
+        "parameters": [{"$ref": "#/components/parameters/Version"}],
+        "responses": {
+          "204": {"description": "Deleted"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/periodic-events": {
+      "post": {
+        "summary": "Create a periodic event (weekly if rrule is not set)",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EventRequest"}}}},
+        "responses": {
+          "201": {"description": "Created"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/periodic-events/{id}": {
+      "parameters": [{"$ref": "#/components/parameters/Id"}],
+      "put": {
+        "summary": "Update a periodic event (period is kept if rrule is not set)",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EventRequest"}}}},
+        "responses": {
+          "204": {"description": "Updated"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      },
+      "delete": {
+        "summary": "Delete a periodic event with its exceptions",
+        "parameters": [{"$ref": "#/components/parameters/Version"}],
+        "responses": {
+          "204": {"description": "Deleted"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "404": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/agenda": {
+      "get": {
+        "summary": "Get available charging slots for the period and the desired charging duration",
+        "parameters": [
+          {"$ref": "#/components/parameters/ChargePointId"},
+          {"name": "start", "in": "query", "required": true, "schema": {"type": "string", "format": "date-time"}},
+          {"name": "period", "in": "query", "required": true, "schema": {"type": "string", "example": "240h"}},
+          {"name": "charge_duration", "in": "query", "required": false, "schema": {"type": "string", "default": "30m"}},
+          {"$ref": "#/components/parameters/Step"},
+          {"$ref": "#/components/parameters/Align"}
+        ],
+        "responses": {
+          "200": {"description": "Agenda days", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Agenda"}}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/agenda/energy": {
+      "get": {
+        "summary": "Get available charging slots for the period and the requested energy (charging duration is estimated using the lower of the charge point and vehicle max powers)",
+        "parameters": [
+          {"$ref": "#/components/parameters/ChargePointId"},
+          {"name": "start", "in": "query", "required": true, "schema": {"type": "string", "format": "date-time"}},
+          {"name": "period", "in": "query", "required": true, "schema": {"type": "string", "example": "240h"}},
+          {"$ref": "#/components/parameters/Step"},
+          {"$ref": "#/components/parameters/Align"},
+          {"name": "energy", "in": "query", "required": true, "description": "Requested energy [kWh]", "schema": {"type": "number"}},
+          {"name": "vehicle_power", "in": "query", "required": false, "description": "Vehicle max charging power [kW]", "schema": {"type": "number"}},
+          {"name": "battery_capacity", "in": "query", "required": false, "description": "Vehicle battery capacity [kWh] (enables the taper model for the last 20% of the state of charge)", "schema": {"type": "number"}},
+          {"name": "start_soc", "in": "query", "required": false, "description": "Vehicle state of charge at the charging start [%]", "schema": {"type": "number"}}
+        ],
+        "responses": {
+          "200": {"description": "Estimated charging parameters and agenda days", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/EnergyAgenda"}}}},
+          "400": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    },
+    "/v1/bookings": {
+      "post": {
+        "summary": "Book an available charging slot (creates an Occupied single event)",
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/BookingRequest"}}}},
+        "responses": {
+          "201": {"$ref": "#/components/responses/Id"},
+          "400": {"$ref": "#/components/responses/Error"},
+          "409": {"$ref": "#/components/responses/Error"},
+          "500": {"$ref": "#/components/responses/Error"}
+        }
+      }
+    }
+  },
+  "components": {
+    "parameters": {
+      "Id": {"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "format": "int64"}},
+      "Version": {"name": "version", "in": "query", "required": true, "description": "Event version as listed (optimistic concurrency)", "schema": {"type": "integer", "format": "int64", "minimum": 1}},
+      "ChargePointId": {"name": "charge_point_id", "in": "query", "required": false, "schema": {"type": "integer", "format": "int64", "default": 1}},
+      "Step": {"name": "step", "in": "query", "required": false, "description": "Interval between slot starts (charge duration if not set)", "schema": {"type": "string", "example": "15m"}},
+      "Align": {"name": "align", "in": "query", "required": false, "description": "Snap slot starts to the charge point local clock boundaries", "schema": {"type": "string", "example": "15m"}}
+    },
+    "responses": {
+      "Id": {"description": "Created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/IdResponse"}}}},
+      "Error": {"description": "Error (400: invalid input, 404: not found, 409: slot unavailable / version conflict)", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/ErrorResponse"}}}}
+    },
+    "schemas": {
+      "ErrorResponse": {
+        "type": "object",
+        "properties": {"error": {"type": "string"}}
+      },
+      "IdResponse": {
+        "type": "object",
+        "properties": {"id": {"type": "integer", "format": "int64"}}
+      },
+      "ChargePointRequest": {
+        "type": "object",
+        "required": ["name", "connectors_count"],
+        "properties": {
+          "name": {"type": "string"},
+          "site": {"type": "string"},
+          "connectors_count": {"type": "integer", "minimum": 1},
+          "time_zone": {"type": "string", "description": "IANA time zone", "default": "UTC"},
+          "max_power_kw": {"type": "number", "minimum": 0, "description": "Charger max power [kW], 0 if unknown"}
+        }
+      },
+      "ChargePoint": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "integer", "format": "int64"},
+          "name": {"type": "string"},
+          "site": {"type": "string"},
+          "connectors_count": {"type": "integer"},
+          "time_zone": {"type": "string"},
+          "max_power_kw": {"type": "number"},
+          "created_at": {"type": "string", "format": "date-time"}
+        }
+      },
+      "EventType": {"type": "string", "enum": ["Available", "Occupied"]},
+      "EventRequest": {
+        "type": "object",
+        "description": "Event end is defined either with end or duration",
+        "required": ["type", "start"],
+        "properties": {
+          "charge_point_id": {"type": "integer", "format": "int64", "default": 1},
+          "type": {"$ref": "#/components/schemas/EventType"},
+          "start": {"type": "string", "format": "date-time"},
+          "end": {"type": "string", "format": "date-time"},
+          "duration": {"type": "string", "example": "8h30m"},
+          "rrule": {"type": "string", "description": "Periodic events only: RFC 5545 RRULE without DTSTART", "example": "FREQ=WEEKLY;BYDAY=MO,WE"},
+          "version": {"type": "integer", "format": "int64", "description": "Updates only (required): event version as listed (optimistic concurrency)"}
+        }
+      },
+      "BookingRequest": {
+        "type": "object",
+        "required": ["start", "duration"],
+        "properties": {
+          "charge_point_id": {"type": "integer", "format": "int64", "default": 1},
+          "start": {"type": "string", "format": "date-time"},
+          "duration": {"type": "string", "example": "30m"},
+          "owner_ref": {"type": "string"}
+        }
+      },
+      "SingleEvent": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "integer", "format": "int64"},
+          "charge_point_id": {"type": "integer", "format": "int64"},
+          "type": {"$ref": "#/components/schemas/EventType"},
+          "start": {"type": "string", "format": "date-time"},
+          "end": {"type": "string", "format": "date-time"},
+          "duration": {"type": "string"},
+          "owner_ref": {"type": "string"},
+          "created_at": {"type": "string", "format": "date-time"},
+          "version": {"type": "integer", "format": "int64"}
+        }
+      },
+      "PeriodicEventException": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "integer", "format": "int64"},
+          "occurrence_start": {"type": "string", "format": "date-time"},
+          "override_start": {"type": "string", "format": "date-time", "description": "Not set if the occurrence is skipped"},
+          "override_duration": {"type": "string"}
+        }
+      },
+      "PeriodicEvent": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "integer", "format": "int64"},
+          "charge_point_id": {"type": "integer", "format": "int64"},
+          "type": {"$ref": "#/components/schemas/EventType"},
+          "start": {"type": "string", "format": "date-time"},
+          "rrule": {"type": "string"},
+          "duration": {"type": "string"},
+          "exceptions": {"type": "array", "items": {"$ref": "#/components/schemas/PeriodicEventException"}},
+          "created_at": {"type": "string", "format": "date-time"},
+          "version": {"type": "integer", "format": "int64"}
+        }
+      },
+      "EventsResponse": {
+        "type": "object",
+        "properties": {
+          "single_events": {"type": "array", "items": {"$ref": "#/components/schemas/SingleEvent"}},
+          "periodic_events": {"type": "array", "items": {"$ref": "#/components/schemas/PeriodicEvent"}}
+        }
+      },
+      "Agenda": {
+        "type": "object",
+        "properties": {
+          "date": {"type": "string", "format": "date"},
+          "slots": {
+            "type": "array",
+            "items": {
+              "type": "object",
+              "properties": {
+                "start": {"type": "string", "format": "date-time"},
+                "duration": {"type": "string"},
+                "energy_kwh": {"type": "number", "description": "Energy-based agenda only"}
+              }
+            }
+          }
+        }
+      },
+      "EnergyAgenda": {
+        "type": "object",
+        "properties": {
+          "energy_kwh": {"type": "number"},
+          "power_kw": {"type": "number"},
+          "charge_duration": {"type": "string"},
+          "agenda": {"type": "array", "items": {"$ref": "#/components/schemas/Agenda"}}
+        }
+      }
+    }
+  }
+}
+`
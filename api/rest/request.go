@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// readJSON decodes the request body into dst rejecting unknown fields.
+func readJSON(w http.ResponseWriter, r *http.Request, dst interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return fmt.Errorf("request body: %v: %w", err, common.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// writeJSON writes the response body with the status code.
+func (s *Server) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.logger.Warn().Err(err).Msg("writing response")
+	}
+}
+
+// writeError writes the ErrorResponse mapping the service error to the status code.
+// Internal errors details are logged and not exposed.
+func (s *Server) writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, common.ErrInvalidInput):
+		status = http.StatusBadRequest
+	case errors.Is(err, common.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, common.ErrSlotUnavailable), errors.Is(err, common.ErrVersionConflict):
+		status = http.StatusConflict
+	}
+
+	msg := err.Error()
+	if status == http.StatusInternalServerError {
+		s.logger.Error().Err(err).Msg("request failed")
+		msg = http.StatusText(status)
+	}
+
+	s.writeJSON(w, status, ErrorResponse{Error: msg})
+}
+
+// writeWarnings adds the service common.Warnings (if any) as the "299" Warning headers.
+// Must be called before the status code is written.
+func writeWarnings(w http.ResponseWriter, warnings common.Warnings) {
+	for _, warning := range warnings {
+		w.Header().Add("Warning", fmt.Sprintf("299 - %s", strconv.Quote(warning)))
+	}
+}
+
+// writeMethodNotAllowed writes the 405 response with the allowed methods.
+func (s *Server) writeMethodNotAllowed(w http.ResponseWriter, allowedMethods ...string) {
+	w.Header().Set("Allow", strings.Join(allowedMethods, ", "))
+	s.writeJSON(w, http.StatusMethodNotAllowed, ErrorResponse{Error: http.StatusText(http.StatusMethodNotAllowed)})
+}
+
+// parsePathId parses the resource ID from the "{prefix}{id}" path.
+func parsePathId(r *http.Request, prefix string) (int64, error) {
+	idStr := strings.TrimPrefix(r.URL.Path, prefix)
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%s: invalid: %w", "id", common.ErrInvalidInput)
+	}
+
+	return id, nil
+}
+
+// parseQueryChargePointId parses the optional charge_point_id query param (schema.DefaultChargePointId if not set).
+func parseQueryChargePointId(query url.Values) (int64, error) {
+	idStr := query.Get("charge_point_id")
+	if idStr == "" {
+		return schema.DefaultChargePointId, nil
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid: %w", "charge_point_id", common.ErrInvalidInput)
+	}
+
+	return id, nil
+}
+
+// parseQueryVersion parses the required event version query param.
+func parseQueryVersion(query url.Values) (int64, error) {
+	versionStr := query.Get("version")
+	if versionStr == "" {
+		return 0, fmt.Errorf("%s: empty: %w", "version", common.ErrInvalidInput)
+	}
+
+	version, err := strconv.ParseInt(versionStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid: %w", "version", common.ErrInvalidInput)
+	}
+
+	return version, nil
+}
+
+// parseQueryTime parses the required RFC 3339 query param.
+func parseQueryTime(query url.Values, param string) (time.Time, error) {
+	valueStr := query.Get(param)
+	if valueStr == "" {
+		return time.Time{}, fmt.Errorf("%s: empty: %w", param, common.ErrInvalidInput)
+	}
+
+	value, err := time.Parse(time.RFC3339, valueStr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: invalid RFC 3339 dateTime: %w", param, common.ErrInvalidInput)
+	}
+
+	return value, nil
+}
+
+// parseQueryDuration parses the query param duration (defaultDur if not set).
+func parseQueryDuration(query url.Values, param string, defaultDur time.Duration) (time.Duration, error) {
+	valueStr := query.Get(param)
+	if valueStr == "" {
+		return defaultDur, nil
+	}
+
+	return parseDuration(param, valueStr)
+}
+
+// parseQueryAgendaOptions parses the optional step and align query params.
+func parseQueryAgendaOptions(query url.Values) (schema.AgendaOptions, error) {
+	step, err := parseQueryDuration(query, "step", 0)
+	if err != nil {
+		return schema.AgendaOptions{}, err
+	}
+	align, err := parseQueryDuration(query, "align", 0)
+	if err != nil {
+		return schema.AgendaOptions{}, err
+	}
+
+	return schema.AgendaOptions{Step: step, Align: align}, nil
+}
+
+// parseQueryFloat parses the optional query param float (0 if not set).
+func parseQueryFloat(query url.Values, param string) (float64, error) {
+	valueStr := query.Get(param)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(valueStr, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid number: %w", param, common.ErrInvalidInput)
+	}
+
+	return value, nil
+}
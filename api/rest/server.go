@@ -0,0 +1,130 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/service/scheduler"
+)
+
+const (
+	// maxRequestBodySize limits JSON request bodies.
+	maxRequestBodySize = 1 << 20
+	// readHeaderTimeout limits slow clients.
+	readHeaderTimeout = 10 * time.Second
+	// actorHeader is the request header carrying the events history actor.
+	actorHeader = "X-Actor"
+)
+
+// Server is a REST (JSON over HTTP) API server for the scheduler.Scheduler service.
+type Server struct {
+	logger  zerolog.Logger
+	svc     scheduler.Scheduler
+	httpSrv *http.Server
+}
+
+// Handler returns the API routes handler.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openapi.json", s.handleOpenAPI)
+	mux.HandleFunc("/v1/charge-points", s.handleChargePoints)
+	mux.HandleFunc("/v1/events", s.handleEvents)
+	mux.HandleFunc("/v1/single-events", s.handleSingleEvents)
+	mux.HandleFunc("/v1/single-events/", s.handleSingleEvent)
+	mux.HandleFunc("/v1/periodic-events", s.handlePeriodicEvents)
+	mux.HandleFunc("/v1/periodic-events/", s.handlePeriodicEvent)
+	mux.HandleFunc("/v1/agenda", s.handleAgenda)
+	mux.HandleFunc("/v1/agenda/energy", s.handleEnergyAgenda)
+	mux.HandleFunc("/v1/bookings", s.handleBookings)
+
+	return s.logRequests(setActor(mux))
+}
+
+// Serve accepts connections on the listener until Shutdown is called (nil is returned in that case).
+func (s *Server) Serve(listener net.Listener) error {
+	s.logger.Info().Str("addr", listener.Addr().String()).Msg("server started")
+	if err := s.httpSrv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("httpSrv.Serve: %w", err)
+	}
+
+	return nil
+}
+
+// ListenAndServe listens on the addr and serves requests until Shutdown is called (nil is returned in that case).
+func (s *Server) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("net.Listen(%s): %w", addr, err)
+	}
+
+	return s.Serve(listener)
+}
+
+// Shutdown gracefully stops the server waiting for active requests to finish (until ctx is done).
+func (s *Server) Shutdown(ctx context.Context) error {
+	if err := s.httpSrv.Shutdown(ctx); err != nil {
+		return fmt.Errorf("httpSrv.Shutdown: %w", err)
+	}
+	s.logger.Info().Msg("server stopped")
+
+	return nil
+}
+
+// logRequests logs every handled request.
+func (s *Server) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		statusW := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(statusW, r)
+
+		s.logger.Debug().
+			Str("method", r.Method).
+			Str("uri", r.RequestURI).
+			Int("status", statusW.status).
+			Dur("dur", time.Since(start)).
+			Msg("request handled")
+	})
+}
+
+// setActor passes the actorHeader value to the request context (recorded to the events history).
+func setActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := common.WithActor(r.Context(), r.Header.Get(actorHeader))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// statusResponseWriter captures the response status code.
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func NewServer(logger zerolog.Logger, svc scheduler.Scheduler) (*Server, error) {
+	if svc == nil {
+		return nil, fmt.Errorf("%s: nil", "svc")
+	}
+
+	s := &Server{
+		logger: logger.With().Str("component", "REST server").Logger(),
+		svc:    svc,
+	}
+	s.httpSrv = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	return s, nil
+}
@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+func (s *ServerTestSuite) Test_ChargePoints() {
+	t := s.T()
+
+	// fail: wrong inputs
+	{
+		require.Equal(t, http.StatusBadRequest, s.doRequest(http.MethodPost, "/v1/charge-points", ChargePointRequest{
+			Name:            "CP-REST",
+			ConnectorsCount: 1,
+			TimeZone:        "Mars/Olympus",
+		}, nil))
+		require.Equal(t, http.StatusMethodNotAllowed, s.doRequest(http.MethodDelete, "/v1/charge-points", nil, nil))
+	}
+
+	// ok
+	{
+		var idResp IdResponse
+		require.Equal(t, http.StatusCreated, s.doRequest(http.MethodPost, "/v1/charge-points", ChargePointRequest{
+			Name:            "CP-REST",
+			Site:            "Berlin Mitte",
+			ConnectorsCount: 2,
+			TimeZone:        "Europe/Berlin",
+		}, &idResp))
+		require.NotEmpty(t, idResp.Id)
+
+		var resp []map[string]interface{}
+		require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, "/v1/charge-points", nil, &resp))
+
+		found := false
+		for _, point := range resp {
+			if int64(point["id"].(float64)) == idResp.Id {
+				require.Equal(t, "CP-REST", point["name"])
+				require.Equal(t, "Europe/Berlin", point["time_zone"])
+				found = true
+			}
+		}
+		require.True(t, found)
+	}
+}
+
+func (s *ServerTestSuite) Test_OpenAPI() {
+	t := s.T()
+
+	var doc struct {
+		OpenAPI string                 `json:"openapi"`
+		Paths   map[string]interface{} `json:"paths"`
+	}
+	require.Equal(t, http.StatusOK, s.doRequest(http.MethodGet, "/openapi.json", nil, &doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+
+	for _, path := range []string{
+		"/v1/charge-points",
+		"/v1/events",
+		"/v1/single-events",
+		"/v1/single-events/{id}",
+		"/v1/periodic-events",
+		"/v1/periodic-events/{id}",
+		"/v1/agenda",
+		"/v1/bookings",
+	} {
+		require.Contains(t, doc.Paths, path)
+	}
+}
+
+func (s *ServerTestSuite) Test_Actor() {
+	t := s.T()
+
+	var actor string
+	handler := setActor(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor = common.ActorFromContext(r.Context())
+	}))
+
+	// ok: header is passed to the context
+	{
+		req := httptest.NewRequest(http.MethodPost, "/v1/single-events", nil)
+		req.Header.Set(actorHeader, "alice")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		require.Equal(t, "alice", actor)
+	}
+
+	// ok: no header
+	{
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/single-events", nil))
+		require.Equal(t, common.ActorUnknown, actor)
+	}
+}
+
+func (s *ServerTestSuite) Test_GracefulShutdown() {
+	t := s.T()
+
+	server, err := NewServer(zerolog.Nop(), s.r.Svc)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		serveErrCh <- server.Serve(listener)
+	}()
+
+	// ok: server is up
+	{
+		resp, err := http.Get("http://" + listener.Addr().String() + "/openapi.json")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	// ok: Serve returns no error on Shutdown
+	{
+		ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer ctxCancel()
+		require.NoError(t, server.Shutdown(ctx))
+
+		select {
+		case err := <-serveErrCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("server has not stopped")
+		}
+
+		_, err := http.Get("http://" + listener.Addr().String() + "/openapi.json")
+		require.Error(t, err)
+	}
+}
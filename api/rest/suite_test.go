@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/service/scheduler/testutil"
+	v1 "github.com/itiky/charge_scheduler/service/scheduler/v1"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	baseSt  *sqlite_base.SQLiteBase
+	r       *testutil.SchedulerServiceTestResource
+	server  *Server
+	httpSrv *httptest.Server
+}
+
+func (s *ServerTestSuite) SetupSuite() {
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(s.T().TempDir())
+	if err != nil {
+		panic(fmt.Errorf("base storage init: %w", err))
+	}
+
+	r, err := v1.NewTestResource(baseSt)
+	if err != nil {
+		panic(fmt.Errorf("resource init: %w", err))
+	}
+
+	server, err := NewServer(zerolog.Nop(), r.Svc)
+	if err != nil {
+		panic(fmt.Errorf("server init: %w", err))
+	}
+
+	s.ctx = context.TODO()
+	s.baseSt = baseSt
+	s.r = r
+	s.server = server
+	s.httpSrv = httptest.NewServer(server.Handler())
+}
+
+// nolint:errcheck
+func (s *ServerTestSuite) TearDownSuite() {
+	if s.httpSrv != nil {
+		s.httpSrv.Close()
+	}
+	if s.baseSt != nil {
+		s.baseSt.Close()
+	}
+}
+
+// doRequest sends the request with an optional JSON body and decodes an optional JSON response body.
+func (s *ServerTestSuite) doRequest(method, path string, reqBody, respBody interface{}) int {
+	t := s.T()
+
+	var bodyReader *bytes.Reader
+	switch body := reqBody.(type) {
+	case nil:
+		bodyReader = bytes.NewReader(nil)
+	case string:
+		bodyReader = bytes.NewReader([]byte(body))
+	default:
+		bodyBz, err := json.Marshal(body)
+		require.NoError(t, err)
+		bodyReader = bytes.NewReader(bodyBz)
+	}
+
+	req, err := http.NewRequest(method, s.httpSrv.URL+path, bodyReader)
+	require.NoError(t, err)
+
+	resp, err := s.httpSrv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	respBz, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	if respBody != nil {
+		require.NoError(t, json.Unmarshal(respBz, respBody), string(respBz))
+	}
+
+	return resp.StatusCode
+}
+
+func TestSuite_RESTServer(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
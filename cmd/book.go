@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+// BookSlotCmd returns book charging slot command.
+func BookSlotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "book [slotStartDateTime] [slotDur] [ownerRef]",
+		Short:   "Book an available charging slot (creates an Occupied event)",
+		Example: "book 2014-08-11T09:30:00Z 30m driver-42 --charge-point 2",
+		Long: `Arguments:
+  [slotStartDateTime] - charging slot start dateTime (RFC 3339);
+  [slotDur] - charging slot duration;
+  [ownerRef] - booking owner reference (driver / vehicle / session ID);
+`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			slotStart, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				logger.Fatal().Str("arg", "slotStartDateTime").Err(err).Msg("invalid")
+			}
+
+			slotDur, err := time.ParseDuration(args[1])
+			if err != nil {
+				logger.Fatal().Str("arg", "slotDur").Err(err).Msg("invalid")
+			}
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			ctx := getActorContext(logger, cmd)
+			id, err := svc.BookSlot(ctx, chargePointId, slotStart, slotDur, args[2])
+			if err != nil {
+				if errors.Is(err, common.ErrSlotUnavailable) {
+					logger.Fatal().Err(err).Msg("slot is not available anymore, request a fresh agenda")
+				}
+				logger.Fatal().Err(err).Msg("svc.BookSlot")
+			}
+
+			// Print response
+			fmt.Printf("Booking ID: %d\n", id)
+		},
+	}
+	addChargePointFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(BookSlotCmd())
+}
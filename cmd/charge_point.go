@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagTimeZone = "time-zone"
+	FlagMaxPower = "max-power"
+)
+
+// ChargePointCmd returns charge points management root command.
+func ChargePointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "charge-point",
+		Short: "Charge points management commands",
+	}
+	cmd.AddCommand(
+		CreateChargePointCmd(),
+		ListChargePointsCmd(),
+	)
+
+	return cmd
+}
+
+// CreateChargePointCmd returns create schema.ChargePoint object command.
+func CreateChargePointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create [name] [site] [connectorsCount]",
+		Short:   "Create a charge point",
+		Example: `charge-point create CP-01 "Berlin Mitte" 2 --time-zone Europe/Berlin --max-power 22`,
+		Long: `Arguments:
+  [name] - charge point name;
+  [site] - charge point site (location) name;
+  [connectorsCount] - number of charge point connectors;
+`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			connectorsCount, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				logger.Fatal().Str("arg", "connectorsCount").Err(err).Msg("invalid")
+			}
+
+			timeZone, err := cmd.Flags().GetString(FlagTimeZone)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagTimeZone).Err(err).Msg("invalid")
+			}
+
+			maxPowerKW, err := cmd.Flags().GetFloat64(FlagMaxPower)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagMaxPower).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			id, err := svc.CreateChargePoint(context.TODO(), args[0], args[1], uint(connectorsCount), timeZone, maxPowerKW)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("svc.CreateChargePoint")
+			}
+
+			// Print response
+			fmt.Printf("Charge point ID: %d\n", id)
+		},
+	}
+	cmd.Flags().String(FlagTimeZone, "UTC", "(optional) charge point IANA time zone (event end times, recurrences and agenda days follow its wall-clock time)")
+	cmd.Flags().Float64(FlagMaxPower, 0, "(optional) charger max power [kW] used by energy-based agendas (unknown if 0)")
+
+	return cmd
+}
+
+// ListChargePointsCmd returns list charge points command.
+func ListChargePointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Print registered charge points",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			points, err := svc.GetChargePoints(context.TODO())
+			if err != nil {
+				logger.Fatal().Err(err).Msg("svc.GetChargePoints")
+			}
+
+			// Print response
+			for _, point := range points {
+				fmt.Print(point.String())
+			}
+		},
+	}
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(ChargePointCmd())
+}
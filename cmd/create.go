@@ -1,29 +1,33 @@
 package main
 
 import (
-	"context"
 	"log"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/itiky/charge_scheduler/common"
 	"github.com/itiky/charge_scheduler/schema"
 )
 
 const (
-	FlagWeekly    = "weekly"
+	FlagWeekly = "weekly"
+	FlagRRule  = "rrule"
 )
 
 // CreateSingleEventCmd returns create schema.SingleEvent object command.
 func CreateSingleEventCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "create [scheduleType] [eventStartDateTime] [eventEndTime]",
-		Short:   "Create a schedule event (single / recurrent) of a specified type",
-		Example: "create Available 2020-02-21T12:00:00Z 15:30 --weekly",
+		Use:   "create [scheduleType] [eventStartDateTime] [eventEnd]",
+		Short: "Create a schedule event (single / recurrent) of a specified type",
+		Example: `create Available 2020-02-21T12:00:00Z 15:30 --weekly --charge-point 2
+create Available 2020-02-21T12:00:00Z 15:30 --rrule "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20201231T000000Z"
+create Available 2020-02-21T22:00:00Z 06:00 --weekly
+create Occupied 2020-02-21T12:00:00Z 2020-02-23T08:00:00Z`,
 		Long: `Arguments:
   [scheduleType] - schedule type (Available / Occupied);
   [eventStartDateTime] - event start dateTime (RFC 3339);
-  [eventEndTime] - event end time during the {eventStartDateTime} day (HH:MM);
+  [eventEnd] - event end: duration (8h30m), dateTime (RFC 3339) or charge point wall-clock time (HH:MM, the next day if not after the {eventStartDateTime} one);
 `,
 		Args: cobra.ExactArgs(3),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -43,30 +47,49 @@ func CreateSingleEventCmd() *cobra.Command {
 				logger.Fatal().Str("arg", "eventStartDateTime").Err(err).Msg("invalid")
 			}
 
-			eventEndTime, err := time.Parse("15:04", args[2])
+			isWeekly, err := cmd.Flags().GetBool(FlagWeekly)
 			if err != nil {
-				logger.Fatal().Str("arg", "eventEndTime").Err(err).Msg("invalid")
+				logger.Fatal().Str("flag", FlagWeekly).Err(err).Msg("invalid")
 			}
 
-			isWeekly, err := cmd.Flags().GetBool(FlagWeekly)
+			rruleStr, err := cmd.Flags().GetString(FlagRRule)
 			if err != nil {
-				logger.Fatal().Str("flag", FlagWeekly).Err(err).Msg("invalid")
+				logger.Fatal().Str("flag", FlagRRule).Err(err).Msg("invalid")
+			}
+			if isWeekly && rruleStr != "" {
+				logger.Fatal().Str("flag", FlagRRule).Msgf("can't be used with the %s flag", FlagWeekly)
 			}
 
+			chargePointId := getChargePointId(logger, cmd)
+
 			// Init dependencies and request
 			svc := getService(logger, cmd)
-			if isWeekly {
-				if err := svc.AddPeriodicEvent(context.TODO(), eventType, eventStart, uint(eventEndTime.Hour()), uint(eventEndTime.Minute())); err != nil {
+			eventDur, err := parseEventEnd(eventStart, args[2], getChargePointLocation(logger, svc, chargePointId))
+			if err != nil {
+				logger.Fatal().Str("arg", "eventEnd").Err(err).Msg("invalid")
+			}
+
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			if rruleStr != "" {
+				if err := svc.AddPeriodicEventWithRule(ctx, chargePointId, eventType, eventStart, rruleStr, eventDur); err != nil {
+					logger.Fatal().Err(err).Msg("svc.AddPeriodicEventWithRule")
+				}
+			} else if isWeekly {
+				if err := svc.AddPeriodicEvent(ctx, chargePointId, eventType, eventStart, eventDur); err != nil {
 					logger.Fatal().Err(err).Msg("svc.AddPeriodicEvent")
 				}
 			} else {
-				if err := svc.AddSingleEvent(context.TODO(), eventType, eventStart, uint(eventEndTime.Hour()), uint(eventEndTime.Minute())); err != nil {
+				if err := svc.AddSingleEvent(ctx, chargePointId, eventType, eventStart, eventDur); err != nil {
 					logger.Fatal().Err(err).Msg("svc.AddSingleEvent")
 				}
 			}
+			logWarnings(logger, warnings)
 		},
 	}
 	cmd.Flags().Bool(FlagWeekly, false, "(optional) recurrent schedule event type")
+	cmd.Flags().String(FlagRRule, "", "(optional) recurrent schedule event RFC 5545 RRULE (without DTSTART, {eventStartDateTime} is used)")
+	addChargePointFlag(cmd)
 
 	return cmd
 }
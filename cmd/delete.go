@@ -0,0 +1,67 @@
+package main
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+// DeleteEventCmd returns delete schema.SingleEvent / schema.PeriodicEvent object command.
+func DeleteEventCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete [eventId]",
+		Short:   "Delete a schedule event (single / recurrent)",
+		Example: "delete 1 --periodic --version 1",
+		Long: `Arguments:
+  [eventId] - event ID (as printed by the list command);
+
+The --version (as printed by the list command) is required, the removal fails if the event was changed since.
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			eventId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatal().Str("arg", "eventId").Err(err).Msg("invalid")
+			}
+
+			version := getEventVersion(logger, cmd)
+
+			isPeriodic, err := cmd.Flags().GetBool(FlagPeriodic)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagPeriodic).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			if isPeriodic {
+				if err := svc.DeletePeriodicEvent(ctx, eventId, version); err != nil {
+					fatalEventChange(logger, err, "svc.DeletePeriodicEvent")
+				}
+			} else {
+				if err := svc.DeleteSingleEvent(ctx, eventId, version); err != nil {
+					fatalEventChange(logger, err, "svc.DeleteSingleEvent")
+				}
+			}
+			logWarnings(logger, warnings)
+		},
+	}
+	cmd.Flags().Bool(FlagPeriodic, false, "(optional) target event is a recurrent (PeriodicEvent) one")
+	addEventVersionFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(DeleteEventCmd())
+}
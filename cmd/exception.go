@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+const (
+	FlagOverrideStart = "override-start"
+	FlagOverrideEnd   = "override-end"
+)
+
+// ExceptionCmd returns periodic event exceptions management root command.
+func ExceptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exception",
+		Short: "Recurrent schedule event exceptions (skipped / moved occurrences) management commands",
+	}
+	cmd.AddCommand(
+		AddExceptionCmd(),
+		RemoveExceptionCmd(),
+	)
+
+	return cmd
+}
+
+// AddExceptionCmd returns create schema.PeriodicEventException object command.
+func AddExceptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [periodicEventId] [occurrenceStartDateTime]",
+		Short: "Skip or move (with the override flags) a single recurrent schedule event occurrence",
+		Example: `exception add 1 2014-08-18T09:30:00Z --version 1
+exception add 1 2014-08-25T09:30:00Z --version 2 --override-start 2014-08-25T12:00:00Z --override-end 16:00`,
+		Long: `Arguments:
+  [periodicEventId] - recurrent event ID (as printed by the list command);
+  [occurrenceStartDateTime] - original occurrence start dateTime (RFC 3339);
+
+The recurrent event --version (as printed by the list command) is required, the exception fails if the event was changed since.
+Exception changes increment the recurrent event version.
+`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			eventId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatal().Str("arg", "periodicEventId").Err(err).Msg("invalid")
+			}
+
+			occurrenceStart, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				logger.Fatal().Str("arg", "occurrenceStartDateTime").Err(err).Msg("invalid")
+			}
+
+			version := getEventVersion(logger, cmd)
+
+			overrideStartStr, err := cmd.Flags().GetString(FlagOverrideStart)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOverrideStart).Err(err).Msg("invalid")
+			}
+
+			overrideEndStr, err := cmd.Flags().GetString(FlagOverrideEnd)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOverrideEnd).Err(err).Msg("invalid")
+			}
+			if (overrideStartStr == "") != (overrideEndStr == "") {
+				logger.Fatal().Str("flag", FlagOverrideEnd).Msgf("must be used together with the %s flag", FlagOverrideStart)
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			var id int64
+			if overrideStartStr != "" {
+				overrideStart, err := time.Parse(time.RFC3339, overrideStartStr)
+				if err != nil {
+					logger.Fatal().Str("flag", FlagOverrideStart).Err(err).Msg("invalid")
+				}
+
+				overrideDur, err := parseEventEnd(overrideStart, overrideEndStr, getEventLocation(logger, svc, eventId, true))
+				if err != nil {
+					logger.Fatal().Str("flag", FlagOverrideEnd).Err(err).Msg("invalid")
+				}
+
+				id, err = svc.AddPeriodicEventOverride(ctx, eventId, version, occurrenceStart, overrideStart, overrideDur)
+				if err != nil {
+					fatalEventChange(logger, err, "svc.AddPeriodicEventOverride")
+				}
+			} else {
+				id, err = svc.AddPeriodicEventException(ctx, eventId, version, occurrenceStart)
+				if err != nil {
+					fatalEventChange(logger, err, "svc.AddPeriodicEventException")
+				}
+			}
+			logWarnings(logger, warnings)
+
+			// Print response
+			fmt.Printf("Exception ID: %d\n", id)
+		},
+	}
+	cmd.Flags().String(FlagOverrideStart, "", "(optional) moved occurrence start dateTime (RFC 3339)")
+	cmd.Flags().String(FlagOverrideEnd, "", "(optional) moved occurrence end: duration (8h30m), dateTime (RFC 3339) or charge point wall-clock time (HH:MM, the next day if not after the {override-start} one)")
+	addEventVersionFlag(cmd)
+
+	return cmd
+}
+
+// RemoveExceptionCmd returns delete schema.PeriodicEventException object command.
+func RemoveExceptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove [exceptionId]",
+		Short:   "Remove a recurrent schedule event exception restoring the original occurrence",
+		Example: "exception remove 1 --version 3",
+		Long: `Arguments:
+  [exceptionId] - exception ID (as printed by the list command);
+
+The recurrent event --version (as printed by the list command) is required, the removal fails if the event was changed since.
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			exceptionId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatal().Str("arg", "exceptionId").Err(err).Msg("invalid")
+			}
+
+			version := getEventVersion(logger, cmd)
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			if err := svc.RemovePeriodicEventException(ctx, exceptionId, version); err != nil {
+				fatalEventChange(logger, err, "svc.RemovePeriodicEventException")
+			}
+			logWarnings(logger, warnings)
+		},
+	}
+	addEventVersionFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(ExceptionCmd())
+}
@@ -2,26 +2,46 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"os"
 	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/output"
+	"github.com/itiky/charge_scheduler/schema"
 )
 
 const (
-	FlagChargeDur = "charge-duration"
+	FlagChargeDur       = "charge-duration"
+	FlagEnergy          = "energy"
+	FlagVehiclePower    = "vehicle-power"
+	FlagBatteryCapacity = "battery-capacity"
+	FlagStartSoC        = "start-soc"
+	FlagStep            = "step"
+	FlagAlign           = "align"
 )
 
 // GetAgendaCmd returns get agenda command.
 func GetAgendaCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "agenda [periodStartDateTime] [periodDur]",
-		Short:   "Get available charging slots for a specified period and charging time",
-		Example: "agenda 2020-02-21T12:00:00Z 240h 30m",
+		Use:   "agenda [periodStartDateTime] [periodDur]",
+		Short: "Get available charging slots for a specified period and charging time",
+		Example: `agenda 2020-02-21T12:00:00Z 240h --charge-duration 30m
+agenda 2020-02-21T12:00:00Z 240h --charge-duration 1h --step 15m --align 15m
+agenda 2020-02-21T12:00:00Z 240h --output json
+agenda 2020-02-21T12:00:00Z 240h --energy 30 --vehicle-power 11 --battery-capacity 60 --start-soc 40`,
 		Long: `Arguments:
   [periodStartDateTime] - period start dateTime (RFC 3339);
   [periodDur] - requested period duration;
+
+Slots are offered back-to-back within an available window unless --step is set (slots overlap if the step is
+less than the charging duration). --align snaps slot starts to the charge point local clock boundaries.
+
+Energy-based request (--energy flag) replaces the charging duration with the one estimated
+using the lower of the charge point and vehicle max powers. If the battery capacity is set,
+charging power decreases linearly from 80% state of charge to 10% of the max power at 100%.
 `,
 		Args: cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
@@ -31,40 +51,94 @@ func GetAgendaCmd() *cobra.Command {
 			}
 
 			// Parse inputs
-			periodStart, err := time.Parse(time.RFC3339, args[0])
+			periodStart, periodDur := parsePeriodArgs(logger, args)
+
+			chargingDur, err := cmd.Flags().GetDuration(FlagChargeDur)
 			if err != nil {
-				logger.Fatal().Str("arg", "periodStartDateTime").Err(err).Msg("invalid")
+				logger.Fatal().Str("flag", FlagChargeDur).Err(err).Msg("invalid")
 			}
 
-			periodDur, err := time.ParseDuration(args[1])
-			if err != nil {
-				logger.Fatal().Str("arg", "periodDur").Err(err).Msg("invalid")
+			agendaOpts := schema.AgendaOptions{}
+			if agendaOpts.Step, err = cmd.Flags().GetDuration(FlagStep); err != nil {
+				logger.Fatal().Str("flag", FlagStep).Err(err).Msg("invalid")
+			}
+			if agendaOpts.Align, err = cmd.Flags().GetDuration(FlagAlign); err != nil {
+				logger.Fatal().Str("flag", FlagAlign).Err(err).Msg("invalid")
 			}
 
-			chargingDur, err := cmd.Flags().GetDuration(FlagChargeDur)
+			energyRequest := getEnergyRequest(logger, cmd)
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			outputFormat, err := getOutputFormat(cmd)
 			if err != nil {
-				logger.Fatal().Str("flag", FlagChargeDur).Err(err).Msg("invalid")
+				logger.Fatal().Str("flag", FlagOutput).Err(err).Msg("invalid")
 			}
 
 			// Init dependencies and request
 			svc := getService(logger, cmd)
-			agenda, err := svc.GetAvailableAgenda(context.TODO(), periodStart, periodDur, chargingDur)
+			if energyRequest.EnergyKWh > 0 {
+				results, err := svc.GetAvailableEnergyAgenda(context.TODO(), chargePointId, periodStart, periodDur, energyRequest, agendaOpts)
+				if err != nil {
+					logger.Fatal().Err(err).Msg("svc.GetAvailableEnergyAgenda")
+				}
+
+				// Print response
+				if len(results.Agenda) == 0 {
+					logger.Fatal().Msg("agenda is empty")
+				}
+				if err := output.WriteEnergyAgenda(os.Stdout, outputFormat, results); err != nil {
+					logger.Fatal().Err(err).Msg("output.WriteEnergyAgenda")
+				}
+
+				return
+			}
+
+			agenda, err := svc.GetAvailableAgendaWithOptions(context.TODO(), chargePointId, periodStart, periodDur, chargingDur, agendaOpts)
 			if err != nil {
-				logger.Fatal().Err(err).Msg("svc.GetAvailableAgenda")
+				logger.Fatal().Err(err).Msg("svc.GetAvailableAgendaWithOptions")
 			}
 
 			// Print response
 			if len(agenda) == 0 {
 				logger.Fatal().Msg("agenda is empty")
 			}
-			fmt.Print(agenda.String())
+			if err := output.WriteAgenda(os.Stdout, outputFormat, agenda); err != nil {
+				logger.Fatal().Err(err).Msg("output.WriteAgenda")
+			}
 		},
 	}
 	cmd.Flags().Duration(FlagChargeDur, 30*time.Minute, "(optional) desired charging duration")
+	cmd.Flags().Duration(FlagStep, 0, "(optional) interval between slot starts (charging duration if not set)")
+	cmd.Flags().Duration(FlagAlign, 0, "(optional) snap slot starts to clock boundaries (15m, 30m, 1h, ...)")
+	cmd.Flags().Float64(FlagEnergy, 0, "(optional) requested energy [kWh] (overrides the charging duration)")
+	cmd.Flags().Float64(FlagVehiclePower, 0, "(optional) vehicle max charging power [kW]")
+	cmd.Flags().Float64(FlagBatteryCapacity, 0, "(optional) vehicle battery capacity [kWh] (enables the taper model)")
+	cmd.Flags().Float64(FlagStartSoC, 0, "(optional) vehicle state of charge at the charging start [%]")
+	addChargePointFlag(cmd)
 
 	return cmd
 }
 
+// getEnergyRequest parses energy-based request flags.
+func getEnergyRequest(logger zerolog.Logger, cmd *cobra.Command) schema.EnergyRequest {
+	request := schema.EnergyRequest{}
+	for flag, value := range map[string]*float64{
+		FlagEnergy:          &request.EnergyKWh,
+		FlagVehiclePower:    &request.VehicleMaxPowerKW,
+		FlagBatteryCapacity: &request.BatteryCapacityKWh,
+		FlagStartSoC:        &request.StartSoCPercent,
+	} {
+		v, err := cmd.Flags().GetFloat64(flag)
+		if err != nil {
+			logger.Fatal().Str("flag", flag).Err(err).Msg("invalid")
+		}
+		*value = v
+	}
+
+	return request
+}
+
 func init() {
 	rootCmd.AddCommand(GetAgendaCmd())
 }
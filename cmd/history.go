@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/output"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	FlagSince = "since"
+	FlagUntil = "until"
+)
+
+// EventHistoryCmd returns print schema.EventHistoryEntry objects command.
+func EventHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [eventId]",
+		Short: "Print the changes history of an event (single / recurrent) or of all charge point events within a time range",
+		Example: `history 1
+history 1 --periodic --output json
+history --since 2020-02-21T00:00:00Z --until 2020-02-22T00:00:00Z --output table`,
+		Long: `Arguments:
+  [eventId] - (optional) event ID (as printed by the list command), deleted events history is kept;
+
+Entries are printed oldest first with the operation (create / update / delete / restore / purge), its actor (see the --actor flag)
+and the event snapshots before / after the change (periodic event exception changes are recorded as updates).
+Without the eventId the --charge-point events changes made within the [--since, --until] range are printed.
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			var eventId int64
+			if len(args) > 0 {
+				if eventId, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					logger.Fatal().Str("arg", "eventId").Err(err).Msg("invalid")
+				}
+			}
+
+			isPeriodic, err := cmd.Flags().GetBool(FlagPeriodic)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagPeriodic).Err(err).Msg("invalid")
+			}
+
+			sinceStr, err := cmd.Flags().GetString(FlagSince)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagSince).Err(err).Msg("invalid")
+			}
+			untilStr, err := cmd.Flags().GetString(FlagUntil)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagUntil).Err(err).Msg("invalid")
+			}
+			if eventId == 0 && sinceStr == "" {
+				logger.Fatal().Msgf("eventId argument or --%s flag is required", FlagSince)
+			}
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			outputFormat, err := getOutputFormat(cmd)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOutput).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			var entries []schema.EventHistoryEntry
+			if eventId != 0 {
+				if entries, err = svc.GetEventHistory(context.TODO(), eventId, isPeriodic); err != nil {
+					logger.Fatal().Err(err).Msg("svc.GetEventHistory")
+				}
+			} else {
+				rangeStart, err := time.Parse(time.RFC3339, sinceStr)
+				if err != nil {
+					logger.Fatal().Str("flag", FlagSince).Err(err).Msg("invalid")
+				}
+
+				rangeEnd := time.Now().UTC()
+				if untilStr != "" {
+					if rangeEnd, err = time.Parse(time.RFC3339, untilStr); err != nil {
+						logger.Fatal().Str("flag", FlagUntil).Err(err).Msg("invalid")
+					}
+				}
+
+				if entries, err = svc.GetEventHistoryWithinRange(context.TODO(), chargePointId, rangeStart, rangeEnd); err != nil {
+					logger.Fatal().Err(err).Msg("svc.GetEventHistoryWithinRange")
+				}
+			}
+
+			// Print response
+			if err := output.WriteEventHistory(os.Stdout, outputFormat, entries); err != nil {
+				logger.Fatal().Err(err).Msg("output.WriteEventHistory")
+			}
+		},
+	}
+	cmd.Flags().Bool(FlagPeriodic, false, "(optional) target event is a recurrent (PeriodicEvent) one")
+	cmd.Flags().String(FlagSince, "", "(optional) changes range start dateTime (RFC 3339), used if the eventId is not set")
+	cmd.Flags().String(FlagUntil, "", "(optional) changes range end dateTime (RFC 3339), now by default")
+	addChargePointFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(EventHistoryCmd())
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/ics"
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/service/scheduler"
+)
+
+const (
+	FlagFormat      = "format"
+	FlagOutputFile  = "output-file"
+	FlagDefaultType = "default-type"
+
+	formatICS = "ics"
+)
+
+var (
+	// exportRangeStart / exportRangeEnd define the "all events" export range.
+	exportRangeStart = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	exportRangeEnd   = time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ExportCmd returns export events command.
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   "Export all charge point events (single and recurrent) to an iCalendar (.ics) file",
+		Example: "export --format ics --output-file schedule.ics --charge-point 2",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagFormat).Err(err).Msg("invalid")
+			}
+			if format != formatICS {
+				logger.Fatal().Str("flag", FlagFormat).Msgf("unsupported format: %s", format)
+			}
+
+			outputPath, err := cmd.Flags().GetString(FlagOutputFile)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOutputFile).Err(err).Msg("invalid")
+			}
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			loc := getChargePointLocation(logger, svc, chargePointId)
+
+			sEvents, pEvents, err := svc.GetEvents(context.TODO(), chargePointId, exportRangeStart, exportRangeEnd)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("svc.GetEvents")
+			}
+
+			// Write
+			output := os.Stdout
+			if outputPath != "" {
+				file, err := os.Create(outputPath)
+				if err != nil {
+					logger.Fatal().Str("flag", FlagOutputFile).Err(err).Msg("creating file")
+				}
+				defer file.Close()
+				output = file
+			}
+
+			if err := ics.WriteCalendar(output, loc, sEvents, pEvents); err != nil {
+				logger.Fatal().Err(err).Msg("ics.WriteCalendar")
+			}
+		},
+	}
+	addChargePointFlag(cmd)
+	cmd.Flags().String(FlagFormat, formatICS, "(optional) export format [ics]")
+	cmd.Flags().String(FlagOutputFile, "", "(optional) output file path (stdout if empty)")
+
+	return cmd
+}
+
+// ImportCmd returns import events command.
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [icsFile]",
+		Short: "Import iCalendar (.ics) VEVENTs as charge point events (conflicts are reported per VEVENT)",
+		Example: `import schedule.ics --charge-point 2
+import holidays.ics --default-type Occupied`,
+		Long: `Arguments:
+  [icsFile] - iCalendar file path ("-" for stdin);
+
+Event type is defined by the X-CHARGE-SCHEDULER-TYPE property or CATEGORIES (Available / Occupied), --default-type otherwise.
+Floating (no TZID) dateTimes are defined within the charge point time zone.
+Recurring VEVENTs (RRULE) are imported with their EXDATEs and RECURRENCE-ID (moved occurrence) VEVENTs.
+Already existing (same type, start and duration) events are not duplicated.
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			input := os.Stdin
+			if args[0] != "-" {
+				file, err := os.Open(args[0])
+				if err != nil {
+					logger.Fatal().Str("arg", "icsFile").Err(err).Msg("invalid")
+				}
+				defer file.Close()
+				input = file
+			}
+
+			defaultTypeStr, err := cmd.Flags().GetString(FlagDefaultType)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagDefaultType).Err(err).Msg("invalid")
+			}
+			defaultType := schema.SingleEventType(defaultTypeStr)
+			if !defaultType.IsValid() {
+				logger.Fatal().Str("flag", FlagDefaultType).Msgf("unknown event type: %s", defaultTypeStr)
+			}
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			loc := getChargePointLocation(logger, svc, chargePointId)
+
+			events, err := ics.ParseCalendar(input, loc)
+			if err != nil {
+				logger.Fatal().Str("arg", "icsFile").Err(err).Msg("ics.ParseCalendar")
+			}
+
+			results, err := ics.Import(getActorContext(logger, cmd), svc, chargePointId, defaultType, events)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("ics.Import")
+			}
+
+			// Print response
+			failedCnt := 0
+			for _, result := range results {
+				if result.IsFailed() {
+					failedCnt++
+				}
+				fmt.Println(result.String())
+			}
+			fmt.Printf("VEVENTs: %d, failed: %d\n", len(results), failedCnt)
+
+			if failedCnt > 0 {
+				logger.Fatal().Int("failed", failedCnt).Msg("not all VEVENTs are imported")
+			}
+		},
+	}
+	addChargePointFlag(cmd)
+	cmd.Flags().String(FlagDefaultType, schema.SingleEventTypeAvailable.String(), "(optional) type for VEVENTs without one [Available, Occupied]")
+
+	return cmd
+}
+
+// getChargePointLocation returns an existing charge point time zone location.
+func getChargePointLocation(logger zerolog.Logger, svc scheduler.Scheduler, chargePointId int64) *time.Location {
+	points, err := svc.GetChargePoints(context.TODO())
+	if err != nil {
+		logger.Fatal().Err(err).Msg("svc.GetChargePoints")
+	}
+
+	for _, point := range points {
+		if point.Id != chargePointId {
+			continue
+		}
+
+		loc, err := point.Location()
+		if err != nil {
+			logger.Fatal().Str("timeZone", point.TimeZone).Err(err).Msg("point.Location")
+		}
+
+		return loc
+	}
+	logger.Fatal().Str("flag", FlagChargePoint).Int64("chargePointId", chargePointId).Msg("charge point not found")
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(ExportCmd())
+	rootCmd.AddCommand(ImportCmd())
+}
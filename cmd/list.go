@@ -2,19 +2,28 @@ package main
 
 import (
 	"context"
-	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/output"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	FlagIncludeDeleted = "include-deleted"
 )
 
 // ListEventsCmd returns list events command.
 func ListEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "list [periodStartDateTime] [periodEndDateTime]",
-		Short:   "Print registered events within specified time range",
-		Example: "agenda 2020-02-21T12:00:00Z 72h 30m",
+		Use:   "list [periodStartDateTime] [periodEndDateTime]",
+		Short: "Print registered events within specified time range",
+		Example: `list 2020-02-21T12:00:00Z 2020-02-28T12:00:00Z
+list 2020-02-21T12:00:00Z 2020-02-28T12:00:00Z --output csv
+list 2020-02-21T12:00:00Z 2020-02-28T12:00:00Z --include-deleted`,
 		Long: `Arguments:
   [periodStartDateTime] - period start dateTime (RFC 3339);
   [periodEndDateTime] - period end dateTime (RFC 3339);
@@ -37,22 +46,34 @@ func ListEventsCmd() *cobra.Command {
 				logger.Fatal().Str("arg", "periodEndDateTime").Err(err).Msg("invalid")
 			}
 
+			chargePointId := getChargePointId(logger, cmd)
+
+			includeDeleted, err := cmd.Flags().GetBool(FlagIncludeDeleted)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagIncludeDeleted).Err(err).Msg("invalid")
+			}
+
+			outputFormat, err := getOutputFormat(cmd)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOutput).Err(err).Msg("invalid")
+			}
+
 			// Init dependencies and request
 			svc := getService(logger, cmd)
-			sEvents, pEvents, err := svc.GetEvents(context.TODO(), periodStart, periodEnd)
+			opts := schema.EventsListOptions{IncludeDeleted: includeDeleted}
+			sEvents, pEvents, err := svc.GetEventsWithOptions(context.TODO(), chargePointId, periodStart, periodEnd, opts)
 			if err != nil {
-				logger.Fatal().Err(err).Msg("svc.GetEvents")
+				logger.Fatal().Err(err).Msg("svc.GetEventsWithOptions")
 			}
 
 			// Print response
-			for _, event := range sEvents {
-				fmt.Print(event.String())
-			}
-			for _, event := range pEvents {
-				fmt.Print(event.String())
+			if err := output.WriteEvents(os.Stdout, outputFormat, sEvents, pEvents); err != nil {
+				logger.Fatal().Err(err).Msg("output.WriteEvents")
 			}
 		},
 	}
+	addChargePointFlag(cmd)
+	cmd.Flags().Bool(FlagIncludeDeleted, false, "(optional) list deleted (not purged yet) events as well")
 
 	return cmd
 }
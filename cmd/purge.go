@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagOlderThan = "older-than"
+)
+
+// PurgeEventsCmd returns permanently remove soft-deleted events command.
+func PurgeEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently remove deleted events (those can't be restored afterwards)",
+		Example: `purge
+purge --older-than 24h`,
+		Long: `Events (of all charge points) deleted more than --older-than ago are removed with their exceptions,
+the number of removed events is printed.
+`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			olderThan, err := cmd.Flags().GetDuration(FlagOlderThan)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOlderThan).Err(err).Msg("invalid")
+			}
+			if olderThan < 0 {
+				logger.Fatal().Str("flag", FlagOlderThan).Msg("must be GTE 0")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			cnt, err := svc.PurgeDeletedEvents(context.TODO(), time.Now().UTC().Add(-olderThan))
+			if err != nil {
+				logger.Fatal().Err(err).Msg("svc.PurgeDeletedEvents")
+			}
+
+			// Print response
+			fmt.Printf("Purged events: %d\n", cnt)
+		},
+	}
+	cmd.Flags().Duration(FlagOlderThan, 30*24*time.Hour, "(optional) minimum time passed since the event removal")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(PurgeEventsCmd())
+}
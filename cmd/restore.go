@@ -0,0 +1,64 @@
+package main
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+// RestoreEventCmd returns restore soft-deleted schema.SingleEvent / schema.PeriodicEvent object command.
+func RestoreEventCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "restore [eventId]",
+		Short:   "Restore a deleted (not purged yet) schedule event (single / recurrent)",
+		Example: "restore 1 --periodic",
+		Long: `Arguments:
+  [eventId] - deleted event ID (as printed by the list command with the --include-deleted flag);
+
+Restore fails if the event intersects with events created since its removal.
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			eventId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatal().Str("arg", "eventId").Err(err).Msg("invalid")
+			}
+
+			isPeriodic, err := cmd.Flags().GetBool(FlagPeriodic)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagPeriodic).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			if isPeriodic {
+				if err := svc.RestorePeriodicEvent(ctx, eventId); err != nil {
+					logger.Fatal().Err(err).Msg("svc.RestorePeriodicEvent")
+				}
+			} else {
+				if err := svc.RestoreSingleEvent(ctx, eventId); err != nil {
+					logger.Fatal().Err(err).Msg("svc.RestoreSingleEvent")
+				}
+			}
+			logWarnings(logger, warnings)
+		},
+	}
+	cmd.Flags().Bool(FlagPeriodic, false, "(optional) target event is a recurrent (PeriodicEvent) one")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(RestoreEventCmd())
+}
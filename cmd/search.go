@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/output"
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// FindEarliestSlotCmd returns find the earliest charging slot command.
+func FindEarliestSlotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "earliest [periodStartDateTime] [periodDur]",
+		Short: "Find the earliest charging slot of a specified charging time starting within a specified period",
+		Example: `earliest 2020-02-21T12:00:00Z 72h --charge-duration 2h
+earliest 2020-02-21T12:00:00Z 72h --charge-duration 2h --output json`,
+		Long: `Arguments:
+  [periodStartDateTime] - period start dateTime (RFC 3339);
+  [periodDur] - requested period duration (slot must start within the period, but might end after it);
+`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			periodStart, periodDur := parsePeriodArgs(logger, args)
+
+			chargingDur, err := cmd.Flags().GetDuration(FlagChargeDur)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagChargeDur).Err(err).Msg("invalid")
+			}
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			outputFormat, err := getOutputFormat(cmd)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOutput).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			slot, err := svc.FindEarliestSlot(context.TODO(), chargePointId, periodStart, periodDur, chargingDur)
+			if err != nil {
+				if errors.Is(err, common.ErrNotFound) {
+					logger.Fatal().Err(err).Msg("no slot found, extend the period or reduce the charging duration")
+				}
+				logger.Fatal().Err(err).Msg("svc.FindEarliestSlot")
+			}
+
+			// Print response
+			if err := output.WriteSlots(os.Stdout, outputFormat, schema.TimeSlots{slot}); err != nil {
+				logger.Fatal().Err(err).Msg("output.WriteSlots")
+			}
+		},
+	}
+	cmd.Flags().Duration(FlagChargeDur, 30*time.Minute, "(optional) desired charging duration")
+	addChargePointFlag(cmd)
+
+	return cmd
+}
+
+// GetFreeWindowsCmd returns get free windows command.
+func GetFreeWindowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "windows [periodStartDateTime] [periodDur]",
+		Short: "Get maximal free (available and not occupied) windows with their lengths for a specified period",
+		Example: `windows 2020-02-21T12:00:00Z 240h
+windows 2020-02-21T12:00:00Z 240h --output table`,
+		Long: `Arguments:
+  [periodStartDateTime] - period start dateTime (RFC 3339);
+  [periodDur] - requested period duration (windows are cut by the period);
+`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			periodStart, periodDur := parsePeriodArgs(logger, args)
+
+			chargePointId := getChargePointId(logger, cmd)
+
+			outputFormat, err := getOutputFormat(cmd)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagOutput).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			windows, err := svc.GetFreeWindows(context.TODO(), chargePointId, periodStart, periodDur)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("svc.GetFreeWindows")
+			}
+
+			// Print response
+			if err := output.WriteSlots(os.Stdout, outputFormat, windows); err != nil {
+				logger.Fatal().Err(err).Msg("output.WriteSlots")
+			}
+		},
+	}
+	addChargePointFlag(cmd)
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(FindEarliestSlotCmd())
+	rootCmd.AddCommand(GetFreeWindowsCmd())
+}
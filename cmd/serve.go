@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/api/grpc"
+	"github.com/itiky/charge_scheduler/api/rest"
+	v1 "github.com/itiky/charge_scheduler/service/scheduler/v1"
+)
+
+const (
+	FlagListenAddr      = "listen-addr"
+	FlagGRPCListenAddr  = "grpc-listen-addr"
+	FlagShutdownTimeout = "shutdown-timeout"
+)
+
+// ServeCmd returns start REST API server command.
+func ServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "serve",
+		Short:   "Start REST and (optionally) gRPC API servers (stop gracefully on SIGINT / SIGTERM)",
+		Example: "serve --listen-addr :8080 --grpc-listen-addr :9090",
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			listenAddr, err := cmd.Flags().GetString(FlagListenAddr)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagListenAddr).Err(err).Msg("invalid")
+			}
+
+			grpcListenAddr, err := cmd.Flags().GetString(FlagGRPCListenAddr)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagGRPCListenAddr).Err(err).Msg("invalid")
+			}
+
+			shutdownTimeout, err := cmd.Flags().GetDuration(FlagShutdownTimeout)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagShutdownTimeout).Err(err).Msg("invalid")
+			}
+
+			// Init dependencies and start
+			svc := getService(logger, cmd)
+			server, err := rest.NewServer(logger, svc)
+			if err != nil {
+				logger.Fatal().Err(err).Msg("restServer init")
+			}
+
+			serveErrCh := make(chan error, 2)
+			go func() {
+				serveErrCh <- server.ListenAndServe(listenAddr)
+			}()
+
+			var grpcServer *grpc.Server
+			if grpcListenAddr != "" {
+				grpcServer, err = grpc.NewServer(logger, svc)
+				if err != nil {
+					logger.Fatal().Err(err).Msg("grpcServer init")
+				}
+
+				go func() {
+					serveErrCh <- grpcServer.ListenAndServe(grpcListenAddr)
+				}()
+			}
+
+			// Wait for a stop signal or a server failure
+			signalCh := make(chan os.Signal, 1)
+			signal.Notify(signalCh, syscall.SIGINT, syscall.SIGTERM)
+
+			select {
+			case sig := <-signalCh:
+				logger.Info().Str("signal", sig.String()).Msg("shutting down")
+			case err := <-serveErrCh:
+				logger.Fatal().Err(err).Msg("server.ListenAndServe")
+			}
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer ctxCancel()
+			if grpcServer != nil {
+				if err := grpcServer.Shutdown(ctx); err != nil {
+					logger.Fatal().Err(err).Msg("grpcServer.Shutdown")
+				}
+			}
+			if err := server.Shutdown(ctx); err != nil {
+				logger.Fatal().Err(err).Msg("restServer.Shutdown")
+			}
+
+			if cachedSvc, ok := svc.(*v1.Scheduler); ok {
+				stats := cachedSvc.OccurrenceCacheStats()
+				logger.Info().
+					Uint64("hits", stats.Hits).
+					Uint64("misses", stats.Misses).
+					Uint64("evictions", stats.Evictions).
+					Int("entries", stats.Entries).
+					Int64("size_bytes", stats.SizeBytes).
+					Msg("occurrence cache stats")
+			}
+		},
+	}
+	cmd.Flags().String(FlagListenAddr, ":8080", "(optional) HTTP listen address")
+	cmd.Flags().String(FlagGRPCListenAddr, "", "(optional) gRPC listen address (disabled if empty)")
+	cmd.Flags().Duration(FlagShutdownTimeout, 10*time.Second, "(optional) graceful shutdown timeout for active requests")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(ServeCmd())
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	FlagPeriodic = "periodic"
+	FlagVersion  = "version"
+)
+
+// UpdateEventCmd returns update schema.SingleEvent / schema.PeriodicEvent object command.
+func UpdateEventCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [eventId] [scheduleType] [eventStartDateTime] [eventEnd]",
+		Short: "Update a schedule event (single / recurrent)",
+		Example: `update 1 Available 2020-02-21T12:00:00Z 15:30 --periodic --version 1
+update 1 Available 2020-02-21T12:00:00Z 15:30 --periodic --version 2 --rrule "FREQ=WEEKLY;INTERVAL=2"`,
+		Long: `Arguments:
+  [eventId] - event ID (as printed by the list command);
+  [scheduleType] - schedule type (Available / Occupied);
+  [eventStartDateTime] - event start dateTime (RFC 3339);
+  [eventEnd] - event end: duration (8h30m), dateTime (RFC 3339) or charge point wall-clock time (HH:MM, the next day if not after the {eventStartDateTime} one);
+
+The --version (as printed by the list command) is required, the update fails if the event was changed since.
+`,
+		Args: cobra.ExactArgs(4),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger, err := getLogger(cmd)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			// Parse inputs
+			eventId, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatal().Str("arg", "eventId").Err(err).Msg("invalid")
+			}
+
+			eventType := schema.SingleEventType(args[1])
+			if !eventType.IsValid() {
+				logger.Fatal().Str("arg", "scheduleType").Msg("invalid")
+			}
+
+			eventStart, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				logger.Fatal().Str("arg", "eventStartDateTime").Err(err).Msg("invalid")
+			}
+
+			version := getEventVersion(logger, cmd)
+
+			isPeriodic, err := cmd.Flags().GetBool(FlagPeriodic)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagPeriodic).Err(err).Msg("invalid")
+			}
+
+			rruleStr, err := cmd.Flags().GetString(FlagRRule)
+			if err != nil {
+				logger.Fatal().Str("flag", FlagRRule).Err(err).Msg("invalid")
+			}
+			if rruleStr != "" && !isPeriodic {
+				logger.Fatal().Str("flag", FlagRRule).Msgf("can only be used with the %s flag", FlagPeriodic)
+			}
+
+			// Init dependencies and request
+			svc := getService(logger, cmd)
+			eventDur, err := parseEventEnd(eventStart, args[3], getEventLocation(logger, svc, eventId, isPeriodic))
+			if err != nil {
+				logger.Fatal().Str("arg", "eventEnd").Err(err).Msg("invalid")
+			}
+
+			var warnings common.Warnings
+			ctx := common.WithWarnings(getActorContext(logger, cmd), &warnings)
+			if rruleStr != "" {
+				if err := svc.UpdatePeriodicEventWithRule(ctx, eventId, version, eventType, eventStart, rruleStr, eventDur); err != nil {
+					fatalEventChange(logger, err, "svc.UpdatePeriodicEventWithRule")
+				}
+			} else if isPeriodic {
+				if err := svc.UpdatePeriodicEvent(ctx, eventId, version, eventType, eventStart, eventDur); err != nil {
+					fatalEventChange(logger, err, "svc.UpdatePeriodicEvent")
+				}
+			} else {
+				if err := svc.UpdateSingleEvent(ctx, eventId, version, eventType, eventStart, eventDur); err != nil {
+					fatalEventChange(logger, err, "svc.UpdateSingleEvent")
+				}
+			}
+			logWarnings(logger, warnings)
+		},
+	}
+	cmd.Flags().Bool(FlagPeriodic, false, "(optional) target event is a recurrent (PeriodicEvent) one")
+	addEventVersionFlag(cmd)
+	cmd.Flags().String(FlagRRule, "", "(optional) replace the recurrent event period with RFC 5545 RRULE (the current one is kept otherwise)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(UpdateEventCmd())
+}
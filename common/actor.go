@@ -0,0 +1,22 @@
+package common
+
+import "context"
+
+// ActorUnknown is the actor of changes made without an actor set.
+const ActorUnknown = "unknown"
+
+type actorCtxKey struct{}
+
+// WithActor returns the ctx copy carrying the actor (user / client reference) the changes are made by.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorCtxKey{}, actor)
+}
+
+// ActorFromContext returns the ctx actor (ActorUnknown if not set or empty).
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorCtxKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	return ActorUnknown
+}
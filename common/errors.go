@@ -3,5 +3,8 @@ package common
 import "fmt"
 
 var (
-	ErrInvalidInput = fmt.Errorf("invalid input")
+	ErrInvalidInput    = fmt.Errorf("invalid input")
+	ErrNotFound        = fmt.Errorf("not found")
+	ErrSlotUnavailable = fmt.Errorf("slot unavailable")
+	ErrVersionConflict = fmt.Errorf("version conflict")
 )
@@ -0,0 +1,21 @@
+package common
+
+import "context"
+
+// Warnings are non-critical issues of an operation that has succeeded (those are never returned as an error).
+// Use WithWarnings to collect them.
+type Warnings []string
+
+type warningsCtxKey struct{}
+
+// WithWarnings returns the ctx copy collecting the succeeded operations warnings to the warnings (appended).
+func WithWarnings(ctx context.Context, warnings *Warnings) context.Context {
+	return context.WithValue(ctx, warningsCtxKey{}, warnings)
+}
+
+// AddWarnings appends warnings to the ctx collector (dropped if not set, see WithWarnings).
+func AddWarnings(ctx context.Context, warnings ...string) {
+	if collector, ok := ctx.Value(warningsCtxKey{}).(*Warnings); ok && collector != nil {
+		*collector = append(*collector, warnings...)
+	}
+}
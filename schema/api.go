@@ -17,9 +17,29 @@ type (
 	TimeSlot struct {
 		Start    time.Time
 		Duration time.Duration
+		// EnergyKWh is the expected energy delivered within the slot (energy-based agendas only).
+		EnergyKWh float64
 	}
 
 	AgendaResults []AgendaResult
+
+	// TimeSlots is a list of time slots / free windows.
+	TimeSlots []TimeSlot
+
+	// AgendaOptions defines agenda time slots granularity.
+	AgendaOptions struct {
+		// Step is the interval between consecutive slot starts within an available window (0 - the desired duration, back-to-back slots).
+		// Slots overlap if Step is less than the desired duration.
+		Step time.Duration
+		// Align snaps slot starts to the charge point local clock boundaries (multiples of Align since midnight, 0 - disabled).
+		Align time.Duration
+	}
+
+	// EventsListOptions defines the listed events filter.
+	EventsListOptions struct {
+		// IncludeDeleted adds soft-deleted (restorable) events.
+		IncludeDeleted bool
+	}
 )
 
 func (r AgendaResults) String() string {
@@ -31,6 +51,21 @@ func (r AgendaResults) String() string {
 	return str.String()
 }
 
+func (s TimeSlots) String() string {
+	str := strings.Builder{}
+	if len(s) == 0 {
+		str.WriteString("Slots: none\n")
+		return str.String()
+	}
+
+	str.WriteString("Slots:\n")
+	for _, slot := range s {
+		str.WriteString(fmt.Sprintf("  - %s -> %s (%s)\n", slot.Start.Format(common.TimeFmt), slot.Start.Add(slot.Duration).Format(common.TimeFmt), slot.Duration))
+	}
+
+	return str.String()
+}
+
 func (r AgendaResult) String() string {
 	str := strings.Builder{}
 	str.WriteString("Agenda:\n")
@@ -40,6 +75,10 @@ func (r AgendaResult) String() string {
 	} else {
 		str.WriteString("  Slots:\n")
 		for _, slot := range r.TimeSlots {
+			if slot.EnergyKWh > 0 {
+				str.WriteString(fmt.Sprintf("  - %s -> %s (%g kWh)\n", slot.Start.Format(common.TimeFmt), slot.Duration, slot.EnergyKWh))
+				continue
+			}
 			str.WriteString(fmt.Sprintf("  - %s -> %s\n", slot.Start.Format(common.TimeFmt), slot.Duration))
 		}
 	}
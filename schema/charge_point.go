@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+const (
+	// DefaultChargePointId is the charge point ID pre-existing events are attached to.
+	DefaultChargePointId int64 = 1
+	// DefaultTimeZone is the charge point time zone used if not specified.
+	DefaultTimeZone = "UTC"
+)
+
+// ChargePoint defines a charging station.
+// TimeZone is an IANA time zone name events are scheduled within (recurrences expanded, agenda days cut).
+// MaxPowerKW is the charger max power used for energy-based agendas (0 if unknown).
+type ChargePoint struct {
+	Id              int64     `json:"id"`
+	Name            string    `json:"name"`
+	Site            string    `json:"site"`
+	ConnectorsCount uint      `json:"connectors_count"`
+	TimeZone        string    `json:"time_zone"`
+	MaxPowerKW      float64   `json:"max_power_kw"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Location returns the charge point time zone location.
+func (p ChargePoint) Location() (*time.Location, error) {
+	return time.LoadLocation(p.TimeZone)
+}
+
+func (p ChargePoint) String() string {
+	str := strings.Builder{}
+	str.WriteString("ChargePoint:\n")
+	str.WriteString(fmt.Sprintf("  Id: %d\n", p.Id))
+	str.WriteString(fmt.Sprintf("  Name: %s\n", p.Name))
+	str.WriteString(fmt.Sprintf("  Site: %s\n", p.Site))
+	str.WriteString(fmt.Sprintf("  Connectors: %d\n", p.ConnectorsCount))
+	str.WriteString(fmt.Sprintf("  TimeZone: %s\n", p.TimeZone))
+	if p.MaxPowerKW > 0 {
+		str.WriteString(fmt.Sprintf("  MaxPower: %g kW\n", p.MaxPowerKW))
+	}
+	str.WriteString(fmt.Sprintf("  CreatedAt: %s\n", p.CreatedAt.Format(common.TimeFmt)))
+
+	return str.String()
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type (
+	// EnergyRequest defines an energy-based charging request.
+	// Taper model (charging power decreases over the last 20% of the state of charge) is applied if BatteryCapacityKWh is set.
+	EnergyRequest struct {
+		// EnergyKWh is the requested energy.
+		EnergyKWh float64
+		// VehicleMaxPowerKW is the vehicle max charge rate (0 if not limited by the vehicle).
+		VehicleMaxPowerKW float64
+		// BatteryCapacityKWh is the vehicle battery capacity (0 disables the taper model).
+		BatteryCapacityKWh float64
+		// StartSoCPercent is the vehicle state of charge at the charging start [0, 100).
+		StartSoCPercent float64
+	}
+
+	// EnergyAgendaResults is the energy-based agenda: estimated charging parameters and slots providing the requested energy.
+	EnergyAgendaResults struct {
+		EnergyKWh float64
+		// PowerKW is the lower of the charge point and vehicle max powers.
+		PowerKW        float64
+		ChargeDuration time.Duration
+		Agenda         AgendaResults
+	}
+)
+
+func (r EnergyAgendaResults) String() string {
+	str := strings.Builder{}
+	str.WriteString("Charge:\n")
+	str.WriteString(fmt.Sprintf("  Energy: %g kWh\n", r.EnergyKWh))
+	str.WriteString(fmt.Sprintf("  Power: %g kW\n", r.PowerKW))
+	str.WriteString(fmt.Sprintf("  Duration: %s\n", r.ChargeDuration))
+	str.WriteString(r.Agenda.String())
+
+	return str.String()
+}
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -13,11 +14,16 @@ import (
 type (
 	SingleEvent struct {
 		Id            int64           `json:"id"`
+		ChargePointId int64           `json:"charge_point_id"`
 		Type          SingleEventType `json:"type"`
 		StartDateTime time.Time       `json:"start_date_time"`
-		EndHours      uint            `json:"end_hours"`
-		EndMinutes    uint            `json:"end_minutes"`
+		Duration      time.Duration   `json:"duration"`
+		OwnerRef      string          `json:"owner_ref,omitempty"`
 		CreatedAt     time.Time       `json:"created_at"`
+		// Version is the optimistic concurrency version (1 for a new event, incremented on every update).
+		Version int64 `json:"version"`
+		// DeletedAt is set for soft-deleted (restorable) events.
+		DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	}
 
 	SingleEventType string
@@ -41,35 +47,188 @@ func (t SingleEventType) String() string {
 	return string(t)
 }
 
+// EndDateTime returns the event end dateTime.
+func (e SingleEvent) EndDateTime() time.Time {
+	return e.StartDateTime.Add(e.Duration)
+}
+
 func (e SingleEvent) String() string {
 	str := strings.Builder{}
 	str.WriteString("SingleEvent:\n")
 	str.WriteString(fmt.Sprintf("  Id: %d\n", e.Id))
+	str.WriteString(fmt.Sprintf("  ChargePointId: %d\n", e.ChargePointId))
 	str.WriteString(fmt.Sprintf("  Type: %s\n", e.Type.String()))
 	str.WriteString(fmt.Sprintf("  Start: %s\n", e.StartDateTime.Format(common.TimeFmt)))
-	str.WriteString(fmt.Sprintf("  End: %02d:%02d\n", e.EndHours, e.EndMinutes))
+	str.WriteString(fmt.Sprintf("  End: %s (%s)\n", e.EndDateTime().Format(common.TimeFmt), e.Duration))
+	if e.OwnerRef != "" {
+		str.WriteString(fmt.Sprintf("  OwnerRef: %s\n", e.OwnerRef))
+	}
 	str.WriteString(fmt.Sprintf("  CreatedAt: %s\n", e.CreatedAt.Format(common.TimeFmt)))
+	str.WriteString(fmt.Sprintf("  Version: %d\n", e.Version))
+	if e.DeletedAt != nil {
+		str.WriteString(fmt.Sprintf("  DeletedAt: %s\n", e.DeletedAt.Format(common.TimeFmt)))
+	}
 
 	return str.String()
 }
 
 type PeriodicEvent struct {
-	Id         int64           `json:"id"`
-	Type       SingleEventType `json:"type"`
-	Rrule      rrule.RRule     `json:"rrule"`
-	EndHours   uint            `json:"end_hours"`
-	EndMinutes uint            `json:"end_minutes"`
-	CreatedAt  time.Time       `json:"created_at"`
+	Id            int64           `json:"id"`
+	ChargePointId int64           `json:"charge_point_id"`
+	Type          SingleEventType `json:"type"`
+	Rrule         rrule.RRule     `json:"rrule"`
+	Duration      time.Duration   `json:"duration"`
+	CreatedAt     time.Time       `json:"created_at"`
+	// Version is the optimistic concurrency version (1 for a new event, incremented on every update).
+	Version int64 `json:"version"`
+	// DeletedAt is set for soft-deleted (restorable) events.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Exceptions are skipped / overridden occurrences (read-only, managed separately).
+	Exceptions []PeriodicEventException `json:"exceptions,omitempty"`
 }
 
 func (e PeriodicEvent) String() string {
 	str := strings.Builder{}
 	str.WriteString("PeriodicEvent:\n")
 	str.WriteString(fmt.Sprintf("  Id: %d\n", e.Id))
+	str.WriteString(fmt.Sprintf("  ChargePointId: %d\n", e.ChargePointId))
 	str.WriteString(fmt.Sprintf("  Type: %s\n", e.Type.String()))
 	str.WriteString(fmt.Sprintf("  RRule: %s\n", e.Rrule.String()))
-	str.WriteString(fmt.Sprintf("  End: %02d:%02d\n", e.EndHours, e.EndMinutes))
+	str.WriteString(fmt.Sprintf("  Duration: %s\n", e.Duration))
 	str.WriteString(fmt.Sprintf("  CreatedAt: %s\n", e.CreatedAt.Format(common.TimeFmt)))
+	str.WriteString(fmt.Sprintf("  Version: %d\n", e.Version))
+	if e.DeletedAt != nil {
+		str.WriteString(fmt.Sprintf("  DeletedAt: %s\n", e.DeletedAt.Format(common.TimeFmt)))
+	}
+	for _, exception := range e.Exceptions {
+		str.WriteString(fmt.Sprintf("  - Exception: %s\n", exception.String()))
+	}
 
 	return str.String()
 }
+
+// periodicEventJSON is the PeriodicEvent JSON representation (the rule is an RFC 5545 string with DTSTART).
+type periodicEventJSON struct {
+	Id            int64                    `json:"id"`
+	ChargePointId int64                    `json:"charge_point_id"`
+	Type          SingleEventType          `json:"type"`
+	Rrule         string                   `json:"rrule"`
+	Duration      time.Duration            `json:"duration"`
+	CreatedAt     time.Time                `json:"created_at"`
+	Version       int64                    `json:"version"`
+	DeletedAt     *time.Time               `json:"deleted_at,omitempty"`
+	Exceptions    []PeriodicEventException `json:"exceptions,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler (rrule.RRule can't be encoded as is).
+func (e PeriodicEvent) MarshalJSON() ([]byte, error) {
+	return json.Marshal(periodicEventJSON{
+		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
+		Type:          e.Type,
+		Rrule:         e.Rrule.String(),
+		Duration:      e.Duration,
+		CreatedAt:     e.CreatedAt,
+		Version:       e.Version,
+		DeletedAt:     e.DeletedAt,
+		Exceptions:    e.Exceptions,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *PeriodicEvent) UnmarshalJSON(data []byte) error {
+	var obj periodicEventJSON
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	rule, err := rrule.StrToRRule(obj.Rrule)
+	if err != nil {
+		return fmt.Errorf("%s: parsing: %w", "rrule", err)
+	}
+
+	*e = PeriodicEvent{
+		Id:            obj.Id,
+		ChargePointId: obj.ChargePointId,
+		Type:          obj.Type,
+		Rrule:         *rule,
+		Duration:      obj.Duration,
+		CreatedAt:     obj.CreatedAt,
+		Version:       obj.Version,
+		DeletedAt:     obj.DeletedAt,
+		Exceptions:    obj.Exceptions,
+	}
+
+	return nil
+}
+
+// ActiveRange returns the range covering all the event occurrences (moved ones included).
+// End is zero for endless (no COUNT / UNTIL) rules.
+func (e PeriodicEvent) ActiveRange() (retStart, retEnd time.Time) {
+	retStart = e.Rrule.OrigOptions.Dtstart
+	if e.Rrule.OrigOptions.Count > 0 || !e.Rrule.OrigOptions.Until.IsZero() {
+		next := e.Rrule.Iterator()
+		for occurrenceStart, ok := next(); ok; occurrenceStart, ok = next() {
+			retEnd = occurrenceStart.Add(e.Duration)
+		}
+	}
+
+	for _, exception := range e.Exceptions {
+		if !exception.IsOverride() {
+			continue
+		}
+
+		if exception.OverrideStart.Before(retStart) {
+			retStart = *exception.OverrideStart
+		}
+		if overrideEnd := exception.OverrideStart.Add(exception.OverrideDuration); !retEnd.IsZero() && overrideEnd.After(retEnd) {
+			retEnd = overrideEnd
+		}
+	}
+
+	return
+}
+
+// PeriodicEventException alters a single PeriodicEvent occurrence: skips it (EXDATE) or overrides its time (RDATE).
+type PeriodicEventException struct {
+	Id              int64 `json:"id"`
+	PeriodicEventId int64 `json:"periodic_event_id"`
+	// OccurrenceStart is the original (rule generated) occurrence start.
+	OccurrenceStart time.Time `json:"occurrence_start"`
+	// OverrideStart is the new occurrence start (nil if the occurrence is skipped).
+	OverrideStart    *time.Time    `json:"override_start,omitempty"`
+	OverrideDuration time.Duration `json:"override_duration,omitempty"`
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+// IsOverride checks if the occurrence is moved / resized (not skipped).
+func (e PeriodicEventException) IsOverride() bool {
+	return e.OverrideStart != nil
+}
+
+func (e PeriodicEventException) String() string {
+	if !e.IsOverride() {
+		return fmt.Sprintf("[%d] %s: skipped", e.Id, e.OccurrenceStart.Format(common.TimeFmt))
+	}
+
+	return fmt.Sprintf("[%d] %s: moved to %s (%s)", e.Id, e.OccurrenceStart.Format(common.TimeFmt), e.OverrideStart.Format(common.TimeFmt), e.OverrideDuration)
+}
+
+// EventsChange describes charge point events created / updated / removed within the range.
+// Range end is zero for endless periodic events.
+type EventsChange struct {
+	ChargePointId int64
+	// Periodic is set for periodic events (and their occurrences) changes.
+	Periodic   bool
+	RangeStart time.Time
+	RangeEnd   time.Time
+}
+
+// Intersects checks if the change affects the [rangeStart, rangeEnd) range.
+func (c EventsChange) Intersects(rangeStart, rangeEnd time.Time) bool {
+	if !c.RangeEnd.IsZero() && !c.RangeEnd.After(rangeStart) {
+		return false
+	}
+
+	return c.RangeStart.Before(rangeEnd)
+}
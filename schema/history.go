@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+type (
+	// EventHistoryEntry is an append-only record of a single / periodic event change.
+	// Before / After are the event JSON snapshots (SingleEvent / PeriodicEvent with its exceptions), Before is nil for
+	// the create / restore operations, After is nil for the delete / purge ones. Periodic event exception changes are recorded as updates.
+	EventHistoryEntry struct {
+		Id            int64           `json:"id"`
+		EventId       int64           `json:"event_id"`
+		Periodic      bool            `json:"periodic"`
+		ChargePointId int64           `json:"charge_point_id"`
+		Operation     EventOperation  `json:"operation"`
+		Actor         string          `json:"actor"`
+		Before        json.RawMessage `json:"before,omitempty"`
+		After         json.RawMessage `json:"after,omitempty"`
+		CreatedAt     time.Time       `json:"created_at"`
+	}
+
+	EventOperation string
+)
+
+const (
+	EventOperationCreate  EventOperation = "create"
+	EventOperationUpdate  EventOperation = "update"
+	EventOperationDelete  EventOperation = "delete"
+	EventOperationRestore EventOperation = "restore"
+	EventOperationPurge   EventOperation = "purge"
+)
+
+func (o EventOperation) IsValid() bool {
+	switch o {
+	case EventOperationCreate, EventOperationUpdate, EventOperationDelete, EventOperationRestore, EventOperationPurge:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o EventOperation) String() string {
+	return string(o)
+}
+
+func (e EventHistoryEntry) String() string {
+	eventKind := "single"
+	if e.Periodic {
+		eventKind = "periodic"
+	}
+
+	str := strings.Builder{}
+	str.WriteString("EventHistoryEntry:\n")
+	str.WriteString(fmt.Sprintf("  Id: %d\n", e.Id))
+	str.WriteString(fmt.Sprintf("  Event: %s %d\n", eventKind, e.EventId))
+	str.WriteString(fmt.Sprintf("  ChargePointId: %d\n", e.ChargePointId))
+	str.WriteString(fmt.Sprintf("  Operation: %s\n", e.Operation.String()))
+	str.WriteString(fmt.Sprintf("  Actor: %s\n", e.Actor))
+	if e.Before != nil {
+		str.WriteString(fmt.Sprintf("  Before: %s\n", e.Before))
+	}
+	if e.After != nil {
+		str.WriteString(fmt.Sprintf("  After: %s\n", e.After))
+	}
+	str.WriteString(fmt.Sprintf("  CreatedAt: %s\n", e.CreatedAt.Format(common.TimeFmt)))
+
+	return str.String()
+}
@@ -7,13 +7,82 @@ import (
 	"github.com/itiky/charge_scheduler/schema"
 )
 
+// Scheduler manages charge points events and agendas.
+// Event change methods might report common.Warnings for an accepted change to the ctx collector (see common.WithWarnings and v1.OccupancyPolicy).
+// Event changes are recorded to the events history along with the ctx actor (see common.WithActor).
+// Event update / delete (and periodic event exception) methods require the event version known by the caller (optimistic concurrency),
+// common.ErrVersionConflict is returned if the event was changed since (exception changes increment the periodic event version).
 type Scheduler interface {
-	// AddPeriodicEvent creates a new non-intersecting with existing events schema.SingleEvent.
-	AddSingleEvent(ctx context.Context, eventType schema.SingleEventType, eventStart time.Time, endDayHours, endDayMinutes uint) error
-	// AddPeriodicEvent creates a new non-intersecting with existing events schema.PeriodicEvent with weekly period.
-	AddPeriodicEvent(ctx context.Context, eventType schema.SingleEventType, eventStart time.Time, endDayHours, endDayMinutes uint) error
-	// GetAvailableAgenda returns available charging slots for specified period and desired charging duration.
-	GetAvailableAgenda(ctx context.Context, periodStart time.Time, periodDur, desiredDur time.Duration) (schema.AgendaResults, error)
-	// GetEvents returns registered within specified range singleEvents and all available periodic events.
-	GetEvents(ctx context.Context, periodStart, periodEnd time.Time) ([]schema.SingleEvent, []schema.PeriodicEvent, error)
+	// CreateChargePoint creates a new schema.ChargePoint within the IANA time zone (UTC if empty) and returns its ID.
+	// maxPowerKW is the charger max power (0 if unknown).
+	CreateChargePoint(ctx context.Context, name, site string, connectorsCount uint, timeZone string, maxPowerKW float64) (int64, error)
+	// GetChargePoints returns all registered charge points.
+	GetChargePoints(ctx context.Context) ([]schema.ChargePoint, error)
+	// AddSingleEvent creates a new non-intersecting with existing charge point events schema.SingleEvent.
+	// Event might span midnight and multiple days (eventDur is limited to 31 days).
+	AddSingleEvent(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error
+	// AddPeriodicEvent creates a new non-intersecting with existing charge point events schema.PeriodicEvent with weekly period.
+	AddPeriodicEvent(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error
+	// AddPeriodicEventWithRule creates a new non-intersecting with existing charge point events schema.PeriodicEvent with an RFC 5545 RRULE period.
+	// rruleStr must not contain DTSTART (eventStart is used), sub-daily recurrences are not supported.
+	AddPeriodicEventWithRule(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, rruleStr string, eventDur time.Duration) error
+	// UpdateSingleEvent alters an existing schema.SingleEvent keeping it non-intersecting with other charge point events.
+	UpdateSingleEvent(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error
+	// UpdatePeriodicEvent alters an existing schema.PeriodicEvent (keeping its period) keeping it non-intersecting with other charge point events.
+	UpdatePeriodicEvent(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error
+	// UpdatePeriodicEventWithRule alters an existing schema.PeriodicEvent replacing its period with an RFC 5545 RRULE.
+	UpdatePeriodicEventWithRule(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, rruleStr string, eventDur time.Duration) error
+	// DeleteSingleEvent soft-deletes an existing schema.SingleEvent (it might be restored until purged).
+	DeleteSingleEvent(ctx context.Context, eventId, version int64) error
+	// DeletePeriodicEvent soft-deletes an existing schema.PeriodicEvent with its exceptions (it might be restored until purged).
+	DeletePeriodicEvent(ctx context.Context, eventId, version int64) error
+	// RestoreSingleEvent restores a soft-deleted schema.SingleEvent checking it is still non-intersecting with other charge point events.
+	RestoreSingleEvent(ctx context.Context, eventId int64) error
+	// RestorePeriodicEvent restores a soft-deleted schema.PeriodicEvent checking it (and its moved occurrences) is still
+	// non-intersecting with other charge point events.
+	RestorePeriodicEvent(ctx context.Context, eventId int64) error
+	// PurgeDeletedEvents permanently removes events soft-deleted before deletedBefore (recording them to the history)
+	// and returns the number of removed events.
+	PurgeDeletedEvents(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// AddPeriodicEventException skips a single schema.PeriodicEvent occurrence (EXDATE) and returns the exception ID.
+	AddPeriodicEventException(ctx context.Context, periodicEventId, version int64, occurrenceStart time.Time) (int64, error)
+	// AddPeriodicEventOverride moves / resizes a single schema.PeriodicEvent occurrence keeping it non-intersecting with other charge point events.
+	// Returns the exception ID.
+	AddPeriodicEventOverride(ctx context.Context, periodicEventId, version int64, occurrenceStart, overrideStart time.Time, overrideDur time.Duration) (int64, error)
+	// RemovePeriodicEventException removes a schema.PeriodicEventException restoring the original occurrence.
+	// version is the exception periodic event one.
+	RemovePeriodicEventException(ctx context.Context, exceptionId, version int64) error
+	// BookSlot atomically checks that the slot is within a free availability window and creates an Occupied schema.SingleEvent for it.
+	// Returns the booking (event) ID or common.ErrSlotUnavailable if the slot can't be booked.
+	BookSlot(ctx context.Context, chargePointId int64, slotStart time.Time, slotDur time.Duration, ownerRef string) (int64, error)
+	// GetAvailableAgenda returns available charge point charging slots for specified period and desired charging duration.
+	GetAvailableAgenda(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration) (schema.AgendaResults, error)
+	// GetAvailableAgendaWithOptions returns available charge point charging slots for specified period and desired charging duration
+	// with the slot starts step and alignment.
+	GetAvailableAgendaWithOptions(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration, opts schema.AgendaOptions) (schema.AgendaResults, error)
+	// GetAvailableEnergyAgenda returns available charge point charging slots for specified period and requested energy.
+	// Charging duration is estimated using the lower of the charge point and vehicle max powers (with an optional taper model).
+	GetAvailableEnergyAgenda(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur time.Duration, request schema.EnergyRequest, opts schema.AgendaOptions) (schema.EnergyAgendaResults, error)
+	// FindEarliestSlot returns the earliest charge point charging slot of desired duration starting within specified period.
+	// Returns common.ErrNotFound if there is no such slot.
+	FindEarliestSlot(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration) (schema.TimeSlot, error)
+	// GetFreeWindows returns maximal free (available and not occupied) charge point windows within specified period.
+	// Windows are cut by the period.
+	GetFreeWindows(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur time.Duration) ([]schema.TimeSlot, error)
+	// GetEvents returns registered within specified range charge point singleEvents and all available periodic events.
+	GetEvents(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time) ([]schema.SingleEvent, []schema.PeriodicEvent, error)
+	// GetEventsWithOptions returns registered within specified range charge point singleEvents and all periodic events
+	// with soft-deleted ones if requested.
+	GetEventsWithOptions(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time, opts schema.EventsListOptions) ([]schema.SingleEvent, []schema.PeriodicEvent, error)
+	// GetSingleEvent returns an existing (not deleted) schema.SingleEvent by ID (common.ErrNotFound if not found).
+	GetSingleEvent(ctx context.Context, eventId int64) (schema.SingleEvent, error)
+	// GetPeriodicEvent returns an existing (not deleted) schema.PeriodicEvent with its exceptions by ID (common.ErrNotFound if not found).
+	GetPeriodicEvent(ctx context.Context, eventId int64) (schema.PeriodicEvent, error)
+	// GetEventHistory returns the single / periodic event changes history (deleted events included), oldest first.
+	GetEventHistory(ctx context.Context, eventId int64, periodic bool) ([]schema.EventHistoryEntry, error)
+	// GetEventHistoryWithinRange returns the charge point events changes made within the [rangeStart, rangeEnd] range, oldest first.
+	GetEventHistoryWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) ([]schema.EventHistoryEntry, error)
+	// WatchEvents subscribes to charge point events changes intersecting the [rangeStart, rangeEnd) range.
+	// Notifications are coalesced (a pending one is not duplicated), the channel is closed once ctx is done.
+	WatchEvents(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (<-chan struct{}, error)
 }
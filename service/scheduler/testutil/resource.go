@@ -2,10 +2,12 @@ package testutil
 
 import (
 	"github.com/itiky/charge_scheduler/service/scheduler"
+	cpTestutil "github.com/itiky/charge_scheduler/storage/chargepoints/testutil"
 	"github.com/itiky/charge_scheduler/storage/events/testutil"
 )
 
 type SchedulerServiceTestResource struct {
-	Svc        scheduler.Scheduler
-	StorageRes *testutil.EventsStorageTestResource
+	Svc                    scheduler.Scheduler
+	StorageRes             *testutil.EventsStorageTestResource
+	ChargePointsStorageRes *cpTestutil.ChargePointsStorageTestResource
 }
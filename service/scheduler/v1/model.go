@@ -7,10 +7,24 @@ import (
 )
 
 type event struct {
-	Id    int64
-	Type  schema.SingleEventType
-	Start time.Time
-	End   time.Time
-	Prev  *event
-	Next  *event
+	Id       int64
+	Periodic bool
+	Type     schema.SingleEventType
+	Start    time.Time
+	End      time.Time
+	Prev     *event
+	Next     *event
+}
+
+// eventKey identifies a stored event (single and periodic events have independent IDs).
+type eventKey struct {
+	Id       int64
+	Periodic bool
+}
+
+func (e event) Key() eventKey {
+	return eventKey{
+		Id:       e.Id,
+		Periodic: e.Periodic,
+	}
 }
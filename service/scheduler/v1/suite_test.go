@@ -14,12 +14,14 @@ import (
 type ServiceTestSuite struct {
 	suite.Suite
 	ctx    context.Context
+	tmpDir string
 	baseSt *sqlite_base.SQLiteBase
 	r      *testutil.SchedulerServiceTestResource
 }
 
 func (s *ServiceTestSuite) SetupSuite() {
-	baseSt, err := sqlite_base.SetupTempSQLiteBase(s.T().TempDir())
+	tmpDir := s.T().TempDir()
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(tmpDir)
 	if err != nil {
 		panic(fmt.Errorf("base storage init: %w", err))
 	}
@@ -30,6 +32,7 @@ func (s *ServiceTestSuite) SetupSuite() {
 	}
 
 	s.ctx = context.TODO()
+	s.tmpDir = tmpDir
 	s.baseSt = baseSt
 	s.r = r
 }
@@ -1,28 +1,71 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/rs/zerolog"
 
 	"github.com/itiky/charge_scheduler/service/scheduler"
+	"github.com/itiky/charge_scheduler/storage/chargepoints"
 	"github.com/itiky/charge_scheduler/storage/events"
 )
 
 var _ scheduler.Scheduler = (*Scheduler)(nil)
 
 type Scheduler struct {
-	logger   zerolog.Logger
-	eventsSt events.EventsStorage
+	logger          zerolog.Logger
+	eventsSt        events.EventsStorage
+	chargePointsSt  chargepoints.ChargePointsStorage
+	watcher         *eventsWatcher
+	occupancyPolicy OccupancyPolicy
+	occurrenceCache *occurrenceCache
 }
 
-func NewScheduler(logger zerolog.Logger, eventsSt events.EventsStorage) (*Scheduler, error) {
+// Option configures the Scheduler.
+type Option func(svc *Scheduler)
+
+// WithOccupancyPolicy sets the Occupied events availability coverage policy (OccupancyPolicyOff by default).
+func WithOccupancyPolicy(policy OccupancyPolicy) Option {
+	return func(svc *Scheduler) {
+		svc.occupancyPolicy = policy
+	}
+}
+
+// withTx executes fn within a single storage transaction passing the transaction bound service copy to it.
+func (svc Scheduler) withTx(ctx context.Context, fn func(txSvc Scheduler) error) error {
+	return svc.eventsSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+		txSvc := svc
+		txSvc.eventsSt = txSt
+		// Uncommitted (might be rolled back) data is not cached
+		txSvc.occurrenceCache = nil
+
+		return fn(txSvc)
+	})
+}
+
+func NewScheduler(logger zerolog.Logger, eventsSt events.EventsStorage, chargePointsSt chargepoints.ChargePointsStorage, opts ...Option) (*Scheduler, error) {
 	if eventsSt == nil {
 		return nil, fmt.Errorf("%s: nil", "eventsSt")
 	}
+	if chargePointsSt == nil {
+		return nil, fmt.Errorf("%s: nil", "chargePointsSt")
+	}
+
+	svc := &Scheduler{
+		logger:          logger.With().Str("component", "Scheduler service").Logger(),
+		eventsSt:        eventsSt,
+		chargePointsSt:  chargePointsSt,
+		watcher:         newEventsWatcher(),
+		occupancyPolicy: OccupancyPolicyOff,
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+
+	if _, err := ParseOccupancyPolicy(string(svc.occupancyPolicy)); err != nil {
+		return nil, err
+	}
 
-	return &Scheduler{
-		logger:   logger.With().Str("component", "Scheduler service").Logger(),
-		eventsSt: eventsSt,
-	}, nil
+	return svc, nil
 }
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) BookSlot(ctx context.Context, chargePointId int64, slotStart time.Time, slotDur time.Duration, ownerRef string) (retId int64, retErr error) {
+	// Slot is defined within the charge point time zone
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+	slotStart = slotStart.In(loc)
+
+	// Input checks
+	if slotStart.IsZero() {
+		retErr = fmt.Errorf("%s: zero: %w", "slotStart", common.ErrInvalidInput)
+		return
+	}
+	if slotDur <= 0 {
+		retErr = fmt.Errorf("%s: must be GT 0: %w", "slotDur", common.ErrInvalidInput)
+		return
+	}
+	if slotDur > maxEventDur {
+		retErr = fmt.Errorf("%s: must be LTE %s: %w", "slotDur", maxEventDur, common.ErrInvalidInput)
+		return
+	}
+	if slotDur%time.Second != 0 {
+		retErr = fmt.Errorf("%s: must have seconds precision: %w", "slotDur", common.ErrInvalidInput)
+		return
+	}
+	slotEnd := slotStart.Add(slotDur)
+
+	// Check and book within a single transaction, so no other booking can take the slot in between
+	var change schema.EventsChange
+	retErr = svc.withTx(ctx, func(txSvc Scheduler) error {
+		// Get existing events [slotStart -1 day : slotEnd +1 day]
+		rangeStart, rangeEnd := slotStart.Add(-dayDur), slotEnd.Add(dayDur)
+		greenEvents, redEvents, err := txSvc.getGreenRedEvents(ctx, chargePointId, rangeStart, rangeEnd)
+		if err != nil {
+			return fmt.Errorf("txSvc.getGreenRedEvents: %w", err)
+		}
+
+		// Search for a free (not occupied) green window covering the slot
+		slotFits := false
+		for greenCur := txSvc.mergeGreenRedEvents(greenEvents, redEvents); greenCur != nil; greenCur = greenCur.Next {
+			if !greenCur.Start.After(slotStart) && !greenCur.End.Before(slotEnd) {
+				slotFits = true
+				break
+			}
+		}
+		if !slotFits {
+			return fmt.Errorf("%s - %s: %w", slotStart.Format(common.TimeFmt), slotEnd.Format(common.TimeFmt), common.ErrSlotUnavailable)
+		}
+
+		// Book
+		event := schema.SingleEvent{
+			ChargePointId: chargePointId,
+			Type:          schema.SingleEventTypeOccupied,
+			StartDateTime: slotStart,
+			Duration:      slotDur,
+			OwnerRef:      ownerRef,
+			CreatedAt:     time.Now().UTC(),
+			Version:       1,
+		}
+		id, err := txSvc.eventsSt.CreateSingleEvent(ctx, event)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.CreateSingleEvent: %w", err)
+		}
+		event.Id = id
+		if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationCreate, nil, &event); err != nil {
+			return err
+		}
+		retId = id
+		change = newSingleEventChange(event)
+		svc.logger.Info().Stringer("event", event).Msgf("slot booked")
+
+		return nil
+	})
+	if retErr != nil {
+		return
+	}
+	svc.notifyEventsChanged(change)
+
+	return
+}
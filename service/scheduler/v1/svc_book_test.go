@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_BookSlot() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), time.Hour))
+	}
+
+	// fail: wrong inputs
+	{
+		slotStart := time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC)
+
+		_, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Time{}, 30*time.Minute, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.BookSlot(ctx, schema.DefaultChargePointId, slotStart, 0, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.BookSlot(ctx, schema.DefaultChargePointId, slotStart, maxEventDur+time.Hour, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.BookSlot(ctx, 1000, slotStart, 30*time.Minute, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// fail: unavailable slots
+	{
+		// no availability that day
+		_, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 12, 9, 30, 0, 0, time.UTC), 30*time.Minute, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrSlotUnavailable))
+
+		// intersects the occupied event
+		_, err = targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrSlotUnavailable))
+
+		// exceeds the availability window
+		_, err = targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 13, 0, 0, 0, time.UTC), time.Hour, "")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrSlotUnavailable))
+	}
+
+	// ok: BookSlot (back-to-back with the occupied event)
+	var bookingId int64
+	{
+		id, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC), time.Hour, "driver-42")
+		require.NoError(t, err)
+		require.NotEmpty(t, id)
+		bookingId = id
+
+		booking, err := s.r.StorageRes.Storage.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, booking)
+		require.Equal(t, schema.SingleEventTypeOccupied, booking.Type)
+		require.Equal(t, time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC), booking.StartDateTime)
+		require.Equal(t, time.Hour, booking.Duration)
+		require.Equal(t, "driver-42", booking.OwnerRef)
+	}
+
+	// fail: BookSlot: the same slot can't be booked twice
+	{
+		_, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), 30*time.Minute, "driver-43")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrSlotUnavailable))
+	}
+
+	// check the booked slot is excluded from the agenda
+	{
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC), dayDur, 30*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 4)
+		require.Equal(t, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+		require.Equal(t, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), agendas[0].TimeSlots[1].Start)
+		require.Equal(t, time.Date(2014, 8, 11, 12, 30, 0, 0, time.UTC), agendas[0].TimeSlots[2].Start)
+		require.Equal(t, time.Date(2014, 8, 11, 13, 0, 0, 0, time.UTC), agendas[0].TimeSlots[3].Start)
+	}
+
+	// ok: the back-to-back booking can be updated, deleted and restored (touching events don't intersect)
+	{
+		bookingStart := time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.UpdateSingleEvent(ctx, bookingId, 1, schema.SingleEventTypeOccupied, bookingStart, time.Hour))
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, bookingId, 2))
+		require.NoError(t, targetSvc.RestoreSingleEvent(ctx, bookingId))
+
+		booking, err := s.r.StorageRes.Storage.GetSingleEvent(ctx, bookingId)
+		require.NoError(t, err)
+		require.NotNil(t, booking)
+		require.Equal(t, bookingStart, booking.StartDateTime)
+		require.Equal(t, int64(2), booking.Version)
+	}
+
+	// ok: AddSingleEvent back-to-back with both occupied events
+	// 11.08.2014 (MON) 10:00 - 10:30 occupied
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), 30*time.Minute))
+	}
+
+	// ok: cancelled booking frees the slot
+	{
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, bookingId, 2))
+
+		_, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), 30*time.Minute, "driver-43")
+		require.NoError(t, err)
+	}
+
+	// ok: BookSlot spanning touching availability windows
+	// 11.08.2014 (MON) 13:30 - 14:30 available
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 11, 13, 30, 0, 0, time.UTC), time.Hour))
+
+		_, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 13, 0, 0, 0, time.UTC), time.Hour, "driver-44")
+		require.NoError(t, err)
+	}
+}
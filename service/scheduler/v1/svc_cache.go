@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"container/list"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// occurrenceCacheBucketDur is the occurrences cache time bucket (buckets are aligned to the zero time).
+	occurrenceCacheBucketDur = 7 * dayDur
+	// occurrenceCacheEntryOverhead is the estimated cache entry size excluding its occurrences (key, map and list elements).
+	occurrenceCacheEntryOverhead = 256
+)
+
+// OccurrenceCacheStats is the occurrences cache state.
+type OccurrenceCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+	// SizeBytes is the estimated cached entries size.
+	SizeBytes int64
+}
+
+// occurrenceCache is an LRU cache of expanded periodic event occurrences per event and time bucket limited by the estimated size.
+// Charge point entries are dropped on any its periodic events change, entries built from the data read before the change
+// are not stored (charge point generation check).
+// Changes made by other processes are not tracked.
+type occurrenceCache struct {
+	lock        sync.Mutex
+	maxSize     int64
+	size        int64
+	entries     map[occurrenceCacheKey]*list.Element
+	lru         *list.List
+	generations map[int64]uint64 // chargePointId -> invalidations count
+	hits        uint64
+	misses      uint64
+	evictions   uint64
+}
+
+type occurrenceCacheKey struct {
+	ChargePointId int64
+	EventId       int64
+	BucketStart   int64 // unix
+}
+
+type occurrenceCacheEntry struct {
+	key         occurrenceCacheKey
+	occurrences []event
+	size        int64
+}
+
+// WithOccurrenceCache enables the periodic event occurrences cache limited by the estimated size (disabled by default).
+func WithOccurrenceCache(maxSizeBytes int64) Option {
+	return func(svc *Scheduler) {
+		if maxSizeBytes > 0 {
+			svc.occurrenceCache = newOccurrenceCache(maxSizeBytes)
+		}
+	}
+}
+
+// OccurrenceCacheStats returns the occurrences cache state (empty if the cache is disabled).
+func (svc Scheduler) OccurrenceCacheStats() OccurrenceCacheStats {
+	if svc.occurrenceCache == nil {
+		return OccurrenceCacheStats{}
+	}
+
+	return svc.occurrenceCache.Stats()
+}
+
+// getCachedPeriodicEventOccurrences returns getPeriodicEventOccurrences result using the cache (if enabled).
+// generation must be taken before the periodic event is read from the storage.
+func (svc Scheduler) getCachedPeriodicEventOccurrences(obj schema.PeriodicEvent, loc *time.Location, rangeStart, rangeEnd time.Time, generation uint64) []event {
+	cache := svc.occurrenceCache
+	if cache == nil {
+		return getPeriodicEventOccurrences(obj, loc, rangeStart, rangeEnd)
+	}
+
+	var retEvents []event
+	for bucketStart := rangeStart.Truncate(occurrenceCacheBucketDur); !bucketStart.After(rangeEnd); bucketStart = bucketStart.Add(occurrenceCacheBucketDur) {
+		key := occurrenceCacheKey{
+			ChargePointId: obj.ChargePointId,
+			EventId:       obj.Id,
+			BucketStart:   bucketStart.Unix(),
+		}
+
+		occurrences, found := cache.Get(key)
+		if !found {
+			// Bucket is [bucketStart, bucketEnd), the inclusive end occurrence belongs to the next one
+			bucketEnd := bucketStart.Add(occurrenceCacheBucketDur)
+			occurrences = getPeriodicEventOccurrences(obj, loc, bucketStart, bucketEnd)
+			if len(occurrences) > 0 && !occurrences[len(occurrences)-1].Start.Before(bucketEnd) {
+				occurrences = occurrences[:len(occurrences)-1]
+			}
+			cache.Put(key, occurrences, generation)
+		}
+
+		for _, occurrence := range occurrences {
+			if occurrence.Start.Before(rangeStart) || occurrence.Start.After(rangeEnd) {
+				continue
+			}
+			retEvents = append(retEvents, occurrence)
+		}
+	}
+
+	return retEvents
+}
+
+// getOccurrenceCacheGeneration returns the charge point cache generation (0 if the cache is disabled).
+func (svc Scheduler) getOccurrenceCacheGeneration(chargePointId int64) uint64 {
+	if svc.occurrenceCache == nil {
+		return 0
+	}
+
+	return svc.occurrenceCache.Generation(chargePointId)
+}
+
+// invalidateOccurrenceCache drops the changed charge points entries (must be called after the transaction is committed).
+func (svc Scheduler) invalidateOccurrenceCache(changes []schema.EventsChange) {
+	if svc.occurrenceCache == nil {
+		return
+	}
+
+	for _, change := range changes {
+		if change.Periodic {
+			svc.occurrenceCache.Invalidate(change.ChargePointId)
+		}
+	}
+}
+
+// Generation returns the charge point invalidations count.
+func (c *occurrenceCache) Generation(chargePointId int64) uint64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.generations[chargePointId]
+}
+
+// Get returns the cached occurrences (the result must not be modified).
+func (c *occurrenceCache) Get(key occurrenceCacheKey) ([]event, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, found := c.entries[key]
+	if !found {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	c.lru.MoveToFront(elem)
+
+	return elem.Value.(*occurrenceCacheEntry).occurrences, true
+}
+
+// Put stores the occurrences if the charge point generation hasn't changed evicting the least recently used entries.
+func (c *occurrenceCache) Put(key occurrenceCacheKey, occurrences []event, generation uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.generations[key.ChargePointId] != generation {
+		return
+	}
+	if _, found := c.entries[key]; found {
+		return
+	}
+
+	entry := &occurrenceCacheEntry{
+		key:         key,
+		occurrences: occurrences,
+		size:        occurrenceCacheEntryOverhead + int64(len(occurrences))*int64(unsafe.Sizeof(event{})),
+	}
+	if entry.size > c.maxSize {
+		return
+	}
+
+	for c.size+entry.size > c.maxSize {
+		c.removeElement(c.lru.Back())
+		c.evictions++
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.size += entry.size
+}
+
+// Invalidate drops the charge point entries.
+func (c *occurrenceCache) Invalidate(chargePointId int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generations[chargePointId]++
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*occurrenceCacheEntry).key.ChargePointId == chargePointId {
+			c.removeElement(elem)
+		}
+		elem = next
+	}
+}
+
+// Stats returns the cache state.
+func (c *occurrenceCache) Stats() OccurrenceCacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return OccurrenceCacheStats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Entries:   len(c.entries),
+		SizeBytes: c.size,
+	}
+}
+
+func (c *occurrenceCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*occurrenceCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func newOccurrenceCache(maxSizeBytes int64) *occurrenceCache {
+	return &occurrenceCache{
+		maxSize:     maxSizeBytes,
+		entries:     make(map[occurrenceCacheKey]*list.Element),
+		lru:         list.New(),
+		generations: make(map[int64]uint64),
+	}
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_OccurrenceCache() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	cachedSvc := *targetSvc
+	cachedSvc.occurrenceCache = newOccurrenceCache(1 << 20)
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	// 05.08.2014 (TUE) 18:00 - 22:00 daily
+	{
+		require.NoError(t, cachedSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, cachedSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 5, 18, 0, 0, 0, time.UTC), "FREQ=DAILY", 4*time.Hour))
+	}
+	periodStart, periodDur := time.Date(2014, 8, 10, 0, 0, 0, 0, time.UTC), 10*dayDur
+
+	// ok: miss, then hit with the same result
+	{
+		expAgendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+
+		agendas, err := cachedSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, expAgendas, agendas)
+
+		stats := cachedSvc.OccurrenceCacheStats()
+		require.Zero(t, stats.Hits)
+		require.NotZero(t, stats.Misses)
+		require.NotZero(t, stats.Entries)
+		require.LessOrEqual(t, stats.SizeBytes, int64(1<<20))
+
+		agendas, err = cachedSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, expAgendas, agendas)
+		require.Equal(t, stats.Misses, cachedSvc.OccurrenceCacheStats().Misses)
+		require.Equal(t, stats.Misses, cachedSvc.OccurrenceCacheStats().Hits)
+	}
+
+	// ok: single event change keeps entries
+	{
+		entries := cachedSvc.OccurrenceCacheStats().Entries
+		require.NoError(t, cachedSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour))
+		require.Equal(t, entries, cachedSvc.OccurrenceCacheStats().Entries)
+	}
+
+	// ok: periodic event change drops entries
+	{
+		_, err := cachedSvc.AddPeriodicEventException(ctx, 1, 1, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Zero(t, cachedSvc.OccurrenceCacheStats().Entries)
+
+		expAgendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+
+		agendas, err := cachedSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, expAgendas, agendas)
+	}
+
+	// ok: size limit
+	{
+		limitedSvc := *targetSvc
+		limitedSvc.occurrenceCache = newOccurrenceCache(3 * occurrenceCacheEntryOverhead)
+
+		expAgendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+
+		agendas, err := limitedSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, periodStart, periodDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, expAgendas, agendas)
+
+		stats := limitedSvc.OccurrenceCacheStats()
+		require.NotZero(t, stats.Evictions)
+		require.LessOrEqual(t, stats.SizeBytes, int64(3*occurrenceCacheEntryOverhead))
+	}
+
+	// ok: entries read before the invalidation are not stored
+	{
+		cache := newOccurrenceCache(1 << 20)
+		key := occurrenceCacheKey{ChargePointId: schema.DefaultChargePointId, EventId: 1}
+
+		generation := cache.Generation(schema.DefaultChargePointId)
+		cache.Invalidate(schema.DefaultChargePointId)
+		cache.Put(key, []event{{Id: 1}}, generation)
+		_, found := cache.Get(key)
+		require.False(t, found)
+
+		cache.Put(key, []event{{Id: 1}}, cache.Generation(schema.DefaultChargePointId))
+		_, found = cache.Get(key)
+		require.True(t, found)
+	}
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) CreateChargePoint(ctx context.Context, name, site string, connectorsCount uint, timeZone string, maxPowerKW float64) (retId int64, retErr error) {
+	// Input checks
+	if name == "" {
+		retErr = fmt.Errorf("%s: empty: %w", "name", common.ErrInvalidInput)
+		return
+	}
+	if connectorsCount == 0 {
+		retErr = fmt.Errorf("%s: must be GT 0: %w", "connectorsCount", common.ErrInvalidInput)
+		return
+	}
+	if timeZone == "" {
+		timeZone = schema.DefaultTimeZone
+	}
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		retErr = fmt.Errorf("%s: invalid (%v): %w", "timeZone", err, common.ErrInvalidInput)
+		return
+	}
+	if maxPowerKW < 0 {
+		retErr = fmt.Errorf("%s: must be GTE 0: %w", "maxPowerKW", common.ErrInvalidInput)
+		return
+	}
+
+	// Create
+	point := schema.ChargePoint{
+		Name:            name,
+		Site:            site,
+		ConnectorsCount: connectorsCount,
+		TimeZone:        timeZone,
+		MaxPowerKW:      maxPowerKW,
+		CreatedAt:       time.Now().UTC(),
+	}
+	id, err := svc.chargePointsSt.CreateChargePoint(ctx, point)
+	if err != nil {
+		retErr = fmt.Errorf("svc.chargePointsSt.CreateChargePoint: %w", err)
+		return
+	}
+	retId = id
+	svc.logger.Info().Stringer("chargePoint", point).Msgf("charge point created")
+
+	return
+}
+
+func (svc Scheduler) GetChargePoints(ctx context.Context) ([]schema.ChargePoint, error) {
+	points, err := svc.chargePointsSt.GetAllChargePoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("svc.chargePointsSt.GetAllChargePoints: %w", err)
+	}
+
+	return points, nil
+}
+
+// getChargePoint returns an existing schema.ChargePoint or fails.
+func (svc Scheduler) getChargePoint(ctx context.Context, chargePointId int64) (*schema.ChargePoint, error) {
+	point, err := svc.chargePointsSt.GetChargePoint(ctx, chargePointId)
+	if err != nil {
+		return nil, fmt.Errorf("svc.chargePointsSt.GetChargePoint(%d): %w", chargePointId, err)
+	}
+	if point == nil {
+		return nil, fmt.Errorf("%s (%d): not found: %w", "chargePointId", chargePointId, common.ErrInvalidInput)
+	}
+
+	return point, nil
+}
+
+// getChargePointLocation returns an existing schema.ChargePoint time zone location or fails.
+func (svc Scheduler) getChargePointLocation(ctx context.Context, chargePointId int64) (*time.Location, error) {
+	point, err := svc.getChargePoint(ctx, chargePointId)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := point.Location()
+	if err != nil {
+		return nil, fmt.Errorf("point.Location (%s): %w", point.TimeZone, err)
+	}
+
+	return loc, nil
+}
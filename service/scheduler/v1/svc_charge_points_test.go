@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_ChargePoints() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// fail: CreateChargePoint: wrong inputs
+	{
+		_, err := targetSvc.CreateChargePoint(ctx, "", "Site", 1, "", 0)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.CreateChargePoint(ctx, "CP", "Site", 0, "", 0)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.CreateChargePoint(ctx, "CP", "Site", 1, "Europe/Atlantis", 0)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.CreateChargePoint(ctx, "CP", "Site", 1, "", -1)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: CreateChargePoint
+	cpId, err := targetSvc.CreateChargePoint(ctx, "CP-01", "Berlin Mitte", 2, "", 22)
+	require.NoError(t, err)
+	require.NotEqual(t, schema.DefaultChargePointId, cpId)
+
+	// ok: GetChargePoints
+	{
+		points, err := targetSvc.GetChargePoints(ctx)
+		require.NoError(t, err)
+
+		found := false
+		for _, point := range points {
+			if point.Id == cpId {
+				found = true
+				require.Equal(t, "CP-01", point.Name)
+				require.Equal(t, "Berlin Mitte", point.Site)
+				require.EqualValues(t, 2, point.ConnectorsCount)
+				require.Equal(t, schema.DefaultTimeZone, point.TimeZone)
+				require.Equal(t, 22.0, point.MaxPowerKW)
+			}
+		}
+		require.True(t, found)
+	}
+
+	// fail: non-existing charge point
+	{
+		eventStart := time.Date(2002, 3, 4, 9, 0, 0, 0, time.UTC)
+
+		err := targetSvc.AddSingleEvent(ctx, 1000, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.GetAvailableAgenda(ctx, 1000, eventStart, dayDur, 30*time.Minute)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: same time range events for different charge points do not intersect
+	// 04.03.2002 (MON) 09:00 - 12:00
+	{
+		eventStart := time.Date(2002, 3, 4, 9, 0, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, cpId, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, cpId, schema.SingleEventTypeOccupied, eventStart, 2*time.Hour))
+	}
+
+	// check per charge point agendas
+	{
+		agendaStart := time.Date(2002, 3, 4, 0, 0, 0, 0, time.UTC)
+
+		defAgendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, agendaStart, dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, defAgendas, 1)
+		require.Len(t, defAgendas[0].TimeSlots, 3)
+
+		cpAgendas, err := targetSvc.GetAvailableAgenda(ctx, cpId, agendaStart, dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, cpAgendas, 1)
+		require.Len(t, cpAgendas[0].TimeSlots, 1)
+		require.Equal(t, time.Date(2002, 3, 4, 11, 0, 0, 0, time.UTC), cpAgendas[0].TimeSlots[0].Start)
+	}
+
+	// check per charge point events
+	{
+		sEvents, pEvents, err := targetSvc.GetEvents(ctx, cpId, time.Date(2002, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2002, 3, 31, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.Len(t, pEvents, 1)
+		require.Equal(t, cpId, sEvents[0].ChargePointId)
+		require.Equal(t, cpId, pEvents[0].ChargePointId)
+	}
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+func (s *ServiceTestSuite) Test_ConcurrentCreate() {
+	const workersCnt = 8
+
+	t := s.T()
+	ctx := s.ctx
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// Init workers: each one has its own DB connection to the same file (emulating separate processes)
+	workerSvcs := make([]*Scheduler, 0, workersCnt)
+	for i := 0; i < workersCnt; i++ {
+		baseSt, err := sqlite_base.NewSQLiteBase(zerolog.Nop(), sqlite_base.TempSQLiteBasePath(s.tmpDir))
+		require.NoError(t, err)
+		defer baseSt.Close()
+
+		r, err := NewTestResource(baseSt)
+		require.NoError(t, err)
+		workerSvcs = append(workerSvcs, r.Svc.(*Scheduler))
+	}
+
+	runConcurrently := func(fn func(svc *Scheduler) error) (retSucceeded int) {
+		startCh := make(chan struct{})
+		errs := make([]error, workersCnt)
+
+		wg := sync.WaitGroup{}
+		for i, svc := range workerSvcs {
+			wg.Add(1)
+			go func(i int, svc *Scheduler) {
+				defer wg.Done()
+				<-startCh
+				errs[i] = fn(svc)
+			}(i, svc)
+		}
+		close(startCh)
+		wg.Wait()
+
+		for _, err := range errs {
+			if err == nil {
+				retSucceeded++
+				continue
+			}
+			// all failures must be intersection errors (not DB lock errors)
+			require.True(t, errors.Is(err, common.ErrInvalidInput), err.Error())
+		}
+
+		return
+	}
+
+	// single events: the same event
+	{
+		succeeded := runConcurrently(func(svc *Scheduler) error {
+			return svc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), 4*time.Hour)
+		})
+		require.Equal(t, 1, succeeded)
+
+		events, err := s.r.StorageRes.Storage.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC), false)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	}
+
+	// periodic events: the same event
+	{
+		succeeded := runConcurrently(func(svc *Scheduler) error {
+			return svc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 10, 0, 0, 0, time.UTC), 2*time.Hour)
+		})
+		require.Equal(t, 1, succeeded)
+
+		events, err := s.r.StorageRes.Storage.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	}
+}
@@ -11,91 +11,171 @@ import (
 	"github.com/itiky/charge_scheduler/schema"
 )
 
-func (svc Scheduler) AddSingleEvent(ctx context.Context, eventType schema.SingleEventType, eventStart time.Time, endDayHours, endDayMinutes uint) error {
-	// Common check
-	if err := svc.validateEventInput(eventType, eventStart, endDayHours, endDayMinutes); err != nil {
+func (svc Scheduler) AddSingleEvent(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error {
+	// Event is defined within the charge point time zone
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
 		return err
 	}
+	eventStart = eventStart.In(loc)
 
-	newEvent := &event{
-		Start: eventStart,
-		End:   cloneTimeWithHourAndMinutes(eventStart, endDayHours, endDayMinutes),
+	// Common check
+	if err := svc.validateEventInput(eventType, eventStart, eventDur); err != nil {
+		return err
 	}
 
-	// Get existing events [eventStart -1 day : eventEnd +1 day]
-	rangeStart, rangeEnd := newEvent.Start.Add(-24*time.Hour), newEvent.End.Add(24*time.Hour)
-	existingGreenEvents, existingRedEvents, err := svc.getGreenRedEvents(ctx, rangeStart, rangeEnd)
+	// Check intersection / availability coverage and create within a single (write locked) transaction
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err = svc.withTx(ctx, func(txSvc Scheduler) error {
+		// Check intersection
+		if err := txSvc.checkSingleEventIntersections(ctx, chargePointId, eventType, eventStart, eventDur, nil); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkSingleEventCoverage(ctx, chargePointId, eventType, eventStart, eventDur, nil); err != nil {
+			return err
+		}
+
+		// Create
+		event := schema.SingleEvent{
+			ChargePointId: chargePointId,
+			Type:          eventType,
+			StartDateTime: eventStart,
+			Duration:      eventDur,
+			CreatedAt:     time.Now().UTC(),
+			Version:       1,
+		}
+		id, err := txSvc.eventsSt.CreateSingleEvent(ctx, event)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.CreateSingleEvent: %w", err)
+		}
+		event.Id = id
+		if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationCreate, nil, &event); err != nil {
+			return err
+		}
+		change = newSingleEventChange(event)
+		svc.logger.Info().Stringer("event", event).Msgf("event created")
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("svc.getAllRangedEvents: %w", err)
+		return err
 	}
+	svc.notifyEventsChanged(change)
+	common.AddWarnings(ctx, warnings...)
 
-	// Pick target existing events group
-	var existingTargetEvents []*event
-	if eventType == schema.SingleEventTypeAvailable {
-		existingTargetEvents = existingGreenEvents
-	} else {
-		existingTargetEvents = existingRedEvents
+	return nil
+}
+
+func (svc Scheduler) AddPeriodicEvent(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error {
+	return svc.AddPeriodicEventWithRule(ctx, chargePointId, eventType, eventStart, weeklyRRule, eventDur)
+}
+
+func (svc Scheduler) AddPeriodicEventWithRule(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, rruleStr string, eventDur time.Duration) error {
+	// Recurrences are expanded in the charge point time zone wall-clock time
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		return err
 	}
+	eventStart = eventStart.In(loc)
 
-	// Check intersection
-	for _, existingEvent := range existingTargetEvents {
-		if svc.checkEventsIntersect(newEvent, existingEvent) {
-			return fmt.Errorf("event intersects with an existing event (%d: %s): %w", existingEvent.Id, existingEvent.Type, common.ErrInvalidInput)
-		}
+	// Common check
+	if err := svc.validateEventInput(eventType, eventStart, eventDur); err != nil {
+		return err
 	}
 
-	// Create
-	event := schema.SingleEvent{
-		Type:          eventType,
-		StartDateTime: eventStart,
-		EndHours:      endDayHours,
-		EndMinutes:    endDayMinutes,
-		CreatedAt:     time.Now().UTC(),
+	rule, err := newRule(eventStart, rruleStr)
+	if err != nil {
+		return err
 	}
-	if _, err := svc.eventsSt.CreateSingleEvent(ctx, event); err != nil {
-		return fmt.Errorf("svc.eventsSt.CreateSingleEvent: %w", err)
+
+	// Check intersection / availability coverage and create within a single (write locked) transaction
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err = svc.withTx(ctx, func(txSvc Scheduler) error {
+		// Check intersection
+		if err := txSvc.checkPeriodicEventIntersections(ctx, chargePointId, eventType, rule, eventDur, nil); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkPeriodicEventCoverage(ctx, chargePointId, eventType, rule, eventDur, nil); err != nil {
+			return err
+		}
+
+		// Create
+		event := schema.PeriodicEvent{
+			ChargePointId: chargePointId,
+			Type:          eventType,
+			Rrule:         *rule,
+			Duration:      eventDur,
+			CreatedAt:     time.Now().UTC(),
+			Version:       1,
+		}
+		id, err := txSvc.eventsSt.CreatePeriodicEvent(ctx, event)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.CreatePeriodicEvent: %w", err)
+		}
+		event.Id = id
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationCreate, nil, &event); err != nil {
+			return err
+		}
+		change = newPeriodicEventChange(event)
+		svc.logger.Info().Stringer("event", event).Msgf("event created")
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	svc.logger.Info().Stringer("event", event).Msgf("event created")
+	svc.notifyEventsChanged(change)
+	common.AddWarnings(ctx, warnings...)
 
 	return nil
 }
 
-func (svc Scheduler) AddPeriodicEvent(ctx context.Context, eventType schema.SingleEventType, eventStart time.Time, endDayHours, endDayMinutes uint) error {
-	// Common check
-	if err := svc.validateEventInput(eventType, eventStart, endDayHours, endDayMinutes); err != nil {
-		return err
+// checkSingleEventIntersections checks that a single event doesn't intersect existing same type events (the ignored one is skipped).
+func (svc Scheduler) checkSingleEventIntersections(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration, ignoredEvent *eventKey) error {
+	newEvent := &event{
+		Start: eventStart,
+		End:   eventStart.Add(eventDur),
 	}
 
-	rule, err := rrule.NewRRule(rrule.ROption{
-		Freq:    rrule.WEEKLY,
-		Count:   0,
-		Dtstart: eventStart,
-	})
+	// Get existing events [eventStart -1 day : eventEnd +1 day]
+	rangeStart, rangeEnd := newEvent.Start.Add(-24*time.Hour), newEvent.End.Add(24*time.Hour)
+	existingTargetEvents, err := svc.getTargetEvents(ctx, chargePointId, eventType, rangeStart, rangeEnd, ignoredEvent)
 	if err != nil {
-		return fmt.Errorf("rrule.NewRRule: %w", err)
+		return err
 	}
 
-	// Get existing events [eventStart -1 day : eventEnd +1 week +1 day]
-	rangeStart, rangeEnd := eventStart.Add(-24*time.Hour), cloneTimeWithHourAndMinutes(eventStart, endDayHours, endDayMinutes).Add((7*24+24)*time.Hour)
-	existingGreenEvents, existingRedEvents, err := svc.getGreenRedEvents(ctx, rangeStart, rangeEnd)
-	if err != nil {
-		return fmt.Errorf("svc.getAllRangedEvents: %w", err)
+	for _, existingEvent := range existingTargetEvents {
+		if svc.checkEventsIntersect(newEvent, existingEvent) {
+			return fmt.Errorf("event intersects with an existing event (%d: %s): %w", existingEvent.Id, existingEvent.Type, common.ErrInvalidInput)
+		}
 	}
 
-	// Pick target existing events group
-	var existingTargetEvents []*event
-	if eventType == schema.SingleEventTypeAvailable {
-		existingTargetEvents = existingGreenEvents
-	} else {
-		existingTargetEvents = existingRedEvents
+	return nil
+}
+
+// checkPeriodicEventIntersections checks that a periodic event doesn't intersect existing same type events (the ignored one is skipped).
+func (svc Scheduler) checkPeriodicEventIntersections(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, rule *rrule.RRule, eventDur time.Duration, ignoredEvent *eventKey) error {
+	// Get existing events within the rule lifetime (or the check horizon for endless rules)
+	rangeStart, rangeEnd := getPeriodicCheckRange(rule, eventDur)
+	existingTargetEvents, err := svc.getTargetEvents(ctx, chargePointId, eventType, rangeStart, rangeEnd, ignoredEvent)
+	if err != nil {
+		return err
 	}
 
-	// Check intersection with periodic events
+	var prevNewEvent *event
 	for _, newEventStart := range rule.Between(rangeStart, rangeEnd, true) {
 		newEvent := &event{
 			Start: newEventStart,
-			End:   cloneTimeWithHourAndMinutes(newEventStart, endDayHours, endDayMinutes),
+			End:   newEventStart.Add(eventDur),
+		}
+
+		// Long (multi-day) occurrences might intersect the next ones
+		if prevNewEvent != nil && svc.checkEventsIntersect(prevNewEvent, newEvent) {
+			return fmt.Errorf("%s: event occurrences intersect with each other: %w", "eventDur", common.ErrInvalidInput)
 		}
+		prevNewEvent = newEvent
 
 		for _, existingEvent := range existingTargetEvents {
 			if svc.checkEventsIntersect(newEvent, existingEvent) {
@@ -104,23 +184,40 @@ func (svc Scheduler) AddPeriodicEvent(ctx context.Context, eventType schema.Sing
 		}
 	}
 
-	// Create
-	event := schema.PeriodicEvent{
-		Type:       eventType,
-		Rrule:      *rule,
-		EndHours:   endDayHours,
-		EndMinutes: endDayMinutes,
-		CreatedAt:  time.Now().UTC(),
+	return nil
+}
+
+// getTargetEvents returns existing events group (green / red) for the eventType excluding the ignored event (if set).
+func (svc Scheduler) getTargetEvents(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, rangeStart, rangeEnd time.Time, ignoredEvent *eventKey) ([]*event, error) {
+	existingGreenEvents, existingRedEvents, err := svc.getGreenRedEvents(ctx, chargePointId, rangeStart, rangeEnd)
+	if err != nil {
+		return nil, fmt.Errorf("svc.getGreenRedEvents: %w", err)
 	}
-	if _, err := svc.eventsSt.CreatePeriodicEvent(ctx, event); err != nil {
-		return fmt.Errorf("svc.eventsSt.CreatePeriodicEvent: %w", err)
+
+	// Pick target existing events group
+	var existingTargetEvents []*event
+	if eventType == schema.SingleEventTypeAvailable {
+		existingTargetEvents = existingGreenEvents
+	} else {
+		existingTargetEvents = existingRedEvents
 	}
-	svc.logger.Info().Stringer("event", event).Msgf("event created")
 
-	return nil
+	if ignoredEvent == nil {
+		return existingTargetEvents, nil
+	}
+
+	filteredEvents := make([]*event, 0, len(existingTargetEvents))
+	for _, existingEvent := range existingTargetEvents {
+		if existingEvent.Key() == *ignoredEvent {
+			continue
+		}
+		filteredEvents = append(filteredEvents, existingEvent)
+	}
+
+	return filteredEvents, nil
 }
 
-func (svc Scheduler) validateEventInput(eventType schema.SingleEventType, eventStart time.Time, endDayHours, endDayMinutes uint) (retErr error) {
+func (svc Scheduler) validateEventInput(eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) (retErr error) {
 	// Input checks
 	if !eventType.IsValid() {
 		retErr = fmt.Errorf("%s: invalid: %w", "eventType", common.ErrInvalidInput)
@@ -131,18 +228,16 @@ func (svc Scheduler) validateEventInput(eventType schema.SingleEventType, eventS
 		return
 	}
 
-	if endDayHours > 23 {
-		retErr = fmt.Errorf("%s: must be LTE 23: %w", "endDayHours", common.ErrInvalidInput)
+	if eventDur <= 0 {
+		retErr = fmt.Errorf("%s: must be GT 0: %w", "eventDur", common.ErrInvalidInput)
 		return
 	}
-	if endDayMinutes > 59 {
-		retErr = fmt.Errorf("%s: must be LTE 59: %w", "endDayMinutes", common.ErrInvalidInput)
+	if eventDur > maxEventDur {
+		retErr = fmt.Errorf("%s: must be LTE %s: %w", "eventDur", maxEventDur, common.ErrInvalidInput)
 		return
 	}
-
-	eventEnd := time.Date(eventStart.Year(), eventStart.Month(), eventStart.Day(), int(endDayHours), int(endDayMinutes), eventStart.Second(), eventStart.Nanosecond(), eventStart.Location())
-	if eventEnd.Equal(eventStart) || eventEnd.Before(eventStart) {
-		retErr = fmt.Errorf("%s: must eventEnd must be after the eventStart: %w", "endDayHours / endDayMinutes", common.ErrInvalidInput)
+	if eventDur%time.Second != 0 {
+		retErr = fmt.Errorf("%s: must have seconds precision: %w", "eventDur", common.ErrInvalidInput)
 		return
 	}
 
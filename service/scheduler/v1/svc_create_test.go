@@ -23,32 +23,31 @@ func (s *ServiceTestSuite) Test_Create_NoOverlap() {
 
 		// EventType
 		{
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventType(""), now, 0, 0)
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventType(""), now, time.Hour)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
-		// endDayHours
+		// eventDur: zero
 		{
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, now, 24, 0)
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, now, 0)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
-		// endDayMinutes
+		// eventDur: negative (end < eventStart)
 		{
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, now, 0, 60)
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, now, -time.Hour)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
-		// endDayHours < eventStart
+		// eventDur: too long
 		{
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, now, uint(now.Hour()-1), uint(now.Minute()))
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, now, maxEventDur+time.Minute)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
-		// endDayMinutes < eventStart
+		// eventDur: sub-second precision
 		{
-			now := now.Add(10 * time.Minute)
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, now, uint(now.Hour()), uint(now.Minute()-1))
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, now, time.Hour+time.Millisecond)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
@@ -58,14 +57,14 @@ func (s *ServiceTestSuite) Test_Create_NoOverlap() {
 	// 15.01.2000 (SAT) 09:00 - 12:00
 	{
 		eventStart := time.Date(2000, 1, 15, 9, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 12, 0))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour))
 	}
 
 	// fail: AddSingleEvent: intersect
 	// 15.01.2000 (SAT) 11:30 - 13:00 -> collide the same day
 	{
 		eventStart := time.Date(2000, 1, 15, 11, 30, 0, 0, time.UTC)
-		err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 13, 0)
+		err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour+30*time.Minute)
 		require.Error(t, err)
 		require.True(t, errors.Is(err, common.ErrInvalidInput))
 	}
@@ -74,14 +73,14 @@ func (s *ServiceTestSuite) Test_Create_NoOverlap() {
 	// 15.01.2000 (SAT) 18:00 - 19:30
 	{
 		eventStart := time.Date(2000, 1, 15, 18, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 19, 30))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, time.Hour+30*time.Minute))
 	}
 
 	// fail: AddPeriodicEvent: intersect wint single
-	// 08.01.2000 (SAT) 12:00 - 13:00 -> collide the next week
+	// 08.01.2000 (SAT) 11:30 - 12:30 -> collide the next week
 	{
-		eventStart := time.Date(2000, 1, 8, 12, 0, 0, 0, time.UTC)
-		err := targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 13, 0)
+		eventStart := time.Date(2000, 1, 8, 11, 30, 0, 0, time.UTC)
+		err := targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour)
 		require.Error(t, err)
 		require.True(t, errors.Is(err, common.ErrInvalidInput))
 	}
@@ -90,14 +89,14 @@ func (s *ServiceTestSuite) Test_Create_NoOverlap() {
 	// 09.01.2000 (SUN) 12:00 - 13:00
 	{
 		eventStart := time.Date(2000, 1, 9, 12, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 13, 0))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour))
 	}
 
 	// fail: AddPeriodicEvent: intersect wint periodic
 	// 23.01.2000 (SAN) 11:00 - 12:15 -> collide the week before
 	{
 		eventStart := time.Date(2000, 1, 23, 11, 0, 0, 0, time.UTC)
-		err := targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 12, 15)
+		err := targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour+15*time.Minute)
 		require.Error(t, err)
 		require.True(t, errors.Is(err, common.ErrInvalidInput))
 	}
@@ -106,12 +105,12 @@ func (s *ServiceTestSuite) Test_Create_NoOverlap() {
 	// 18.01.2000 (TUE) 00:00 - 23:59
 	{
 		eventStart := time.Date(2000, 1, 18, 0, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 23, 59))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, 23*time.Hour+59*time.Minute))
 	}
 
 	// check the resulting green / red events for the month of January
 	{
-		greenEvents, redEvents, err := targetSvc.getGreenRedEvents(ctx, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2000, 1, 31, 23, 59, 0, 0, time.UTC))
+		greenEvents, redEvents, err := targetSvc.getGreenRedEvents(ctx, schema.DefaultChargePointId, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2000, 1, 31, 23, 59, 0, 0, time.UTC))
 		require.NoError(t, err)
 
 		require.Len(t, greenEvents, 5)
@@ -174,13 +173,13 @@ func (s *ServiceTestSuite) Test_Create_Overlap() {
 	// 21.05.2001 (MON) 09:00 - 11:59
 	{
 		eventStart := time.Date(2001, 5, 21, 9, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 11, 59))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 2*time.Hour+59*time.Minute))
 
 		// fail: Green: AddPeriodicEvent
 		// 28.05.2001 (MON) 10:00 - 13:00
 		{
 			eventStart := time.Date(2001, 5, 28, 10, 0, 0, 0, time.UTC)
-			err := targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 13, 0)
+			err := targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
@@ -190,13 +189,13 @@ func (s *ServiceTestSuite) Test_Create_Overlap() {
 	// 21.05.2001 (MON) 12:00 - 13:00
 	{
 		eventStart := time.Date(2001, 5, 21, 12, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 13, 0))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour))
 
 		// fail: Green: AddSingleEvent
-		// 21.05.2001 (MON) 13:00 - 14:00
+		// 21.05.2001 (MON) 12:30 - 13:30
 		{
-			eventStart := time.Date(2001, 5, 21, 13, 0, 0, 0, time.UTC)
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 14, 0)
+			eventStart := time.Date(2001, 5, 21, 12, 30, 0, 0, time.UTC)
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, time.Hour)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
@@ -206,20 +205,20 @@ func (s *ServiceTestSuite) Test_Create_Overlap() {
 	// 22.05.2001 (TUE) 15:00 - 18:00
 	{
 		eventStart := time.Date(2001, 5, 22, 15, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeAvailable, eventStart, 18, 0))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 3*time.Hour))
 	}
 
 	// ok / fail: Red: AddPeriodicEvent (overlaps periodic and single Green)
 	// 21.05.2001 (MON) 08:00 - 14:00
 	{
 		eventStart := time.Date(2001, 5, 21, 8, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 14, 0))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, 6*time.Hour))
 
 		// fail: Red: AddPeriodicEvent
 		// 14.05.2001 (MON) 09:00 - 10:00
 		{
 			eventStart := time.Date(2001, 5, 14, 9, 0, 0, 0, time.UTC)
-			err := targetSvc.AddPeriodicEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 10, 0)
+			err := targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, time.Hour)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
@@ -229,13 +228,13 @@ func (s *ServiceTestSuite) Test_Create_Overlap() {
 	// 22.05.2001 (TUE) 16:00 - 17:00
 	{
 		eventStart := time.Date(2001, 5, 22, 16, 0, 0, 0, time.UTC)
-		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 17, 0))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, time.Hour))
 
 		// fail: Red: AddSingleEvent
 		// 22.05.2001 (TUE) 16:00 - 16:30
 		{
 			eventStart := time.Date(2001, 5, 22, 16, 0, 0, 0, time.UTC)
-			err := targetSvc.AddSingleEvent(ctx, schema.SingleEventTypeOccupied, eventStart, 16, 30)
+			err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, 30*time.Minute)
 			require.Error(t, err)
 			require.True(t, errors.Is(err, common.ErrInvalidInput))
 		}
@@ -243,7 +242,7 @@ func (s *ServiceTestSuite) Test_Create_Overlap() {
 
 	// check the resulting green / red events for two weeks
 	{
-		greenEvents, redEvents, err := targetSvc.getGreenRedEvents(ctx, time.Date(2001, 5, 20, 0, 0, 0, 0, time.UTC), time.Date(2001, 6, 2, 23, 59, 0, 0, time.UTC))
+		greenEvents, redEvents, err := targetSvc.getGreenRedEvents(ctx, schema.DefaultChargePointId, time.Date(2001, 5, 20, 0, 0, 0, 0, time.UTC), time.Date(2001, 6, 2, 23, 59, 0, 0, time.UTC))
 		require.NoError(t, err)
 
 		require.Len(t, greenEvents, 4)
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) DeleteSingleEvent(ctx context.Context, eventId, version int64) error {
+	// Read (for the change notification and history), soft-delete and check availability coverage within a single transaction
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		event, err := txSvc.eventsSt.GetSingleEvent(ctx, eventId)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetSingleEvent(%d): %w", eventId, err)
+		}
+		if event == nil {
+			return fmt.Errorf("%s (%d): %w", "eventId", eventId, common.ErrNotFound)
+		}
+		if err := checkEventVersion(eventId, version, event.Version); err != nil {
+			return err
+		}
+
+		if err := txSvc.eventsSt.DeleteSingleEvent(ctx, eventId, time.Now().UTC()); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.DeleteSingleEvent(%d): %w", eventId, err)
+		}
+		if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationDelete, event, nil); err != nil {
+			return err
+		}
+		change = newSingleEventChange(*event)
+
+		// Occupied events might have been covered by the deleted availability
+		if event.Type == schema.SingleEventTypeAvailable {
+			if warnings, err = txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, event.StartDateTime, event.StartDateTime.Add(event.Duration), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(change)
+	svc.logger.Info().Int64("eventId", eventId).Msgf("single event deleted")
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+func (svc Scheduler) DeletePeriodicEvent(ctx context.Context, eventId, version int64) error {
+	// Read (for the change notification and history), soft-delete and check availability coverage within a single transaction
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		event, err := txSvc.getPeriodicEvent(ctx, eventId)
+		if err != nil {
+			return err
+		}
+		if err := checkEventVersion(eventId, version, event.Version); err != nil {
+			return err
+		}
+
+		if err := txSvc.eventsSt.DeletePeriodicEvent(ctx, eventId, time.Now().UTC()); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.DeletePeriodicEvent(%d): %w", eventId, err)
+		}
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationDelete, event, nil); err != nil {
+			return err
+		}
+		change = newPeriodicEventChange(*event)
+
+		// Occupied events might have been covered by the deleted availability occurrences
+		if event.Type == schema.SingleEventTypeAvailable {
+			rangeStart, rangeEnd := getPeriodicEventRange(*event)
+			if warnings, err = txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, rangeStart, rangeEnd, nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(change)
+	svc.logger.Info().Int64("eventId", eventId).Msgf("periodic event deleted")
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_Delete() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	singleStart := time.Date(2003, 3, 3, 9, 0, 0, 0, time.UTC)
+	periodicStart := time.Date(2003, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	// Init fixtures
+	// 03.03.2003 (MON) 09:00 - 12:00
+	// 04.03.2003 (TUE) 09:00 - 12:00 weekly
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, 3*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart, 3*time.Hour))
+	}
+
+	sEvents, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2003, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, 3, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, sEvents, 1)
+	require.Len(t, pEvents, 1)
+
+	// ok: GetSingleEvent / GetPeriodicEvent
+	{
+		sEvent, err := targetSvc.GetSingleEvent(ctx, sEvents[0].Id)
+		require.NoError(t, err)
+		require.Equal(t, sEvents[0], sEvent)
+
+		pEvent, err := targetSvc.GetPeriodicEvent(ctx, pEvents[0].Id)
+		require.NoError(t, err)
+		require.Equal(t, pEvents[0].Id, pEvent.Id)
+		require.Equal(t, pEvents[0].Version, pEvent.Version)
+	}
+
+	// fail: non-existing events
+	{
+		_, err := targetSvc.GetSingleEvent(ctx, 1000)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		_, err = targetSvc.GetPeriodicEvent(ctx, 1000)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSvc.DeleteSingleEvent(ctx, 1000, 1)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSvc.DeletePeriodicEvent(ctx, 1000, 1)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// fail: occupied slots before removal
+	{
+		err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart.Add(7*dayDur), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: DeleteSingleEvent / DeletePeriodicEvent
+	{
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, sEvents[0].Id, sEvents[0].Version))
+		require.NoError(t, targetSvc.DeletePeriodicEvent(ctx, pEvents[0].Id, pEvents[0].Version))
+
+		_, err := targetSvc.GetSingleEvent(ctx, sEvents[0].Id)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+		_, err = targetSvc.GetPeriodicEvent(ctx, pEvents[0].Id)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		sEvents, pEvents, err = targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2003, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, 3, 31, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Empty(t, sEvents)
+		require.Empty(t, pEvents)
+	}
+
+	// ok: freed slots are available again
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart.Add(7*dayDur), time.Hour))
+	}
+}
@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// taperStartSoC is the state of charge [%] the charging power starts to decrease from.
+	taperStartSoC = 80.0
+	// taperEndPowerRatio is the charging power ratio (to the max one) at the 100% state of charge (decreases linearly).
+	taperEndPowerRatio = 0.1
+	// taperPowerSlope is the charging power ratio decrease per state of charge percent above taperStartSoC.
+	taperPowerSlope = (1 - taperEndPowerRatio) / (100 - taperStartSoC)
+	// chargeDurationPrecision is the estimated charging duration round up precision.
+	chargeDurationPrecision = time.Minute
+	// slotEnergyPrecisionKWh is the slot energy round precision.
+	slotEnergyPrecisionKWh = 0.001
+)
+
+func (svc Scheduler) GetAvailableEnergyAgenda(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur time.Duration, request schema.EnergyRequest, opts schema.AgendaOptions) (retResults schema.EnergyAgendaResults, retErr error) {
+	// Input checks
+	if err := validateEnergyRequest(request); err != nil {
+		retErr = err
+		return
+	}
+
+	point, err := svc.getChargePoint(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	// Estimate and request
+	powerKW := getEffectivePower(point.MaxPowerKW, request.VehicleMaxPowerKW)
+	if powerKW == 0 {
+		retErr = fmt.Errorf("%s: unknown (neither the charge point nor the vehicle one is set): %w", "maxPower", common.ErrInvalidInput)
+		return
+	}
+	chargeDur, err := estimateChargeDuration(request, powerKW)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	agenda, err := svc.GetAvailableAgendaWithOptions(ctx, chargePointId, periodStart, periodDur, chargeDur, opts)
+	if err != nil {
+		retErr = err
+		return
+	}
+	for i := range agenda {
+		for j, slot := range agenda[i].TimeSlots {
+			agenda[i].TimeSlots[j].EnergyKWh = estimateChargeEnergy(request, powerKW, slot.Duration)
+		}
+	}
+
+	retResults = schema.EnergyAgendaResults{
+		EnergyKWh:      request.EnergyKWh,
+		PowerKW:        powerKW,
+		ChargeDuration: chargeDur,
+		Agenda:         agenda,
+	}
+
+	return
+}
+
+// validateEnergyRequest checks the schema.EnergyRequest.
+func validateEnergyRequest(request schema.EnergyRequest) error {
+	if request.EnergyKWh <= 0 {
+		return fmt.Errorf("%s: must be GT 0: %w", "energyKWh", common.ErrInvalidInput)
+	}
+	if request.VehicleMaxPowerKW < 0 {
+		return fmt.Errorf("%s: must be GTE 0: %w", "vehicleMaxPowerKW", common.ErrInvalidInput)
+	}
+	if request.BatteryCapacityKWh < 0 {
+		return fmt.Errorf("%s: must be GTE 0: %w", "batteryCapacityKWh", common.ErrInvalidInput)
+	}
+	if request.StartSoCPercent < 0 || request.StartSoCPercent >= 100 {
+		return fmt.Errorf("%s: must be within [0, 100): %w", "startSoCPercent", common.ErrInvalidInput)
+	}
+	if request.BatteryCapacityKWh > 0 && getEndSoC(request) > 100 {
+		return fmt.Errorf("%s: exceeds the battery free capacity: %w", "energyKWh", common.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// getEffectivePower returns the lower of non-zero charge point and vehicle powers (0 if both are unknown).
+func getEffectivePower(chargePointPowerKW, vehiclePowerKW float64) float64 {
+	switch {
+	case chargePointPowerKW == 0:
+		return vehiclePowerKW
+	case vehiclePowerKW == 0:
+		return chargePointPowerKW
+	default:
+		return math.Min(chargePointPowerKW, vehiclePowerKW)
+	}
+}
+
+// estimateChargeDuration estimates the charging duration for the requested energy rounding it up by chargeDurationPrecision.
+// With the taper model, power decreases linearly from powerKW at taperStartSoC to powerKW * taperEndPowerRatio at 100%.
+// Durations exceeding maxEventDur can't be booked and are rejected.
+func estimateChargeDuration(request schema.EnergyRequest, powerKW float64) (time.Duration, error) {
+	hours := request.EnergyKWh / powerKW
+	if request.BatteryCapacityKWh > 0 {
+		capacity, startSoC, endSoC := request.BatteryCapacityKWh, request.StartSoCPercent, getEndSoC(request)
+
+		// Constant power part
+		hours = 0
+		if bulkEndSoC := math.Min(endSoC, taperStartSoC); bulkEndSoC > startSoC {
+			hours += (bulkEndSoC - startSoC) / 100 * capacity / powerKW
+		}
+
+		// Taper part: P(soc) = P * (1 - k * (soc - taperStartSoC)), dt = capacity / 100 * dSoC / P(soc)
+		if taperFromSoC := math.Max(startSoC, taperStartSoC); endSoC > taperFromSoC {
+			hours += capacity / (100 * powerKW * taperPowerSlope) * math.Log(getTaperPowerRatio(taperFromSoC)/getTaperPowerRatio(endSoC))
+		}
+	}
+
+	// Checked before the conversion as a huge one overflows time.Duration
+	if math.IsNaN(hours) || hours > maxEventDur.Hours() {
+		return 0, fmt.Errorf("%s: charging duration (%.1fh at %g kW) must be LTE %s: %w", "energyKWh", hours, powerKW, maxEventDur, common.ErrInvalidInput)
+	}
+
+	dur := time.Duration(hours * float64(time.Hour))
+	if rounded := dur.Truncate(chargeDurationPrecision); rounded < dur {
+		dur = rounded + chargeDurationPrecision
+	}
+
+	return dur, nil
+}
+
+// estimateChargeEnergy estimates the energy charged within dur (the inverse of estimateChargeDuration) rounding it by slotEnergyPrecisionKWh.
+// With the taper model, charging stops at 100% state of charge.
+func estimateChargeEnergy(request schema.EnergyRequest, powerKW float64, dur time.Duration) float64 {
+	roundEnergy := func(energy float64) float64 {
+		return math.Round(energy/slotEnergyPrecisionKWh) * slotEnergyPrecisionKWh
+	}
+
+	hours := dur.Hours()
+	if request.BatteryCapacityKWh == 0 {
+		return roundEnergy(powerKW * hours)
+	}
+	capacity, soc := request.BatteryCapacityKWh, request.StartSoCPercent
+
+	// Constant power part
+	energy := 0.0
+	if soc < taperStartSoC {
+		bulkHours := (taperStartSoC - soc) / 100 * capacity / powerKW
+		if hours <= bulkHours {
+			return roundEnergy(powerKW * hours)
+		}
+		energy, hours, soc = (taperStartSoC-soc)/100*capacity, hours-bulkHours, taperStartSoC
+	}
+
+	// Taper part: the power ratio decreases exponentially over time, r(t) = r(soc) * exp(-100 * P * k * t / capacity)
+	endPowerRatio := getTaperPowerRatio(soc) * math.Exp(-100*powerKW*taperPowerSlope*hours/capacity)
+	endSoC := math.Min(taperStartSoC+(1-endPowerRatio)/taperPowerSlope, 100)
+	energy += (endSoC - soc) / 100 * capacity
+
+	return roundEnergy(energy)
+}
+
+// getTaperPowerRatio returns the charging power ratio (to the max one) at the soc [%] above taperStartSoC.
+func getTaperPowerRatio(soc float64) float64 {
+	return 1 - taperPowerSlope*(soc-taperStartSoC)
+}
+
+// getEndSoC returns the state of charge [%] after the requested energy is charged.
+func getEndSoC(request schema.EnergyRequest) float64 {
+	return request.StartSoCPercent + 100*request.EnergyKWh/request.BatteryCapacityKWh
+}
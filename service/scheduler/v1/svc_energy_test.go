@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_EnergyAgenda() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	cpId, err := targetSvc.CreateChargePoint(ctx, "CP-22KW", "", 1, "", 22)
+	require.NoError(t, err)
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly (default and 22 kW charge points)
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied (22 kW charge point)
+	{
+		eventStart := time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 4*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, cpId, schema.SingleEventTypeAvailable, eventStart, 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, cpId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), time.Hour))
+	}
+	agendaStart := time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)
+
+	// fail: wrong inputs
+	{
+		for _, request := range []schema.EnergyRequest{
+			{EnergyKWh: 0},
+			{EnergyKWh: 10, VehicleMaxPowerKW: -1},
+			{EnergyKWh: 10, BatteryCapacityKWh: -1},
+			{EnergyKWh: 10, StartSoCPercent: 100},
+			{EnergyKWh: 50, BatteryCapacityKWh: 60, StartSoCPercent: 20},
+			// charging duration exceeds the max event one (or overflows time.Duration)
+			{EnergyKWh: 10000, VehicleMaxPowerKW: 11},
+			{EnergyKWh: 1e300, VehicleMaxPowerKW: 1e-300},
+		} {
+			_, err := targetSvc.GetAvailableEnergyAgenda(ctx, cpId, agendaStart, dayDur, request, schema.AgendaOptions{})
+			require.True(t, errors.Is(err, common.ErrInvalidInput), "%+v", request)
+		}
+
+		// Default charge point max power is unknown
+		_, err := targetSvc.GetAvailableEnergyAgenda(ctx, schema.DefaultChargePointId, agendaStart, dayDur, schema.EnergyRequest{EnergyKWh: 10}, schema.AgendaOptions{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: vehicle power is used if the charge point one is unknown
+	// 11 kWh / 11 kW = 1h: 09:30, 10:30, 11:30, 12:30
+	{
+		results, err := targetSvc.GetAvailableEnergyAgenda(ctx, schema.DefaultChargePointId, agendaStart, dayDur, schema.EnergyRequest{
+			EnergyKWh:         11,
+			VehicleMaxPowerKW: 11,
+		}, schema.AgendaOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 11.0, results.PowerKW)
+		require.Equal(t, time.Hour, results.ChargeDuration)
+		require.Len(t, results.Agenda, 1)
+		require.Len(t, results.Agenda[0].TimeSlots, 4)
+		for _, slot := range results.Agenda[0].TimeSlots {
+			require.Equal(t, time.Hour, slot.Duration)
+			require.Equal(t, 11.0, slot.EnergyKWh)
+		}
+	}
+
+	// ok: the lower of the charge point and vehicle powers is used
+	{
+		// 30 kWh / 22 kW = 1h21m49s -> 1h22m: only the 11:30 - 13:30 window fits
+		results, err := targetSvc.GetAvailableEnergyAgenda(ctx, cpId, agendaStart, dayDur, schema.EnergyRequest{
+			EnergyKWh:         30,
+			VehicleMaxPowerKW: 50,
+		}, schema.AgendaOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 22.0, results.PowerKW)
+		require.Equal(t, 82*time.Minute, results.ChargeDuration)
+		require.Len(t, results.Agenda[0].TimeSlots, 1)
+		require.Equal(t, time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC), results.Agenda[0].TimeSlots[0].Start)
+		// 22 kW * 1h22m (rounded up duration)
+		require.Equal(t, 30.067, results.Agenda[0].TimeSlots[0].EnergyKWh)
+
+		// 30 kWh / 11 kW = 2h43m38s -> 2h44m: no window fits
+		results, err = targetSvc.GetAvailableEnergyAgenda(ctx, cpId, agendaStart, dayDur, schema.EnergyRequest{
+			EnergyKWh:         30,
+			VehicleMaxPowerKW: 11,
+		}, schema.AgendaOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 11.0, results.PowerKW)
+		require.Equal(t, 164*time.Minute, results.ChargeDuration)
+		require.Empty(t, results.Agenda[0].TimeSlots)
+	}
+
+	// ok: taper model
+	{
+		// 40% -> 90% of 60 kWh at 11 kW: 24 kWh / 11 kW (2h10m55s) + 80% -> 90% taper (43m29s) -> 2h55m
+		results, err := targetSvc.GetAvailableEnergyAgenda(ctx, schema.DefaultChargePointId, agendaStart, dayDur, schema.EnergyRequest{
+			EnergyKWh:          30,
+			VehicleMaxPowerKW:  11,
+			BatteryCapacityKWh: 60,
+			StartSoCPercent:    40,
+		}, schema.AgendaOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 175*time.Minute, results.ChargeDuration)
+		require.NotEmpty(t, results.Agenda[0].TimeSlots)
+		for _, slot := range results.Agenda[0].TimeSlots {
+			// The taper part is charged at a lower power (rounded up duration adds less than 11 kW * 1m)
+			require.GreaterOrEqual(t, slot.EnergyKWh, 30.0)
+			require.Less(t, slot.EnergyKWh, 30.0+11.0/60)
+		}
+
+		// Charging below the taper start SoC is not affected
+		results, err = targetSvc.GetAvailableEnergyAgenda(ctx, schema.DefaultChargePointId, agendaStart, dayDur, schema.EnergyRequest{
+			EnergyKWh:          11,
+			VehicleMaxPowerKW:  11,
+			BatteryCapacityKWh: 60,
+			StartSoCPercent:    10,
+		}, schema.AgendaOptions{})
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, results.ChargeDuration)
+
+		// Full taper range (80% -> 100%) takes ln(10) / 0.9 times longer than without it
+		fullTaperRequest := schema.EnergyRequest{EnergyKWh: 12, BatteryCapacityKWh: 60, StartSoCPercent: 80}
+		dur, err := estimateChargeDuration(fullTaperRequest, 12)
+		require.NoError(t, err)
+		require.Equal(t, 154*time.Minute, dur)
+
+		// Charging stops at 100% (the rounded up duration adds nothing), the power drops, so the first half of the duration gives the most of the energy
+		require.Equal(t, 12.0, estimateChargeEnergy(fullTaperRequest, 12, dur))
+		require.Greater(t, estimateChargeEnergy(fullTaperRequest, 12, dur/2), 6.0)
+		require.Equal(t, 6.0, estimateChargeEnergy(schema.EnergyRequest{EnergyKWh: 6, BatteryCapacityKWh: 60, StartSoCPercent: 10}, 12, 30*time.Minute))
+	}
+}
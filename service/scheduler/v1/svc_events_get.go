@@ -6,12 +6,11 @@ import (
 	"sort"
 	"time"
 
-	"github.com/itiky/charge_scheduler/common"
 	"github.com/itiky/charge_scheduler/schema"
 )
 
-func (svc Scheduler) getGreenRedEvents(ctx context.Context, periodStart, periodEnd time.Time) (retGreenEvents, retRedEvents []*event, retErr error) {
-	events, err := svc.getAllRangedEvents(ctx, periodStart, periodEnd)
+func (svc Scheduler) getGreenRedEvents(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time) (retGreenEvents, retRedEvents []*event, retErr error) {
+	events, err := svc.getAllRangedEvents(ctx, chargePointId, periodStart, periodEnd)
 	if err != nil {
 		retErr = fmt.Errorf("svc.getAllRangedEvents: %w", err)
 		return
@@ -49,14 +48,20 @@ func (svc Scheduler) getGreenRedEvents(ctx context.Context, periodStart, periodE
 	return
 }
 
-func (svc Scheduler) getAllRangedEvents(ctx context.Context, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
-	sEvents, err := svc.getSingleRangedEvents(ctx, periodStart, periodEnd)
+func (svc Scheduler) getAllRangedEvents(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	sEvents, err := svc.getSingleRangedEvents(ctx, chargePointId, loc, periodStart, periodEnd)
 	if err != nil {
 		retErr = fmt.Errorf("svc.getSingleRangedEvents: %w", err)
 		return
 	}
 
-	pEvents, err := svc.getPeriodicRangedEvents(ctx, periodStart, periodEnd)
+	pEvents, err := svc.getPeriodicRangedEvents(ctx, chargePointId, loc, periodStart, periodEnd)
 	if err != nil {
 		retErr = fmt.Errorf("svc.getPeriodicRangedEvents: %w", err)
 		return
@@ -67,48 +72,48 @@ func (svc Scheduler) getAllRangedEvents(ctx context.Context, periodStart, period
 	return
 }
 
-func (svc Scheduler) getSingleRangedEvents(ctx context.Context, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
-	dbEvents, err := svc.eventsSt.GetSingleEventsWithinRange(ctx, periodStart, periodEnd)
+// getSingleRangedEvents returns single events intersecting the period.
+func (svc Scheduler) getSingleRangedEvents(ctx context.Context, chargePointId int64, loc *time.Location, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
+	dbEvents, err := svc.eventsSt.GetSingleEventsIntersectingRange(ctx, chargePointId, periodStart, periodEnd, false)
 	if err != nil {
-		retErr = fmt.Errorf("svc.eventsSt.GetSingleEventsWithinRange(%s, %s): %w", periodStart.Format(common.TimeFmt), periodEnd.Format(common.TimeFmt), err)
+		retErr = fmt.Errorf("svc.eventsSt.GetSingleEventsIntersectingRange: %w", err)
 		return
 	}
 
 	retEvents = make([]event, 0, len(dbEvents))
 	for _, dbEvent := range dbEvents {
+		eventStart := dbEvent.StartDateTime.In(loc)
 		retEvents = append(retEvents, event{
 			Id:    dbEvent.Id,
 			Type:  dbEvent.Type,
-			Start: dbEvent.StartDateTime,
-			End:   cloneTimeWithHourAndMinutes(dbEvent.StartDateTime, dbEvent.EndHours, dbEvent.EndMinutes),
+			Start: eventStart,
+			End:   eventStart.Add(dbEvent.Duration),
 		})
 	}
 
 	return
 }
 
-func (svc Scheduler) getPeriodicRangedEvents(ctx context.Context, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
-	dbEvents, err := svc.eventsSt.GetAllPeriodicEvents(ctx)
+func (svc Scheduler) getPeriodicRangedEvents(ctx context.Context, chargePointId int64, loc *time.Location, periodStart, periodEnd time.Time) (retEvents []event, retErr error) {
+	// Only rules which might produce occurrences within the period are expanded
+	cacheGeneration := svc.getOccurrenceCacheGeneration(chargePointId)
+	dbEvents, err := svc.eventsSt.GetPeriodicEventsActiveWithin(ctx, chargePointId, periodStart, periodEnd)
 	if err != nil {
-		retErr = fmt.Errorf("svc.eventsSt.GetAllPeriodicEvents: %w", err)
+		retErr = fmt.Errorf("svc.eventsSt.GetPeriodicEventsActiveWithin: %w", err)
 		return
 	}
 
 	retEvents = make([]event, 0)
 	for _, dbEvent := range dbEvents {
-		for _, t := range dbEvent.Rrule.Between(periodStart, periodEnd, true) {
-			retEvents = append(retEvents, event{
-				Id:    dbEvent.Id,
-				Type:  dbEvent.Type,
-				Start: t,
-				End:   cloneTimeWithHourAndMinutes(t, dbEvent.EndHours, dbEvent.EndMinutes),
-			})
+		// Occurrences started before the period might end within it
+		occurrences := svc.getCachedPeriodicEventOccurrences(dbEvent, loc, periodStart.Add(-getPeriodicEventMaxDuration(dbEvent)), periodEnd, cacheGeneration)
+		for _, occurrence := range occurrences {
+			if occurrence.End.Before(periodStart) {
+				continue
+			}
+			retEvents = append(retEvents, occurrence)
 		}
 	}
 
 	return
 }
-
-func cloneTimeWithHourAndMinutes(t time.Time, h, m uint) time.Time {
-	return time.Date(t.Year(), t.Month(), t.Day(), int(h), int(m), t.Second(), t.Nanosecond(), t.Location())
-}
@@ -1,17 +1,49 @@
 package v1
 
+// checkEventsIntersect checks [Start, End) ranges intersection: touching events don't intersect (those might be adjacent).
+// The same rule is enforced by the PostgreSQL exclusion constraint.
 func (svc Scheduler) checkEventsIntersect(e1, e2 *event) bool {
-	earlierEvent, laterEvent := e1, e2
-	if laterEvent.Start.Before(earlierEvent.Start) {
-		earlierEvent, laterEvent = laterEvent, earlierEvent
+	return e1.Start.Before(e2.End) && e2.Start.Before(e1.End)
+}
+
+// joinTouchingEvents joins touching (or intersecting) elements of an events list sorted by start into disjoint ranges
+// (with the first element ID).
+// Returns the input if nothing touches, a relinked list otherwise: joined elements are replaced with copies, the rest are kept.
+func joinTouchingEvents(events []*event) []*event {
+	touching := false
+	for i := 1; i < len(events); i++ {
+		if !events[i-1].End.Before(events[i].Start) {
+			touching = true
+			break
+		}
+	}
+	if !touching {
+		return events
 	}
 
-	if laterEvent.Start.Equal(earlierEvent.Start) || laterEvent.Start.Equal(earlierEvent.End) {
-		return true
+	joinedEvents, copied := make([]*event, 0, len(events)), make([]bool, 0, len(events))
+	for _, cur := range events {
+		lastIdx := len(joinedEvents) - 1
+		if lastIdx < 0 || joinedEvents[lastIdx].End.Before(cur.Start) {
+			joinedEvents, copied = append(joinedEvents, cur), append(copied, false)
+			continue
+		}
+
+		if !copied[lastIdx] {
+			joined := *joinedEvents[lastIdx]
+			joinedEvents[lastIdx], copied[lastIdx] = &joined, true
+		}
+		if cur.End.After(joinedEvents[lastIdx].End) {
+			joinedEvents[lastIdx].End = cur.End
+		}
 	}
-	if laterEvent.Start.After(earlierEvent.Start) && laterEvent.Start.Before(earlierEvent.End) {
-		return true
+
+	for i, cur := range joinedEvents {
+		cur.Prev, cur.Next = nil, nil
+		if i > 0 {
+			cur.Prev, joinedEvents[i-1].Next = joinedEvents[i-1], cur
+		}
 	}
 
-	return false
+	return joinedEvents
 }
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/stretchr/testify/require"
@@ -28,19 +29,19 @@ func (s *ServiceTestSuite) Test_checkEventsIntersect() {
 		}
 	}
 
-	// intersect: one point
+	// no intersect: one point (touching)
 	{
 		// e1.End == e2.Start
 		{
 			e1 := &event{Start: now.Add(-1 * time.Second), End: now}
 			e2 := &event{Start: now, End: now.Add(1 * time.Second)}
-			require.True(t, targetSvc.checkEventsIntersect(e1, e2))
+			require.False(t, targetSvc.checkEventsIntersect(e1, e2))
 		}
 		// e1.Start == e2.End
 		{
 			e1 := &event{Start: now, End: now.Add(1 * time.Second)}
 			e2 := &event{Start: now.Add(-1 * time.Second), End: now}
-			require.True(t, targetSvc.checkEventsIntersect(e1, e2))
+			require.False(t, targetSvc.checkEventsIntersect(e1, e2))
 		}
 	}
 
@@ -76,3 +77,36 @@ func (s *ServiceTestSuite) Test_checkEventsIntersect() {
 		}
 	}
 }
+
+func (s *ServiceTestSuite) Test_joinTouchingEvents() {
+	t := s.T()
+
+	now := time.Now()
+	newEvents := func() []*event {
+		return cloneEventsLinkedList([]*event{
+			{Id: 1, Start: now, End: now.Add(1 * time.Second)},
+			{Id: 2, Start: now.Add(1 * time.Second), End: now.Add(2 * time.Second)},
+			{Id: 3, Start: now.Add(2 * time.Second), End: now.Add(3 * time.Second)},
+			{Id: 4, Start: now.Add(4 * time.Second), End: now.Add(5 * time.Second)},
+		})
+	}
+
+	// ok: nothing touches
+	{
+		events := newEvents()[2:]
+		events[0].Prev = nil
+		require.Equal(t, events, joinTouchingEvents(events))
+		require.Empty(t, joinTouchingEvents(nil))
+	}
+
+	// ok: touching ones are joined, inputs are not modified
+	{
+		events := newEvents()
+		joinedEvents := joinTouchingEvents(events)
+		require.Equal(t, []string{
+			fmt.Sprintf("1  [%s - %s) prev ok: true", now.Format(time.RFC3339), now.Add(3*time.Second).Format(time.RFC3339)),
+			fmt.Sprintf("4  [%s - %s) prev ok: true", now.Add(4*time.Second).Format(time.RFC3339), now.Add(5*time.Second).Format(time.RFC3339)),
+		}, eventsLinkedListValues(joinedEvents[0]))
+		require.Equal(t, now.Add(1*time.Second), events[0].End)
+	}
+}
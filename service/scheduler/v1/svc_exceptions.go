@@ -0,0 +1,257 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) AddPeriodicEventException(ctx context.Context, periodicEventId, version int64, occurrenceStart time.Time) (int64, error) {
+	return svc.addPeriodicEventException(ctx, periodicEventId, version, occurrenceStart, nil, 0)
+}
+
+func (svc Scheduler) AddPeriodicEventOverride(ctx context.Context, periodicEventId, version int64, occurrenceStart, overrideStart time.Time, overrideDur time.Duration) (int64, error) {
+	if overrideStart.IsZero() {
+		return 0, fmt.Errorf("%s: zero: %w", "overrideStart", common.ErrInvalidInput)
+	}
+
+	return svc.addPeriodicEventException(ctx, periodicEventId, version, occurrenceStart, &overrideStart, overrideDur)
+}
+
+func (svc Scheduler) RemovePeriodicEventException(ctx context.Context, exceptionId, version int64) error {
+	// Remove and check the restored occurrence within a single (write locked) transaction
+	var changes []schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		exception, err := txSvc.eventsSt.GetPeriodicEventException(ctx, exceptionId)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetPeriodicEventException(%d): %w", exceptionId, err)
+		}
+		if exception == nil {
+			return fmt.Errorf("%s (%d): %w", "exceptionId", exceptionId, common.ErrNotFound)
+		}
+
+		prevEvent, err := txSvc.getPeriodicEvent(ctx, exception.PeriodicEventId)
+		if err != nil {
+			return err
+		}
+		if err := txSvc.incrementPeriodicEventVersion(ctx, *prevEvent, version); err != nil {
+			return err
+		}
+
+		if err := txSvc.eventsSt.DeletePeriodicEventException(ctx, exceptionId); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.DeletePeriodicEventException: %w", err)
+		}
+
+		event, err := txSvc.getPeriodicEvent(ctx, exception.PeriodicEventId)
+		if err != nil {
+			return err
+		}
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationUpdate, prevEvent, event); err != nil {
+			return err
+		}
+
+		// Check the restored occurrence (moved occurrences of the same event are not deduplicated by RRule set)
+		for _, otherException := range event.Exceptions {
+			if otherException.IsOverride() && otherException.OverrideStart.Equal(exception.OccurrenceStart) {
+				return fmt.Errorf("restored occurrence intersects with the moved one (%d): %w", otherException.Id, common.ErrInvalidInput)
+			}
+		}
+		if err := txSvc.checkOccurrenceIntersections(ctx, *event, exception.OccurrenceStart, event.Duration); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, event.Type, exception.OccurrenceStart, event.Duration, nil); err != nil {
+			return err
+		}
+		changes = append(changes, newOccurrenceChange(*event, exception.OccurrenceStart, event.Duration))
+		if exception.IsOverride() {
+			changes = append(changes, newOccurrenceChange(*event, *exception.OverrideStart, exception.OverrideDuration))
+
+			// Occupied events might have been covered by the moved availability occurrence
+			if event.Type == schema.SingleEventTypeAvailable {
+				if warnings, err = txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, *exception.OverrideStart, exception.OverrideStart.Add(exception.OverrideDuration), nil); err != nil {
+					return err
+				}
+			}
+		}
+		svc.logger.Info().Stringer("exception", exception).Msgf("event exception removed")
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(changes...)
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+// addPeriodicEventException skips (overrideStart is nil) or moves the periodic event occurrence.
+func (svc Scheduler) addPeriodicEventException(ctx context.Context, periodicEventId, version int64, occurrenceStart time.Time, overrideStart *time.Time, overrideDur time.Duration) (retId int64, retErr error) {
+	// Input checks
+	if occurrenceStart.IsZero() {
+		retErr = fmt.Errorf("%s: zero: %w", "occurrenceStart", common.ErrInvalidInput)
+		return
+	}
+
+	// Check and create within a single (write locked) transaction
+	var changes []schema.EventsChange
+	var warnings common.Warnings
+	retErr = svc.withTx(ctx, func(txSvc Scheduler) error {
+		event, err := txSvc.getPeriodicEvent(ctx, periodicEventId)
+		if err != nil {
+			return err
+		}
+		if err := txSvc.incrementPeriodicEventVersion(ctx, *event, version); err != nil {
+			return err
+		}
+
+		loc, err := txSvc.getChargePointLocation(ctx, event.ChargePointId)
+		if err != nil {
+			return err
+		}
+
+		if !isRuleOccurrence(&event.Rrule, occurrenceStart) {
+			return fmt.Errorf("%s: not an event occurrence: %w", "occurrenceStart", common.ErrInvalidInput)
+		}
+		for _, exception := range event.Exceptions {
+			if exception.OccurrenceStart.Equal(occurrenceStart) {
+				return fmt.Errorf("%s: occurrence already has an exception (%d): %w", "occurrenceStart", exception.Id, common.ErrInvalidInput)
+			}
+		}
+
+		if overrideStart != nil {
+			// Moved occurrence is defined within the charge point time zone
+			localOverrideStart := overrideStart.In(loc)
+			overrideStart = &localOverrideStart
+
+			if err := txSvc.validateEventInput(event.Type, *overrideStart, overrideDur); err != nil {
+				return err
+			}
+
+			// Moved occurrence must not match other occurrences of the same event (those are deduplicated by RRule set)
+			for _, occurrence := range getPeriodicEventOccurrences(*event, loc, *overrideStart, *overrideStart) {
+				if !occurrence.Start.Equal(occurrenceStart) {
+					return fmt.Errorf("%s: matches another event occurrence: %w", "overrideStart", common.ErrInvalidInput)
+				}
+			}
+		}
+
+		// Create
+		exception := schema.PeriodicEventException{
+			PeriodicEventId:  periodicEventId,
+			OccurrenceStart:  occurrenceStart,
+			OverrideStart:    overrideStart,
+			OverrideDuration: overrideDur,
+			CreatedAt:        time.Now().UTC(),
+		}
+		id, err := txSvc.eventsSt.CreatePeriodicEventException(ctx, exception)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.CreatePeriodicEventException: %w", err)
+		}
+		exception.Id = id
+
+		updatedEvent, err := txSvc.getPeriodicEvent(ctx, periodicEventId)
+		if err != nil {
+			return err
+		}
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationUpdate, event, updatedEvent); err != nil {
+			return err
+		}
+
+		// Check the moved occurrence (skipped one can't intersect anything)
+		changes = append(changes, newOccurrenceChange(*event, occurrenceStart, event.Duration))
+		if overrideStart != nil {
+			if err := txSvc.checkOccurrenceIntersections(ctx, *event, *overrideStart, overrideDur); err != nil {
+				return err
+			}
+			if warnings, err = txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, event.Type, *overrideStart, overrideDur, nil); err != nil {
+				return err
+			}
+			changes = append(changes, newOccurrenceChange(*event, *overrideStart, overrideDur))
+		}
+
+		// Occupied events might have been covered by the skipped / moved availability occurrence
+		if event.Type == schema.SingleEventTypeAvailable {
+			if warnings, err = txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, occurrenceStart, occurrenceStart.Add(event.Duration), nil); err != nil {
+				return err
+			}
+		}
+		retId = id
+		svc.logger.Info().Stringer("exception", exception).Msgf("event exception created")
+
+		return nil
+	})
+	if retErr != nil {
+		return
+	}
+	svc.notifyEventsChanged(changes...)
+	common.AddWarnings(ctx, warnings...)
+
+	return
+}
+
+// checkOccurrenceIntersections checks that the (already stored) periodic event occurrence doesn't intersect other same type events.
+func (svc Scheduler) checkOccurrenceIntersections(ctx context.Context, obj schema.PeriodicEvent, occurrenceStart time.Time, occurrenceDur time.Duration) error {
+	loc, err := svc.getChargePointLocation(ctx, obj.ChargePointId)
+	if err != nil {
+		return err
+	}
+	occurrenceStart = occurrenceStart.In(loc)
+
+	occurrence := &event{
+		Start: occurrenceStart,
+		End:   occurrenceStart.Add(occurrenceDur),
+	}
+
+	// Get existing events [occurrenceStart -1 day : occurrenceEnd +1 day]
+	rangeStart, rangeEnd := occurrence.Start.Add(-dayDur), occurrence.End.Add(dayDur)
+	existingTargetEvents, err := svc.getTargetEvents(ctx, obj.ChargePointId, obj.Type, rangeStart, rangeEnd, nil)
+	if err != nil {
+		return err
+	}
+
+	occurrenceKey := eventKey{Id: obj.Id, Periodic: true}
+	for _, existingEvent := range existingTargetEvents {
+		// Skip the occurrence itself
+		if existingEvent.Key() == occurrenceKey && existingEvent.Start.Equal(occurrence.Start) {
+			continue
+		}
+
+		if svc.checkEventsIntersect(occurrence, existingEvent) {
+			return fmt.Errorf("occurrence intersects with an existing event (%d: %s): %w", existingEvent.Id, existingEvent.Type, common.ErrInvalidInput)
+		}
+	}
+
+	return nil
+}
+
+// incrementPeriodicEventVersion checks the periodic event version known by the caller and increments the stored one
+// (must be called within the exception change transaction): exceptions are the event part, so a stale event update must not drop them.
+func (svc Scheduler) incrementPeriodicEventVersion(ctx context.Context, event schema.PeriodicEvent, version int64) error {
+	if err := checkEventVersion(event.Id, version, event.Version); err != nil {
+		return err
+	}
+	if err := svc.eventsSt.UpdatePeriodicEvent(ctx, event); err != nil {
+		return fmt.Errorf("svc.eventsSt.UpdatePeriodicEvent(%d): %w", event.Id, err)
+	}
+
+	return nil
+}
+
+// getPeriodicEvent returns an existing periodic event (with its exceptions) or common.ErrNotFound.
+func (svc Scheduler) getPeriodicEvent(ctx context.Context, eventId int64) (*schema.PeriodicEvent, error) {
+	event, err := svc.eventsSt.GetPeriodicEvent(ctx, eventId)
+	if err != nil {
+		return nil, fmt.Errorf("svc.eventsSt.GetPeriodicEvent(%d): %w", eventId, err)
+	}
+	if event == nil {
+		return nil, fmt.Errorf("%s (%d): %w", "periodicEventId", eventId, common.ErrNotFound)
+	}
+
+	return event, nil
+}
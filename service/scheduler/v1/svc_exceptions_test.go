@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_PeriodicEventExceptions() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	getAgendaSlotsCnt := func(date time.Time) int {
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, date, dayDur, time.Hour)
+		require.NoError(t, err)
+		for _, agenda := range agendas {
+			if agenda.Date.Equal(date) {
+				return len(agenda.TimeSlots)
+			}
+		}
+
+		return 0
+	}
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	var eventId int64
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+
+		pEvents, err := s.r.StorageRes.Storage.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Len(t, pEvents, 1)
+		eventId = pEvents[0].Id
+	}
+
+	// fail: wrong inputs
+	{
+		_, err := targetSvc.AddPeriodicEventException(ctx, eventId, 1, time.Time{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// zero version
+		_, err = targetSvc.AddPeriodicEventException(ctx, eventId, 0, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// non-existing event
+		_, err = targetSvc.AddPeriodicEventException(ctx, eventId+1, 1, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		// not an occurrence
+		_, err = targetSvc.AddPeriodicEventException(ctx, eventId, 1, time.Date(2014, 8, 12, 9, 30, 0, 0, time.UTC))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// override: end before start
+		_, err = targetSvc.AddPeriodicEventOverride(ctx, eventId, 1, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), -time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// override: matches another occurrence
+		_, err = targetSvc.AddPeriodicEventOverride(ctx, eventId, 1, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), time.Date(2014, 8, 18, 9, 30, 0, 0, time.UTC), 2*time.Hour+30*time.Minute)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// non-existing exception
+		err = targetSvc.RemovePeriodicEventException(ctx, 100, 1)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: skip a holiday
+	// 11.08.2014 (MON)
+	var skipExceptionId int64
+	{
+		require.Equal(t, 4, getAgendaSlotsCnt(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)))
+
+		id, err := targetSvc.AddPeriodicEventException(ctx, eventId, 1, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		skipExceptionId = id
+
+		event, err := s.r.StorageRes.Storage.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, event.Version)
+
+		require.Equal(t, 0, getAgendaSlotsCnt(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)))
+		require.Equal(t, 4, getAgendaSlotsCnt(time.Date(2014, 8, 18, 0, 0, 0, 0, time.UTC)))
+
+		// fail: the same occurrence
+		_, err = targetSvc.AddPeriodicEventException(ctx, eventId, 2, time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// fail: stale version (the event exceptions were changed since)
+	{
+		_, err := targetSvc.AddPeriodicEventException(ctx, eventId, 1, time.Date(2014, 8, 18, 9, 30, 0, 0, time.UTC))
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		err = targetSvc.RemovePeriodicEventException(ctx, skipExceptionId, 1)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		// exceptions are not dropped by a stale update
+		err = targetSvc.UpdatePeriodicEvent(ctx, eventId, 1, schema.SingleEventTypeAvailable, time.Date(2014, 8, 6, 9, 30, 0, 0, time.UTC), 4*time.Hour)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		event, err := s.r.StorageRes.Storage.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, event.Version)
+		require.Len(t, event.Exceptions, 1)
+	}
+
+	// ok: different hours (the same start)
+	// 18.08.2014 (MON) 09:30 - 11:30
+	{
+		_, err := targetSvc.AddPeriodicEventOverride(ctx, eventId, 2, time.Date(2014, 8, 18, 9, 30, 0, 0, time.UTC), time.Date(2014, 8, 18, 9, 30, 0, 0, time.UTC), 2*time.Hour)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, getAgendaSlotsCnt(time.Date(2014, 8, 18, 0, 0, 0, 0, time.UTC)))
+	}
+
+	// ok: moved to the next day
+	// 25.08.2014 (MON) -> 26.08.2014 (TUE) 14:00 - 20:00
+	{
+		_, err := targetSvc.AddPeriodicEventOverride(ctx, eventId, 3, time.Date(2014, 8, 25, 9, 30, 0, 0, time.UTC), time.Date(2014, 8, 26, 14, 0, 0, 0, time.UTC), 6*time.Hour)
+		require.NoError(t, err)
+
+		require.Equal(t, 0, getAgendaSlotsCnt(time.Date(2014, 8, 25, 0, 0, 0, 0, time.UTC)))
+		require.Equal(t, 6, getAgendaSlotsCnt(time.Date(2014, 8, 26, 0, 0, 0, 0, time.UTC)))
+	}
+
+	// fail: moved occurrence intersects a single event
+	// 01.09.2014 (MON) -> 02.09.2014 (TUE) 09:00 - 10:00 intersects 02.09.2014 09:30 - 10:30
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 9, 2, 9, 30, 0, 0, time.UTC), time.Hour))
+
+		_, err := targetSvc.AddPeriodicEventOverride(ctx, eventId, 4, time.Date(2014, 9, 1, 9, 30, 0, 0, time.UTC), time.Date(2014, 9, 2, 9, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// rolled back
+		require.Equal(t, 4, getAgendaSlotsCnt(time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC)))
+	}
+
+	// fail: RemovePeriodicEventException: restored occurrence intersects a single event created meanwhile
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour))
+
+		err := targetSvc.RemovePeriodicEventException(ctx, skipExceptionId, 4)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: RemovePeriodicEventException
+	{
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, sEvents[0].Id, sEvents[0].Version))
+
+		require.NoError(t, targetSvc.RemovePeriodicEventException(ctx, skipExceptionId, 4))
+		require.Equal(t, 4, getAgendaSlotsCnt(time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)))
+	}
+
+	// ok: UpdatePeriodicEvent drops exceptions not matching the new rule
+	{
+		require.NoError(t, targetSvc.UpdatePeriodicEvent(ctx, eventId, 5, schema.SingleEventTypeAvailable, time.Date(2014, 8, 6, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+
+		event, err := s.r.StorageRes.Storage.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Empty(t, event.Exceptions)
+	}
+}
@@ -3,15 +3,26 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/itiky/charge_scheduler/common"
 	"github.com/itiky/charge_scheduler/schema"
 )
 
-const dayDur = 24 * time.Hour
+const (
+	dayDur = 24 * time.Hour
+	// maxEventDur limits an event duration (events might span midnight and multiple days).
+	maxEventDur = 31 * dayDur
+	// minAgendaStep limits agenda slot starts step and alignment.
+	minAgendaStep = time.Minute
+)
+
+func (svc Scheduler) GetAvailableAgenda(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration) (schema.AgendaResults, error) {
+	return svc.GetAvailableAgendaWithOptions(ctx, chargePointId, periodStart, periodDur, desiredDur, schema.AgendaOptions{})
+}
 
-func (svc Scheduler) GetAvailableAgenda(ctx context.Context, periodStart time.Time, periodDur, desiredDur time.Duration) (retAgendas schema.AgendaResults, retErr error) {
+func (svc Scheduler) GetAvailableAgendaWithOptions(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration, opts schema.AgendaOptions) (retAgendas schema.AgendaResults, retErr error) {
 	// Input checks
 	if periodStart.IsZero() {
 		retErr = fmt.Errorf("%s: zero: %w", "periodStart", common.ErrInvalidInput)
@@ -25,10 +36,22 @@ func (svc Scheduler) GetAvailableAgenda(ctx context.Context, periodStart time.Ti
 		retErr = fmt.Errorf("%s: must be GT 0: %w", "desiredDur", common.ErrInvalidInput)
 		return
 	}
+	if err := validateAgendaOptions(opts); err != nil {
+		retErr = err
+		return
+	}
+
+	// Agenda days are cut at the charge point time zone midnight
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+	periodStart = periodStart.In(loc)
 
 	// Get existing events [-1 day : +periodDur +1 day]
-	rangeStart, rangeEnd := periodStart.Add(-dayDur), periodStart.Add(periodDur).Add(dayDur)
-	greenEvents, redEvents, err := svc.getGreenRedEvents(ctx, rangeStart, rangeEnd)
+	rangeStart, rangeEnd := periodStart.Add(-dayDur), addCalendarDuration(periodStart, periodDur).Add(dayDur)
+	greenEvents, redEvents, err := svc.getGreenRedEvents(ctx, chargePointId, rangeStart, rangeEnd)
 	if err != nil {
 		retErr = fmt.Errorf("svc.getGreenRedEvents: %w", err)
 		return
@@ -36,192 +59,171 @@ func (svc Scheduler) GetAvailableAgenda(ctx context.Context, periodStart time.Ti
 
 	// Remove reds from greens and build the result
 	greenHead := svc.mergeGreenRedEvents(greenEvents, redEvents)
-	retAgendas = svc.buildAgendaResults(greenHead, periodStart, periodDur, desiredDur)
+	retAgendas = svc.buildAgendaResults(greenHead, periodStart, periodDur, desiredDur, opts)
 
 	return
 }
 
-// mergeGreenRedEvents alters greens by splitting / removing its elements (subtracting red elements).
+// validateAgendaOptions checks the schema.AgendaOptions.
+func validateAgendaOptions(opts schema.AgendaOptions) error {
+	if opts.Step != 0 && opts.Step < minAgendaStep {
+		return fmt.Errorf("%s: must be 0 or GTE %s: %w", "step", minAgendaStep, common.ErrInvalidInput)
+	}
+	if opts.Align != 0 {
+		if opts.Align < minAgendaStep {
+			return fmt.Errorf("%s: must be 0 or GTE %s: %w", "align", minAgendaStep, common.ErrInvalidInput)
+		}
+		if dayDur%opts.Align != 0 {
+			return fmt.Errorf("%s: must divide 24h: %w", "align", common.ErrInvalidInput)
+		}
+	}
+
+	return nil
+}
+
+// mergeGreenRedEvents subtracts reds from greens returning the resulting greens linked list head (nil if nothing left).
+// Greens must be a sorted non-overlapping linked list (touching ones are joined into a single window), reds might overlap each other.
+// Untouched green elements are kept "as is" (relinked), split / cut ones are replaced with new elements.
+// Sweep-line: reds are sorted by start (if not already) and joined into disjoint ranges, so red ends are sorted as well:
+// ended reds are passed once and only the last red scanned for a green might be rescanned for the next one, O(g + r log r).
 func (svc Scheduler) mergeGreenRedEvents(greenEvents, redEvents []*event) *event {
 	if len(greenEvents) == 0 {
 		return nil
 	}
+	greenEvents = joinTouchingEvents(greenEvents)
 	if len(redEvents) == 0 {
 		return greenEvents[0]
 	}
 
-	greenHead := greenEvents[0]
-	for _, redCur := range redEvents {
-		for greenCur := greenHead; greenCur != nil; greenCur = greenCur.Next {
-			// Optimization
-			if greenCur.Start.After(redCur.End) {
-				break
-			}
-
-			// Check if green and red intersects
-			if svc.checkEventsIntersect(redCur, greenCur) {
-				// Remove green element / exchange with splits
-				greenCurSplits := svc.splitGreenEventWithRedEvent(greenCur, redCur)
-				if len(greenCurSplits) == 0 {
-					// Remove
-					if greenCur.Prev != nil {
-						greenCur.Prev.Next = greenCur.Next
-						if greenCur.Next != nil {
-							greenCur.Next.Prev = greenCur.Prev
-						}
-					} else {
-						greenHead = greenCur.Next
-						if greenHead != nil {
-							greenHead.Prev = nil
-						}
-					}
-				} else {
-					// Exchange
-					if greenCur.Prev != nil {
-						greenCur.Prev.Next = greenCurSplits[0]
-						greenCurSplits[0].Prev = greenCur.Prev
-					} else {
-						greenHead = greenCurSplits[0]
-					}
-
-					if greenCur.Next != nil {
-						greenCurSplits[len(greenCurSplits)-1].Next = greenCur.Next
-						greenCur.Next.Prev = greenCurSplits[len(greenCurSplits)-1]
-					}
-
-					greenCur = greenCurSplits[len(greenCurSplits)-1]
-				}
-			}
+	if !sort.SliceIsSorted(redEvents, func(i, j int) bool { return redEvents[i].Start.Before(redEvents[j].Start) }) {
+		sortedRedEvents := make([]*event, len(redEvents))
+		copy(sortedRedEvents, redEvents)
+		sort.SliceStable(sortedRedEvents, func(i, j int) bool { return sortedRedEvents[i].Start.Before(sortedRedEvents[j].Start) })
+		redEvents = sortedRedEvents
+	}
+	redEvents = joinTouchingEvents(redEvents)
+
+	var greenHead, greenTail *event
+	appendGreen := func(green *event) {
+		green.Prev, green.Next = greenTail, nil
+		if greenTail != nil {
+			greenTail.Next = green
+		} else {
+			greenHead = green
 		}
+		greenTail = green
 	}
 
-	return greenHead
-}
-
-// splitGreenEventWithRedEvent builds new green parts (if any) removing red intersection with green.
-func (svc Scheduler) splitGreenEventWithRedEvent(green, red *event) (retGreenParts []*event) {
-	if green.Start.Before(red.Start) {
-		newGreenPart := &event{
-			Id:    green.Id,
-			Type:  green.Type,
-			Start: green.Start,
-			End:   red.Start,
-			Prev:  nil,
-			Next:  nil,
+	redIdx := 0
+	for _, greenCur := range greenEvents {
+		// Reds ended before the green can't affect the next (later) greens
+		for redIdx < len(redEvents) && !redEvents[redIdx].End.After(greenCur.Start) {
+			redIdx++
 		}
 
-		retGreenParts = append(retGreenParts, newGreenPart)
-	}
+		// Cut the green parts covered by reds moving the cursor from the green start to its end
+		cursor, split := greenCur.Start, false
+		for i := redIdx; i < len(redEvents) && redEvents[i].Start.Before(greenCur.End); i++ {
+			redCur := redEvents[i]
+			split = true
 
-	if green.End.After(red.End) {
-		newGreenPart := &event{
-			Id:    green.Id,
-			Type:  green.Type,
-			Start: red.End,
-			End:   green.End,
-			Prev:  nil,
-			Next:  nil,
+			if redCur.Start.After(cursor) {
+				appendGreen(&event{Id: greenCur.Id, Type: greenCur.Type, Start: cursor, End: redCur.Start})
+			}
+			cursor = redCur.End
+			if !cursor.Before(greenCur.End) {
+				break
+			}
 		}
 
-		if len(retGreenParts) == 1 {
-			retGreenParts[0].Next = newGreenPart
-			newGreenPart.Prev = retGreenParts[0]
+		switch {
+		case !split:
+			appendGreen(greenCur)
+		case cursor.Before(greenCur.End):
+			appendGreen(&event{Id: greenCur.Id, Type: greenCur.Type, Start: cursor, End: greenCur.End})
 		}
-		retGreenParts = append(retGreenParts, newGreenPart)
 	}
 
-	return
+	return greenHead
 }
 
 // buildAgendaResults builds schema.AgendaResult list searching for available time slots within desired duration.
-func (svc Scheduler) buildAgendaResults(greenHead *event, periodStart time.Time, periodDur, desiredDur time.Duration) (retAgendas schema.AgendaResults) {
-	periodEnd := periodStart.Add(periodDur)
+// Days are cut at the periodStart location midnight, a green window spanning midnight provides slots for multiple days
+// (a slot belongs to the day it starts).
+// Slot starts are opts.Step (desiredDur by default) apart and snapped to the periodStart location opts.Align clock boundaries.
+func (svc Scheduler) buildAgendaResults(greenHead *event, periodStart time.Time, periodDur, desiredDur time.Duration, opts schema.AgendaOptions) (retAgendas schema.AgendaResults) {
+	loc := periodStart.Location()
 
 	// removeTime return time.Time containing only date
 	removeTime := func(ts time.Time) time.Time {
-		return time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, ts.Location())
+		ts = ts.In(loc)
+		return time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, loc)
 	}
 
-	// addEmptyAgendas adds to the results empty agendas within range [start, end)
-	addEmptyAgendas := func(start, end time.Time, startInclusive, endInclusive bool) {
-		start, end = removeTime(start), removeTime(end)
+	// Prefill agendas for the [periodStart day : periodEnd day) range (at least one day)
+	firstDay, lastDay := removeTime(periodStart), removeTime(addCalendarDuration(periodStart, periodDur))
+	if !lastDay.After(firstDay) {
+		lastDay = firstDay.AddDate(0, 0, 1)
+	}
 
-		if !startInclusive {
-			start = start.Add(dayDur)
-		}
-		if endInclusive {
-			end = end.Add(dayDur)
-		}
+	step := opts.Step
+	if step == 0 {
+		step = desiredDur
+	}
 
-		if start.Equal(end) || start.After(end) {
-			return
+	// alignTime returns the first opts.Align clock boundary not before ts (ts if alignment is disabled)
+	alignTime := func(ts time.Time) time.Time {
+		if opts.Align == 0 {
+			return ts
 		}
 
-		for ; !start.Equal(end); {
-			retAgendas = append(retAgendas, schema.AgendaResult{
-				Date:      start,
-				TimeSlots: nil,
-			})
-			start = start.Add(dayDur)
+		ts = ts.In(loc)
+		sinceMidnight := time.Duration(ts.Hour())*time.Hour + time.Duration(ts.Minute())*time.Minute +
+			time.Duration(ts.Second())*time.Second + time.Duration(ts.Nanosecond())
+		if rem := sinceMidnight % opts.Align; rem != 0 {
+			ts = ts.Add(opts.Align - rem)
 		}
-	}
 
-	// Edge case
-	if greenHead == nil {
-		addEmptyAgendas(periodStart, periodEnd, true, false)
-		return
+		return ts
 	}
 
-	// Prefill
-	addEmptyAgendas(periodStart, greenHead.Start, true, false)
+	agendaIdxs := make(map[int64]int) // date (unix) -> retAgendas index
+	for day := firstDay; day.Before(lastDay); day = day.AddDate(0, 0, 1) {
+		agendaIdxs[day.Unix()] = len(retAgendas)
+		retAgendas = append(retAgendas, schema.AgendaResult{
+			Date:      day,
+			TimeSlots: nil,
+		})
+	}
 
-	greenLast := greenHead
 	for greenCur := greenHead; greenCur != nil; greenCur = greenCur.Next {
-		// Middle fill
-		addEmptyAgendas(greenLast.Start, greenCur.Start, false, false)
-		greenLast = greenCur
-
-		// Check if prev agenda should be used (instead of the new one)
-		var agenda schema.AgendaResult
-		createAgenda := true
-		if len(retAgendas) > 0 {
-			agenda = retAgendas[len(retAgendas)-1]
-			if removeTime(greenCur.Start).Equal(agenda.Date) {
-				createAgenda = false
-				retAgendas = retAgendas[:len(retAgendas)-1]
-			}
-		}
-		if createAgenda {
-			agenda = schema.AgendaResult{
-				Date: removeTime(greenCur.Start),
-			}
-		}
-
-		// Check if time chunk is big enough
-		eventDur := greenCur.End.Sub(greenCur.Start)
-		if eventDur < desiredDur {
-			retAgendas = append(retAgendas, agenda)
+		// Optimization
+		if greenCur.End.Before(firstDay) {
 			continue
 		}
+		if !greenCur.Start.Before(lastDay) {
+			break
+		}
 
-		// Get time slots
-		curTs := greenCur.Start
-		for i := 0; i < int(eventDur/desiredDur); i++ {
-			agenda.TimeSlots = append(agenda.TimeSlots, schema.TimeSlot{
-				Start:    curTs,
-				Duration: desiredDur,
-			})
-			curTs = curTs.Add(desiredDur)
+		// Get time slots (time chunk might be not big enough)
+		for curTs := alignTime(greenCur.Start); !curTs.Add(desiredDur).After(greenCur.End); curTs = alignTime(curTs.Add(step)) {
+			if agendaIdx, ok := agendaIdxs[removeTime(curTs).Unix()]; ok {
+				retAgendas[agendaIdx].TimeSlots = append(retAgendas[agendaIdx].TimeSlots, schema.TimeSlot{
+					Start:    curTs,
+					Duration: desiredDur,
+				})
+			}
 		}
-		retAgendas = append(retAgendas, agenda)
 	}
 
-	// Postfill
-	addEmptyAgendas(greenLast.Start, periodEnd, false, false)
-
 	return
 }
 
+// addCalendarDuration adds whole days of dur as calendar days (keeping the wall-clock time over DST changes) and the rest as is.
+func addCalendarDuration(ts time.Time, dur time.Duration) time.Time {
+	return ts.AddDate(0, 0, int(dur/dayDur)).Add(dur % dayDur)
+}
+
 func printList(greenHead *event) {
 	fmt.Println("\nGreen list:")
 	for greenCur := greenHead; greenCur != nil; greenCur = greenCur.Next {
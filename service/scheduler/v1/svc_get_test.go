@@ -1,10 +1,14 @@
 package v1
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
 )
 
 func (s *ServiceTestSuite) Test_mergeGreenRedEvents() {
@@ -209,7 +213,7 @@ func (s *ServiceTestSuite) Test_buildAgendaResults() {
 	{
 		start := time.Date(2000, 1, 1, 15, 30, 0, 0, time.UTC)
 		endDur := 72 * time.Hour
-		res := targetSvc.buildAgendaResults(nil, start, endDur, 30*time.Minute)
+		res := targetSvc.buildAgendaResults(nil, start, endDur, 30*time.Minute, schema.AgendaOptions{})
 		require.Len(t, res, 3)
 
 		require.Equal(t, res[0].Date, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
@@ -241,7 +245,7 @@ func (s *ServiceTestSuite) Test_buildAgendaResults() {
 			),
 		})
 
-		res := targetSvc.buildAgendaResults(greens[0], start, endDur, desDur)
+		res := targetSvc.buildAgendaResults(greens[0], start, endDur, desDur, schema.AgendaOptions{})
 		require.Len(t, res, 4)
 
 		require.Len(t, res[0].TimeSlots, 3)
@@ -278,7 +282,7 @@ func (s *ServiceTestSuite) Test_buildAgendaResults() {
 			),
 		})
 
-		res := targetSvc.buildAgendaResults(greens[0], start, endDur, desDur)
+		res := targetSvc.buildAgendaResults(greens[0], start, endDur, desDur, schema.AgendaOptions{})
 		require.Len(t, res, 4)
 
 		require.Len(t, res[0].TimeSlots, 0)
@@ -295,6 +299,103 @@ func (s *ServiceTestSuite) Test_buildAgendaResults() {
 		require.Len(t, res[3].TimeSlots, 0)
 		require.Equal(t, res[3].Date, time.Date(2000, 1, 4, 0, 0, 0, 0, time.UTC))
 	}
+
+	// step: 60m slots every 30m within 09:30 - 13:30
+	{
+		start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		desDur := time.Hour
+
+		greens := buildEventsLinkedList(t, []*event{
+			buildEvent(
+				2000, 1, 1, 9, 30,
+				2000, 1, 1, 13, 30,
+			),
+		})
+
+		res := targetSvc.buildAgendaResults(greens[0], start, dayDur, desDur, schema.AgendaOptions{Step: 30 * time.Minute})
+		require.Len(t, res, 1)
+		require.Len(t, res[0].TimeSlots, 7)
+		for i, slot := range res[0].TimeSlots {
+			require.Equal(t, time.Date(2000, 1, 1, 9, 30, 0, 0, time.UTC).Add(time.Duration(i)*30*time.Minute), slot.Start)
+			require.Equal(t, desDur, slot.Duration)
+		}
+	}
+
+	// align: 60m slots aligned to whole hours within 09:20 - 13:30, step is aligned as well
+	{
+		start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		desDur := time.Hour
+
+		greens := buildEventsLinkedList(t, []*event{
+			buildEvent(
+				2000, 1, 1, 9, 20,
+				2000, 1, 1, 13, 30,
+			),
+		})
+
+		res := targetSvc.buildAgendaResults(greens[0], start, dayDur, desDur, schema.AgendaOptions{Align: time.Hour})
+		require.Len(t, res, 1)
+		require.Len(t, res[0].TimeSlots, 3)
+		require.Equal(t, time.Date(2000, 1, 1, 10, 0, 0, 0, time.UTC), res[0].TimeSlots[0].Start)
+		require.Equal(t, time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), res[0].TimeSlots[2].Start)
+
+		// 09:20 -> 09:30, 09:50 -> 10:00, 10:20 -> 10:30, ...
+		res = targetSvc.buildAgendaResults(greens[0], start, dayDur, desDur, schema.AgendaOptions{Step: 20 * time.Minute, Align: 30 * time.Minute})
+		require.Len(t, res, 1)
+		require.Len(t, res[0].TimeSlots, 7)
+		for i, slot := range res[0].TimeSlots {
+			require.Equal(t, time.Date(2000, 1, 1, 9, 30, 0, 0, time.UTC).Add(time.Duration(i)*30*time.Minute), slot.Start)
+		}
+	}
+}
+
+func (s *ServiceTestSuite) Test_AgendaOptions() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	cpId, err := targetSvc.CreateChargePoint(ctx, "CP-IN", "", 1, "Asia/Kolkata", 0)
+	require.NoError(t, err)
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 UTC weekly (15:00 - 19:00 IST)
+	{
+		eventStart := time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, cpId, schema.SingleEventTypeAvailable, eventStart, 4*time.Hour))
+	}
+	agendaStart := time.Date(2014, 8, 11, 0, 0, 0, 0, kolkata)
+
+	// fail: wrong inputs
+	{
+		for _, opts := range []schema.AgendaOptions{
+			{Step: -time.Minute},
+			{Step: time.Second},
+			{Align: -time.Hour},
+			{Align: 7 * time.Minute},
+		} {
+			_, err := targetSvc.GetAvailableAgendaWithOptions(ctx, cpId, agendaStart, dayDur, time.Hour, opts)
+			require.True(t, errors.Is(err, common.ErrInvalidInput), "%+v", opts)
+		}
+	}
+
+	// ok: alignment uses the charge point local clock (15:00 IST is aligned to whole hours, 09:30 UTC is not)
+	{
+		agendas, err := targetSvc.GetAvailableAgendaWithOptions(ctx, cpId, agendaStart, dayDur, time.Hour, schema.AgendaOptions{Step: 15 * time.Minute, Align: time.Hour})
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 4)
+		for i, slot := range agendas[0].TimeSlots {
+			require.True(t, time.Date(2014, 8, 11, 15+i, 0, 0, 0, kolkata).Equal(slot.Start), slot.Start.String())
+		}
+
+		agendas, err = targetSvc.GetAvailableAgendaWithOptions(ctx, cpId, agendaStart, dayDur, time.Hour, schema.AgendaOptions{Step: 15 * time.Minute})
+		require.NoError(t, err)
+		require.Len(t, agendas[0].TimeSlots, 13)
+	}
 }
 
 func buildEvent(startYear, startMonth, startDay, startHour, startMinute, endYear, endMonth, endDay, endHour, endMinute int) *event {
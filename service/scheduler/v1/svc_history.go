@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) GetEventHistory(ctx context.Context, eventId int64, periodic bool) ([]schema.EventHistoryEntry, error) {
+	entries, err := svc.eventsSt.GetEventHistory(ctx, eventId, periodic)
+	if err != nil {
+		return nil, fmt.Errorf("svc.eventsSt.GetEventHistory(%d): %w", eventId, err)
+	}
+
+	return entries, nil
+}
+
+func (svc Scheduler) GetEventHistoryWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) ([]schema.EventHistoryEntry, error) {
+	// Input checks
+	if rangeStart.IsZero() {
+		return nil, fmt.Errorf("%s: zero: %w", "rangeStart", common.ErrInvalidInput)
+	}
+	if rangeEnd.Before(rangeStart) {
+		return nil, fmt.Errorf("%s: must be GTE rangeStart: %w", "rangeEnd", common.ErrInvalidInput)
+	}
+	if _, err := svc.getChargePoint(ctx, chargePointId); err != nil {
+		return nil, err
+	}
+
+	entries, err := svc.eventsSt.GetEventHistoryWithinRange(ctx, chargePointId, rangeStart, rangeEnd)
+	if err != nil {
+		return nil, fmt.Errorf("svc.eventsSt.GetEventHistoryWithinRange: %w", err)
+	}
+
+	return entries, nil
+}
+
+// recordSingleEventChange appends the single event change made by the ctx actor to the history
+// (must be called within the change transaction). before is nil for the create operation, after is nil for the delete / purge ones.
+func (svc Scheduler) recordSingleEventChange(ctx context.Context, operation schema.EventOperation, before, after *schema.SingleEvent) error {
+	entry := schema.EventHistoryEntry{}
+	var beforeObj, afterObj interface{}
+	if before != nil {
+		entry.EventId, entry.ChargePointId, beforeObj = before.Id, before.ChargePointId, before
+	}
+	if after != nil {
+		entry.EventId, entry.ChargePointId, afterObj = after.Id, after.ChargePointId, after
+	}
+
+	return svc.recordEventChange(ctx, operation, entry, beforeObj, afterObj)
+}
+
+// recordPeriodicEventChange appends the periodic event (or its exceptions) change made by the ctx actor to the history
+// (must be called within the change transaction). before is nil for the create operation, after is nil for the delete / purge ones.
+func (svc Scheduler) recordPeriodicEventChange(ctx context.Context, operation schema.EventOperation, before, after *schema.PeriodicEvent) error {
+	entry := schema.EventHistoryEntry{Periodic: true}
+	var beforeObj, afterObj interface{}
+	if before != nil {
+		entry.EventId, entry.ChargePointId, beforeObj = before.Id, before.ChargePointId, before
+	}
+	if after != nil {
+		entry.EventId, entry.ChargePointId, afterObj = after.Id, after.ChargePointId, after
+	}
+
+	return svc.recordEventChange(ctx, operation, entry, beforeObj, afterObj)
+}
+
+// recordEventChange creates the history entry with the non-nil before / after snapshots.
+func (svc Scheduler) recordEventChange(ctx context.Context, operation schema.EventOperation, entry schema.EventHistoryEntry, before, after interface{}) error {
+	entry.Operation = operation
+	entry.Actor = common.ActorFromContext(ctx)
+	entry.CreatedAt = time.Now().UTC()
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return fmt.Errorf("before snapshot marshal: %w", err)
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return fmt.Errorf("after snapshot marshal: %w", err)
+		}
+	}
+
+	if _, err := svc.eventsSt.CreateEventHistoryEntry(ctx, entry); err != nil {
+		return fmt.Errorf("svc.eventsSt.CreateEventHistoryEntry: %w", err)
+	}
+
+	return nil
+}
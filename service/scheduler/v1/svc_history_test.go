@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_EventHistory() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	aliceCtx, bobCtx := common.WithActor(ctx, "alice"), common.WithActor(ctx, "bob")
+	singleStart := time.Date(2003, 3, 3, 9, 0, 0, 0, time.UTC)
+	periodicStart := time.Date(2003, 3, 4, 9, 0, 0, 0, time.UTC)
+	testStart := time.Now().UTC()
+
+	// Init fixtures
+	// 03.03.2003 (MON) 09:00 - 12:00 -> 10:00 - 12:00 (updated)
+	// 04.03.2003 (TUE) 09:00 - 12:00 weekly, 11.03.2003 occurrence skipped
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(aliceCtx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, 3*time.Hour))
+		require.NoError(t, targetSvc.UpdateSingleEvent(bobCtx, 1, 1, schema.SingleEventTypeOccupied, singleStart.Add(time.Hour), 2*time.Hour))
+
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart, 3*time.Hour))
+		_, err := targetSvc.AddPeriodicEventException(aliceCtx, 1, 1, periodicStart.Add(7*dayDur))
+		require.NoError(t, err)
+	}
+
+	// ok: single event create / update snapshots
+	{
+		entries, err := targetSvc.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		require.Equal(t, schema.EventOperationCreate, entries[0].Operation)
+		require.Equal(t, "alice", entries[0].Actor)
+		require.Nil(t, entries[0].Before)
+		var created schema.SingleEvent
+		require.NoError(t, json.Unmarshal(entries[0].After, &created))
+		require.EqualValues(t, 1, created.Id)
+		require.True(t, singleStart.Equal(created.StartDateTime))
+
+		require.Equal(t, schema.EventOperationUpdate, entries[1].Operation)
+		require.Equal(t, "bob", entries[1].Actor)
+		require.JSONEq(t, string(entries[0].After), string(entries[1].Before))
+		var updated schema.SingleEvent
+		require.NoError(t, json.Unmarshal(entries[1].After, &updated))
+		require.True(t, singleStart.Add(time.Hour).Equal(updated.StartDateTime))
+		require.Equal(t, 2*time.Hour, updated.Duration)
+	}
+
+	// ok: periodic event exception change is an update with exceptions snapshots
+	{
+		entries, err := targetSvc.GetEventHistory(ctx, 1, true)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		require.Equal(t, schema.EventOperationCreate, entries[0].Operation)
+		require.Equal(t, common.ActorUnknown, entries[0].Actor)
+
+		require.Equal(t, schema.EventOperationUpdate, entries[1].Operation)
+		require.Equal(t, "alice", entries[1].Actor)
+		var before, after schema.PeriodicEvent
+		require.NoError(t, json.Unmarshal(entries[1].Before, &before))
+		require.NoError(t, json.Unmarshal(entries[1].After, &after))
+		require.Empty(t, before.Exceptions)
+		require.Len(t, after.Exceptions, 1)
+		require.EqualValues(t, 1, before.Version)
+		require.EqualValues(t, 2, after.Version)
+		require.Equal(t, weeklyRRule, after.Rrule.OrigOptions.RRuleString())
+		require.True(t, periodicStart.Equal(after.Rrule.OrigOptions.Dtstart))
+	}
+
+	// ok: failed change is not recorded (rolled back)
+	{
+		err := targetSvc.AddSingleEvent(aliceCtx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart.Add(time.Hour), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		entries, err := targetSvc.GetEventHistory(ctx, 2, false)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+
+	// ok: deleted event history is kept
+	{
+		require.NoError(t, targetSvc.DeleteSingleEvent(bobCtx, 1, 2))
+
+		entries, err := targetSvc.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, schema.EventOperationDelete, entries[2].Operation)
+		require.Equal(t, "bob", entries[2].Actor)
+		require.JSONEq(t, string(entries[1].After), string(entries[2].Before))
+		require.Nil(t, entries[2].After)
+	}
+
+	// ok: GetEventHistoryWithinRange
+	{
+		entries, err := targetSvc.GetEventHistoryWithinRange(ctx, schema.DefaultChargePointId, testStart, time.Now().UTC())
+		require.NoError(t, err)
+		require.Len(t, entries, 5)
+		for i := 1; i < len(entries); i++ {
+			require.Greater(t, entries[i].Id, entries[i-1].Id)
+		}
+
+		entries, err = targetSvc.GetEventHistoryWithinRange(ctx, schema.DefaultChargePointId, testStart.Add(-time.Hour), testStart.Add(-time.Minute))
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+
+	// fail: GetEventHistoryWithinRange: invalid range / charge point
+	{
+		_, err := targetSvc.GetEventHistoryWithinRange(ctx, schema.DefaultChargePointId, testStart, testStart.Add(-time.Minute))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.GetEventHistoryWithinRange(ctx, 1000, testStart, time.Now().UTC())
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
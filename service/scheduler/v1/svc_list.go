@@ -9,7 +9,11 @@ import (
 	"github.com/itiky/charge_scheduler/schema"
 )
 
-func (svc Scheduler) GetEvents(ctx context.Context, periodStart, periodEnd time.Time) (retSingleEvents []schema.SingleEvent, retPeriodicEvents []schema.PeriodicEvent, retErr error) {
+func (svc Scheduler) GetEvents(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time) ([]schema.SingleEvent, []schema.PeriodicEvent, error) {
+	return svc.GetEventsWithOptions(ctx, chargePointId, periodStart, periodEnd, schema.EventsListOptions{})
+}
+
+func (svc Scheduler) GetEventsWithOptions(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time, opts schema.EventsListOptions) (retSingleEvents []schema.SingleEvent, retPeriodicEvents []schema.PeriodicEvent, retErr error) {
 	// Input checks
 	if periodStart.IsZero() {
 		retErr = fmt.Errorf("%s: zero: %w", "periodStart", common.ErrInvalidInput)
@@ -23,16 +27,24 @@ func (svc Scheduler) GetEvents(ctx context.Context, periodStart, periodEnd time.
 		retErr = fmt.Errorf("%s: periodStart must be LT periodEnd: %w", "periodStart / periodEnd", common.ErrInvalidInput)
 		return
 	}
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
 
 	// Get
-	sEvents, err := svc.eventsSt.GetSingleEventsWithinRange(ctx, periodStart, periodEnd)
+	sEvents, err := svc.eventsSt.GetSingleEventsIntersectingRange(ctx, chargePointId, periodStart, periodEnd, opts.IncludeDeleted)
 	if err != nil {
-		retErr = fmt.Errorf("svc.eventsSt.GetSingleEventsWithinRange(%s, %s): %w", periodStart.Format(common.TimeFmt), periodEnd.Format(common.TimeFmt), err)
+		retErr = fmt.Errorf("svc.eventsSt.GetSingleEventsIntersectingRange: %w", err)
 		return
 	}
+	for i := range sEvents {
+		sEvents[i].StartDateTime = sEvents[i].StartDateTime.In(loc)
+	}
 	retSingleEvents = sEvents
 
-	pEvents, err := svc.eventsSt.GetAllPeriodicEvents(ctx)
+	pEvents, err := svc.eventsSt.GetAllPeriodicEvents(ctx, chargePointId, opts.IncludeDeleted)
 	if err != nil {
 		retErr = fmt.Errorf("svc.eventsSt.GetAllPeriodicEvents: %w", err)
 		return
@@ -41,3 +53,30 @@ func (svc Scheduler) GetEvents(ctx context.Context, periodStart, periodEnd time.
 
 	return
 }
+
+func (svc Scheduler) GetSingleEvent(ctx context.Context, eventId int64) (schema.SingleEvent, error) {
+	event, err := svc.eventsSt.GetSingleEvent(ctx, eventId)
+	if err != nil {
+		return schema.SingleEvent{}, fmt.Errorf("svc.eventsSt.GetSingleEvent(%d): %w", eventId, err)
+	}
+	if event == nil {
+		return schema.SingleEvent{}, fmt.Errorf("%s (%d): %w", "eventId", eventId, common.ErrNotFound)
+	}
+
+	loc, err := svc.getChargePointLocation(ctx, event.ChargePointId)
+	if err != nil {
+		return schema.SingleEvent{}, err
+	}
+	event.StartDateTime = event.StartDateTime.In(loc)
+
+	return *event, nil
+}
+
+func (svc Scheduler) GetPeriodicEvent(ctx context.Context, eventId int64) (schema.PeriodicEvent, error) {
+	event, err := svc.getPeriodicEvent(ctx, eventId)
+	if err != nil {
+		return schema.PeriodicEvent{}, err
+	}
+
+	return *event, nil
+}
@@ -0,0 +1,322 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/events"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+const (
+	// mergeTestSeed makes random loads reproducible.
+	mergeTestSeed = 42
+	// mergeBenchBookings is the benchmark bookings count (15 min bookings within a year of daily 06:00 - 22:00 windows).
+	mergeBenchBookings = 10000
+)
+
+var mergeBenchStart = time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+
+func (s *ServiceTestSuite) Test_mergeGreenRedEventsDifferential() {
+	t := s.T()
+	targetSvc := s.r.Svc.(*Scheduler)
+	rnd := rand.New(rand.NewSource(mergeTestSeed))
+
+	for caseIdx := 0; caseIdx < 1000; caseIdx++ {
+		greens, reds := buildRandomGreenRedEvents(rnd, rnd.Intn(30), rnd.Intn(60), time.Hour)
+		if caseIdx%2 == 0 {
+			rnd.Shuffle(len(reds), func(i, j int) { reds[i], reds[j] = reds[j], reds[i] })
+		}
+
+		expected := targetSvc.mergeGreenRedEventsReference(cloneEventsLinkedList(greens), cloneEvents(reds))
+		received := targetSvc.mergeGreenRedEvents(cloneEventsLinkedList(greens), cloneEvents(reds))
+		require.Equal(t, eventsLinkedListValues(expected), eventsLinkedListValues(received), "case [%d]", caseIdx)
+		checkEventsLinkedListsEqual(t, expected, received)
+	}
+}
+
+func BenchmarkMergeGreenRedEvents(b *testing.B) {
+	benchmarkMergeGreenRedEvents(b, Scheduler.mergeGreenRedEvents, buildMergeBenchLoad)
+}
+
+func BenchmarkMergeGreenRedEventsReference(b *testing.B) {
+	benchmarkMergeGreenRedEvents(b, Scheduler.mergeGreenRedEventsReference, buildMergeBenchLoad)
+}
+
+// BenchmarkMergeGreenRedEventsLongReds measures the merge with a half year long red overlapping the first half of bookings.
+func BenchmarkMergeGreenRedEventsLongReds(b *testing.B) {
+	benchmarkMergeGreenRedEvents(b, Scheduler.mergeGreenRedEvents, buildMergeBenchLongRedsLoad)
+}
+
+// BenchmarkGetAvailableAgenda measures a year agenda request for a year of weekly windows and 10k bookings.
+func BenchmarkGetAvailableAgenda(b *testing.B) {
+	ctx := context.TODO()
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(b.TempDir())
+	require.NoError(b, err)
+	defer baseSt.Close() // nolint:errcheck
+
+	r, err := NewTestResource(baseSt)
+	require.NoError(b, err)
+	targetSvc := r.Svc.(*Scheduler)
+
+	// Init fixtures
+	// Weekly windows for every week day (06:00 - 22:00) and bookings created directly (skipping the checks)
+	{
+		for day := 0; day < 7; day++ {
+			require.NoError(b, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, mergeBenchStart.AddDate(0, 0, day).Add(6*time.Hour), 16*time.Hour))
+		}
+
+		_, bookings := buildMergeBenchLoad()
+		err := targetSvc.eventsSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+			for _, booking := range bookings {
+				if _, err := txSt.CreateSingleEvent(ctx, schema.SingleEvent{
+					ChargePointId: schema.DefaultChargePointId,
+					Type:          schema.SingleEventTypeOccupied,
+					StartDateTime: booking.Start,
+					Duration:      booking.End.Sub(booking.Start),
+					CreatedAt:     mergeBenchStart,
+				}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		require.NoError(b, err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, mergeBenchStart, 365*dayDur, time.Hour)
+		require.NoError(b, err)
+	}
+}
+
+func benchmarkMergeGreenRedEvents(b *testing.B, mergeFn func(svc Scheduler, greenEvents, redEvents []*event) *event, buildLoad func() (retGreens, retReds []*event)) {
+	svc := Scheduler{}
+	greens, reds := buildLoad()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		greensCopy := cloneEventsLinkedList(greens)
+		b.StartTimer()
+
+		if mergeFn(svc, greensCopy, reds) == nil {
+			b.Fatal("empty merge result")
+		}
+	}
+}
+
+// buildMergeBenchLoad returns a year of daily 06:00 - 22:00 windows (weekly events occurrences)
+// and 10k random (non-touching) 15 min bookings within them.
+func buildMergeBenchLoad() (retGreens, retReds []*event) {
+	const slotDur, daySlots = 15 * time.Minute, 16 * 4
+
+	for day := 0; day < 365; day++ {
+		dayStart := mergeBenchStart.AddDate(0, 0, day)
+		retGreens = append(retGreens, &event{
+			Id:       int64(day%7 + 1),
+			Periodic: true,
+			Type:     schema.SingleEventTypeAvailable,
+			Start:    dayStart.Add(6 * time.Hour),
+			End:      dayStart.Add(22 * time.Hour),
+		})
+	}
+
+	// Every other slot might be booked (keeps bookings non-touching)
+	rnd := rand.New(rand.NewSource(mergeTestSeed))
+	for _, slotIdx := range rnd.Perm(365 * daySlots / 2)[:mergeBenchBookings] {
+		dayIdx, daySlotIdx := slotIdx/(daySlots/2), slotIdx%(daySlots/2)*2
+		start := retGreens[dayIdx].Start.Add(time.Duration(daySlotIdx) * slotDur)
+		retReds = append(retReds, &event{
+			Id:    int64(len(retReds) + 1),
+			Type:  schema.SingleEventTypeOccupied,
+			Start: start,
+			End:   start.Add(slotDur),
+		})
+	}
+	sort.Slice(retReds, func(i, j int) bool { return retReds[i].Start.Before(retReds[j].Start) })
+
+	return cloneEventsLinkedList(retGreens), retReds
+}
+
+// buildMergeBenchLongRedsLoad returns buildMergeBenchLoad events with a half year long red (e.g. a maintenance) added upfront.
+func buildMergeBenchLongRedsLoad() (retGreens, retReds []*event) {
+	retGreens, bookings := buildMergeBenchLoad()
+	retReds = append([]*event{{
+		Id:    int64(len(bookings) + 1),
+		Type:  schema.SingleEventTypeOccupied,
+		Start: mergeBenchStart,
+		End:   mergeBenchStart.AddDate(0, 6, 0),
+	}}, bookings...)
+
+	return
+}
+
+// buildRandomGreenRedEvents returns sorted non-touching greens and sorted reds (overlapping / touching each other and greens, some are long).
+func buildRandomGreenRedEvents(rnd *rand.Rand, greensCnt, redsCnt int, unit time.Duration) (retGreens, retReds []*event) {
+	cursor := mergeBenchStart
+	for i := 0; i < greensCnt; i++ {
+		start := cursor.Add(time.Duration(1+rnd.Intn(3)) * unit)
+		end := start.Add(time.Duration(1+rnd.Intn(6)) * unit)
+		retGreens = append(retGreens, &event{
+			Id:    int64(i + 1),
+			Type:  schema.SingleEventTypeAvailable,
+			Start: start,
+			End:   end,
+		})
+		cursor = end
+	}
+
+	spanUnits := int(cursor.Sub(mergeBenchStart)/unit) + 2
+	for i := 0; i < redsCnt; i++ {
+		start := mergeBenchStart.Add(time.Duration(rnd.Intn(spanUnits)) * unit)
+		durUnits := 1 + rnd.Intn(4)
+		if rnd.Intn(10) == 0 {
+			// Long red spanning multiple greens
+			durUnits = 1 + rnd.Intn(30)
+		}
+		retReds = append(retReds, &event{
+			Id:    int64(i + 1),
+			Type:  schema.SingleEventTypeOccupied,
+			Start: start,
+			End:   start.Add(time.Duration(durUnits) * unit),
+		})
+	}
+	sort.Slice(retReds, func(i, j int) bool { return retReds[i].Start.Before(retReds[j].Start) })
+
+	return cloneEventsLinkedList(retGreens), retReds
+}
+
+// cloneEvents copies events dropping links.
+func cloneEvents(events []*event) []*event {
+	clones := make([]*event, 0, len(events))
+	for _, e := range events {
+		clone := *e
+		clone.Prev, clone.Next = nil, nil
+		clones = append(clones, &clone)
+	}
+
+	return clones
+}
+
+// cloneEventsLinkedList copies events linking them in the slice order.
+func cloneEventsLinkedList(events []*event) []*event {
+	clones := cloneEvents(events)
+	for i := 1; i < len(clones); i++ {
+		clones[i-1].Next, clones[i].Prev = clones[i], clones[i-1]
+	}
+
+	return clones
+}
+
+// eventsLinkedListValues returns linked list events values (without links) checking the back links.
+func eventsLinkedListValues(head *event) []string {
+	var values []string
+	var prev *event
+	for cur := head; cur != nil; prev, cur = cur, cur.Next {
+		values = append(values, fmt.Sprintf("%d %s [%s - %s) prev ok: %v", cur.Id, cur.Type, cur.Start.Format(time.RFC3339), cur.End.Format(time.RFC3339), cur.Prev == prev))
+	}
+
+	return values
+}
+
+// mergeGreenRedEventsReference is the initial mergeGreenRedEvents implementation (O(g*r)), used as a reference.
+func (svc Scheduler) mergeGreenRedEventsReference(greenEvents, redEvents []*event) *event {
+	if len(greenEvents) == 0 {
+		return nil
+	}
+	if len(redEvents) == 0 {
+		return greenEvents[0]
+	}
+
+	greenHead := greenEvents[0]
+	for _, redCur := range redEvents {
+		for greenCur := greenHead; greenCur != nil; greenCur = greenCur.Next {
+			// Optimization
+			if greenCur.Start.After(redCur.End) {
+				break
+			}
+
+			// Check if green and red intersects
+			if svc.checkEventsIntersect(redCur, greenCur) {
+				// Remove green element / exchange with splits
+				greenCurSplits := svc.splitGreenEventWithRedEventReference(greenCur, redCur)
+				if len(greenCurSplits) == 0 {
+					// Remove
+					if greenCur.Prev != nil {
+						greenCur.Prev.Next = greenCur.Next
+						if greenCur.Next != nil {
+							greenCur.Next.Prev = greenCur.Prev
+						}
+					} else {
+						greenHead = greenCur.Next
+						if greenHead != nil {
+							greenHead.Prev = nil
+						}
+					}
+				} else {
+					// Exchange
+					if greenCur.Prev != nil {
+						greenCur.Prev.Next = greenCurSplits[0]
+						greenCurSplits[0].Prev = greenCur.Prev
+					} else {
+						greenHead = greenCurSplits[0]
+					}
+
+					if greenCur.Next != nil {
+						greenCurSplits[len(greenCurSplits)-1].Next = greenCur.Next
+						greenCur.Next.Prev = greenCurSplits[len(greenCurSplits)-1]
+					}
+
+					greenCur = greenCurSplits[len(greenCurSplits)-1]
+				}
+			}
+		}
+	}
+
+	return greenHead
+}
+
+// splitGreenEventWithRedEventReference builds new green parts (if any) removing red intersection with green.
+func (svc Scheduler) splitGreenEventWithRedEventReference(green, red *event) (retGreenParts []*event) {
+	if green.Start.Before(red.Start) {
+		newGreenPart := &event{
+			Id:    green.Id,
+			Type:  green.Type,
+			Start: green.Start,
+			End:   red.Start,
+			Prev:  nil,
+			Next:  nil,
+		}
+
+		retGreenParts = append(retGreenParts, newGreenPart)
+	}
+
+	if green.End.After(red.End) {
+		newGreenPart := &event{
+			Id:    green.Id,
+			Type:  green.Type,
+			Start: red.End,
+			End:   green.End,
+			Prev:  nil,
+			Next:  nil,
+		}
+
+		if len(retGreenParts) == 1 {
+			retGreenParts[0].Next = newGreenPart
+			newGreenPart.Prev = retGreenParts[0]
+		}
+		retGreenParts = append(retGreenParts, newGreenPart)
+	}
+
+	return
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_MultiDayEvents() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// ok: overnight availability
+	// 04.08.2014 (MON) 22:00 - 05.08.2014 (TUE) 06:00 daily
+	{
+		eventStart := time.Date(2014, 8, 4, 22, 0, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, "FREQ=DAILY", 8*time.Hour))
+	}
+
+	// fail: intersects the previous day occurrence end
+	// 06.08.2014 (WED) 05:00 - 07:00
+	{
+		err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 6, 5, 0, 0, 0, time.UTC), 2*time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// fail: periodic event occurrences intersect each other
+	// 04.08.2014 (MON) 08:00 - 09.08.2014 (SAT) 08:00 every 3 days
+	{
+		eventStart := time.Date(2014, 8, 4, 8, 0, 0, 0, time.UTC)
+		err := targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, "FREQ=DAILY;INTERVAL=3", 5*dayDur)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check the overnight window provides slots for both days
+	{
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 5, 0, 0, 0, 0, time.UTC), dayDur, 2*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 4)
+		require.Equal(t, time.Date(2014, 8, 5, 0, 0, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+		require.Equal(t, time.Date(2014, 8, 5, 22, 0, 0, 0, time.UTC), agendas[0].TimeSlots[3].Start)
+	}
+
+	// ok: multi-day maintenance block
+	// 07.08.2014 (THU) 12:00 - 09.08.2014 (SAT) 03:00
+	{
+		eventStart := time.Date(2014, 8, 7, 12, 0, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, eventStart, 39*time.Hour))
+
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 8, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 9, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.Equal(t, time.Date(2014, 8, 9, 3, 0, 0, 0, time.UTC), sEvents[0].EndDateTime())
+	}
+
+	// check the maintenance block (started more than a day before the agenda) is excluded across midnight
+	// 09.08.2014 (SAT) - 10.08.2014 (SUN)
+	{
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 9, 0, 0, 0, 0, time.UTC), 2*dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 2)
+
+		// 09.08: 03:00 - 06:00, 22:00 - 24:00
+		require.Len(t, agendas[0].TimeSlots, 5)
+		require.Equal(t, time.Date(2014, 8, 9, 3, 0, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+		require.Equal(t, time.Date(2014, 8, 9, 22, 0, 0, 0, time.UTC), agendas[0].TimeSlots[3].Start)
+		require.Equal(t, time.Date(2014, 8, 9, 23, 0, 0, 0, time.UTC), agendas[0].TimeSlots[4].Start)
+
+		// 10.08: 00:00 - 06:00, 22:00 - 24:00
+		require.Len(t, agendas[1].TimeSlots, 8)
+		require.Equal(t, time.Date(2014, 8, 10, 0, 0, 0, 0, time.UTC), agendas[1].TimeSlots[0].Start)
+	}
+
+	// ok: BookSlot across midnight
+	{
+		id, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 23, 0, 0, 0, time.UTC), 3*time.Hour, "driver-42")
+		require.NoError(t, err)
+
+		booking, err := s.r.StorageRes.Storage.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, booking)
+		require.Equal(t, time.Date(2014, 8, 12, 2, 0, 0, 0, time.UTC), booking.EndDateTime().UTC())
+
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC), dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 6)
+		require.Equal(t, time.Date(2014, 8, 12, 2, 0, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+	}
+
+	// ok: moved occurrence might span midnight as well
+	// 13.08.2014 (WED) 22:00 -> 13.08.2014 (WED) 20:00 - 14.08.2014 (THU) 02:00
+	{
+		pEvents, err := s.r.StorageRes.Storage.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Len(t, pEvents, 1)
+
+		_, err = targetSvc.AddPeriodicEventOverride(ctx, pEvents[0].Id, pEvents[0].Version, time.Date(2014, 8, 13, 22, 0, 0, 0, time.UTC), time.Date(2014, 8, 13, 20, 0, 0, 0, time.UTC), 6*time.Hour)
+		require.NoError(t, err)
+
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 14, 0, 0, 0, 0, time.UTC), dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 4)
+		require.Equal(t, time.Date(2014, 8, 14, 0, 0, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+		require.Equal(t, time.Date(2014, 8, 14, 1, 0, 0, 0, time.UTC), agendas[0].TimeSlots[1].Start)
+		require.Equal(t, time.Date(2014, 8, 14, 22, 0, 0, 0, time.UTC), agendas[0].TimeSlots[2].Start)
+	}
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// OccupancyPolicy defines how Occupied events not fully covered by Available ones are handled.
+type OccupancyPolicy string
+
+const (
+	// OccupancyPolicyOff skips the check.
+	OccupancyPolicyOff OccupancyPolicy = "off"
+	// OccupancyPolicyWarn accepts uncovered events reporting common.Warnings (see common.WithWarnings).
+	OccupancyPolicyWarn OccupancyPolicy = "warn"
+	// OccupancyPolicyStrict rejects uncovered events with common.ErrInvalidInput.
+	OccupancyPolicyStrict OccupancyPolicy = "strict"
+)
+
+// OccupancyPolicies lists all supported policies.
+var OccupancyPolicies = []OccupancyPolicy{OccupancyPolicyOff, OccupancyPolicyWarn, OccupancyPolicyStrict}
+
+// ParseOccupancyPolicy parses and validates the OccupancyPolicy.
+func ParseOccupancyPolicy(policyStr string) (OccupancyPolicy, error) {
+	for _, policy := range OccupancyPolicies {
+		if strings.EqualFold(policyStr, string(policy)) {
+			return policy, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: unknown (%s): %w", "occupancyPolicy", policyStr, common.ErrInvalidInput)
+}
+
+// checkSingleEventCoverage checks that an Occupied single event is covered by availability according to the occupancy policy.
+// Available events being updated (the ignored one) don't cover anything.
+func (svc Scheduler) checkSingleEventCoverage(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration, ignoredEvent *eventKey) (common.Warnings, error) {
+	if svc.occupancyPolicy == OccupancyPolicyOff || eventType != schema.SingleEventTypeOccupied {
+		return nil, nil
+	}
+
+	newEvent := &event{
+		Start: eventStart,
+		End:   eventStart.Add(eventDur),
+	}
+
+	// Get existing events [eventStart -1 day : eventEnd +1 day]
+	rangeStart, rangeEnd := newEvent.Start.Add(-dayDur), newEvent.End.Add(dayDur)
+
+	return svc.checkOccupancyCoverage(ctx, chargePointId, []*event{newEvent}, rangeStart, rangeEnd, ignoredEvent)
+}
+
+// checkPeriodicEventCoverage checks that Occupied periodic event occurrences are covered by availability according to the occupancy policy.
+func (svc Scheduler) checkPeriodicEventCoverage(ctx context.Context, chargePointId int64, eventType schema.SingleEventType, rule *rrule.RRule, eventDur time.Duration, ignoredEvent *eventKey) (common.Warnings, error) {
+	if svc.occupancyPolicy == OccupancyPolicyOff || eventType != schema.SingleEventTypeOccupied {
+		return nil, nil
+	}
+
+	// Get existing events within the rule lifetime (or the check horizon for endless rules)
+	rangeStart, rangeEnd := getPeriodicCheckRange(rule, eventDur)
+	occurrenceStarts := rule.Between(rangeStart, rangeEnd, true)
+	newEvents := make([]*event, 0, len(occurrenceStarts))
+	for _, occurrenceStart := range occurrenceStarts {
+		newEvents = append(newEvents, &event{
+			Start: occurrenceStart,
+			End:   occurrenceStart.Add(eventDur),
+		})
+	}
+
+	return svc.checkOccupancyCoverage(ctx, chargePointId, newEvents, rangeStart, rangeEnd, ignoredEvent)
+}
+
+// checkRemovedAvailabilityCoverage checks that stored Occupied events intersecting the range are still covered by availability
+// according to the occupancy policy (must be called after an Available event (occurrence) within the range is removed / shrunk).
+// The ignored event (an Available one changed to Occupied, checked on its own) is skipped.
+func (svc Scheduler) checkRemovedAvailabilityCoverage(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, ignoredEvent *eventKey) (common.Warnings, error) {
+	if svc.occupancyPolicy == OccupancyPolicyOff {
+		return nil, nil
+	}
+
+	existingRedEvents, err := svc.getTargetEvents(ctx, chargePointId, schema.SingleEventTypeOccupied, rangeStart, rangeEnd, ignoredEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	removedRange := &event{
+		Start: rangeStart,
+		End:   rangeEnd,
+	}
+	affectedEvents := make([]*event, 0, len(existingRedEvents))
+	for _, existingEvent := range existingRedEvents {
+		if svc.checkEventsIntersect(removedRange, existingEvent) {
+			affectedEvents = append(affectedEvents, existingEvent)
+		}
+	}
+	if len(affectedEvents) == 0 {
+		return nil, nil
+	}
+
+	// Get existing events [firstEventStart -1 day : lastEventEnd +1 day] (Occupied events can't intersect each other)
+	coverageStart, coverageEnd := affectedEvents[0].Start.Add(-dayDur), affectedEvents[len(affectedEvents)-1].End.Add(dayDur)
+
+	return svc.checkOccupancyCoverage(ctx, chargePointId, affectedEvents, coverageStart, coverageEnd, nil)
+}
+
+// checkOccupancyCoverage checks that every sorted new Occupied event (occurrence) lies within the Available events.
+// Returns common.ErrInvalidInput for OccupancyPolicyStrict and common.Warnings for OccupancyPolicyWarn if some are not covered.
+func (svc Scheduler) checkOccupancyCoverage(ctx context.Context, chargePointId int64, newEvents []*event, rangeStart, rangeEnd time.Time, ignoredEvent *eventKey) (common.Warnings, error) {
+	existingGreenEvents, err := svc.getTargetEvents(ctx, chargePointId, schema.SingleEventTypeAvailable, rangeStart, rangeEnd, ignoredEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Available events can't intersect each other (touching ones are joined), so an Occupied one must lie within a single window
+	existingGreenEvents = joinTouchingEvents(existingGreenEvents)
+	var uncoveredEvents []*event
+	greenIdx := 0
+	for _, newEvent := range newEvents {
+		for greenIdx < len(existingGreenEvents) && !existingGreenEvents[greenIdx].End.After(newEvent.Start) {
+			greenIdx++
+		}
+
+		if greenIdx == len(existingGreenEvents) || existingGreenEvents[greenIdx].Start.After(newEvent.Start) || existingGreenEvents[greenIdx].End.Before(newEvent.End) {
+			uncoveredEvents = append(uncoveredEvents, newEvent)
+		}
+	}
+	if len(uncoveredEvents) == 0 {
+		return nil, nil
+	}
+
+	msg := fmt.Sprintf("event (%s - %s) is not covered by availability", uncoveredEvents[0].Start.Format(common.TimeFmt), uncoveredEvents[0].End.Format(common.TimeFmt))
+	if len(uncoveredEvents) > 1 {
+		msg = fmt.Sprintf("%d event occurrences are not covered by availability, first one: %s - %s",
+			len(uncoveredEvents), uncoveredEvents[0].Start.Format(common.TimeFmt), uncoveredEvents[0].End.Format(common.TimeFmt))
+	}
+
+	if svc.occupancyPolicy == OccupancyPolicyStrict {
+		return nil, fmt.Errorf("%s: %w", msg, common.ErrInvalidInput)
+	}
+
+	return common.Warnings{msg}, nil
+}
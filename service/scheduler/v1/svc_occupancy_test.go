@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_OccupancyPolicy() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	offSvc, warnSvc, strictSvc := *targetSvc, *targetSvc, *targetSvc
+	offSvc.occupancyPolicy = OccupancyPolicyOff
+	warnSvc.occupancyPolicy = OccupancyPolicyWarn
+	strictSvc.occupancyPolicy = OccupancyPolicyStrict
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:00 - 13:00 weekly available
+	// 12.08.2014 (TUE) 22:00 - 13.08.2014 (WED) 02:00 available
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 12, 22, 0, 0, 0, time.UTC), 4*time.Hour))
+	}
+
+	// fail: unknown policy
+	{
+		_, err := ParseOccupancyPolicy("unknown")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		policy, err := ParseOccupancyPolicy("Strict")
+		require.NoError(t, err)
+		require.Equal(t, OccupancyPolicyStrict, policy)
+	}
+
+	// fail: strict, not covered / partially covered
+	{
+		err := strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 14, 0, 0, 0, time.UTC), time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), 2*time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// Every other week is not covered
+		err = strictSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 10, 0, 0, 0, time.UTC), "FREQ=DAILY;COUNT=8", time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: strict, covered (occurrences and spanning midnight)
+	{
+		require.NoError(t, strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 12, 23, 0, 0, 0, time.UTC), 2*time.Hour))
+		require.NoError(t, strictSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 12, 0, 0, 0, time.UTC), "FREQ=WEEKLY;COUNT=4", time.Hour))
+
+		// Available events are not checked
+		require.NoError(t, strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 14, 9, 0, 0, 0, time.UTC), time.Hour))
+	}
+
+	// ok: warn, created with warnings
+	{
+		var warnings common.Warnings
+		require.NoError(t, warnSvc.AddSingleEvent(common.WithWarnings(ctx, &warnings), schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 14, 0, 0, 0, time.UTC), time.Hour))
+		require.Len(t, warnings, 1)
+
+		// Failed operations don't report warnings
+		err := warnSvc.AddSingleEvent(common.WithWarnings(ctx, &warnings), schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 14, 30, 0, 0, time.UTC), time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+		require.Len(t, warnings, 1)
+
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 14, 0, 0, 0, time.UTC), time.Date(2014, 8, 11, 15, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+	}
+
+	// ok: off, created without warnings
+	{
+		var warnings common.Warnings
+		require.NoError(t, offSvc.AddSingleEvent(common.WithWarnings(ctx, &warnings), schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 16, 0, 0, 0, time.UTC), time.Hour))
+		require.Empty(t, warnings)
+	}
+
+	// Update (the updated Available event doesn't cover anything)
+	{
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 14, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		var availableId, occupiedId int64
+		for _, sEvent := range sEvents {
+			if sEvent.Type == schema.SingleEventTypeAvailable {
+				availableId = sEvent.Id
+			} else {
+				occupiedId = sEvent.Id
+			}
+		}
+		require.NotZero(t, availableId)
+		require.NotZero(t, occupiedId)
+
+		// fail: moved outside the availability
+		err = strictSvc.UpdateSingleEvent(ctx, occupiedId, 1, schema.SingleEventTypeOccupied, time.Date(2014, 8, 13, 1, 0, 0, 0, time.UTC), 2*time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ok: moved within the availability
+		require.NoError(t, strictSvc.UpdateSingleEvent(ctx, occupiedId, 1, schema.SingleEventTypeOccupied, time.Date(2014, 8, 12, 22, 0, 0, 0, time.UTC), 4*time.Hour))
+
+		// Available event changed to Occupied doesn't cover itself
+		sEvents, _, err = targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 14, 9, 0, 0, 0, time.UTC), time.Date(2014, 8, 14, 10, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+
+		err = strictSvc.UpdateSingleEvent(ctx, sEvents[0].Id, sEvents[0].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 14, 9, 0, 0, 0, time.UTC), time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		var warnings common.Warnings
+		require.NoError(t, warnSvc.UpdateSingleEvent(common.WithWarnings(ctx, &warnings), sEvents[0].Id, sEvents[0].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 14, 9, 0, 0, 0, time.UTC), time.Hour))
+		require.Len(t, warnings, 1)
+	}
+
+	// Periodic event occurrence override
+	{
+		_, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 4, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 5, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		var occupiedId int64
+		for _, pEvent := range pEvents {
+			if pEvent.Type == schema.SingleEventTypeOccupied {
+				occupiedId = pEvent.Id
+			}
+		}
+		require.NotZero(t, occupiedId)
+
+		// fail: moved outside the availability
+		_, err = strictSvc.AddPeriodicEventOverride(ctx, occupiedId, 1, time.Date(2014, 8, 18, 12, 0, 0, 0, time.UTC), time.Date(2014, 8, 18, 13, 0, 0, 0, time.UTC), time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ok: moved within the availability
+		id, err := strictSvc.AddPeriodicEventOverride(ctx, occupiedId, 1, time.Date(2014, 8, 18, 12, 0, 0, 0, time.UTC), time.Date(2014, 8, 18, 11, 0, 0, 0, time.UTC), time.Hour)
+		require.NoError(t, err)
+		require.NotZero(t, id)
+	}
+
+	// ok: strict, covered by touching Available events
+	// 15.08.2014 (FRI) 09:00 - 10:00, 10:00 - 11:00 available
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 15, 9, 0, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 15, 10, 0, 0, 0, time.UTC), time.Hour))
+
+		require.NoError(t, strictSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 15, 9, 30, 0, 0, time.UTC), time.Hour))
+	}
+}
+
+func (s *ServiceTestSuite) Test_OccupancyPolicy_AvailabilityRemoval() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	warnSvc, strictSvc := *targetSvc, *targetSvc
+	warnSvc.occupancyPolicy = OccupancyPolicyWarn
+	strictSvc.occupancyPolicy = OccupancyPolicyStrict
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:00 - 13:00 weekly available
+	// 12.08.2014 (TUE) 09:00 - 13:00 available
+	// 11.08.2014 (MON) 10:00 - 11:00 occupied
+	// 12.08.2014 (TUE) 10:00 - 11:00 occupied
+	// 18.08.2014 (MON) 12:00 - 13:00 weekly (2 occurrences) occupied
+	var availableSingleId, availablePeriodicId, occupiedPeriodicId int64
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 12, 9, 0, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 12, 10, 0, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 18, 12, 0, 0, 0, time.UTC), "FREQ=WEEKLY;COUNT=2", time.Hour))
+
+		sEvents, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 4, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 19, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		for _, sEvent := range sEvents {
+			if sEvent.Type == schema.SingleEventTypeAvailable {
+				availableSingleId = sEvent.Id
+			}
+		}
+		for _, pEvent := range pEvents {
+			if pEvent.Type == schema.SingleEventTypeAvailable {
+				availablePeriodicId = pEvent.Id
+			} else {
+				occupiedPeriodicId = pEvent.Id
+			}
+		}
+		require.NotZero(t, availableSingleId)
+		require.NotZero(t, availablePeriodicId)
+		require.NotZero(t, occupiedPeriodicId)
+	}
+
+	// Single Available event
+	{
+		// fail: strict, deleted / shrunk availability leaves the occupied event uncovered
+		err := strictSvc.DeleteSingleEvent(ctx, availableSingleId, 1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = strictSvc.UpdateSingleEvent(ctx, availableSingleId, 1, schema.SingleEventTypeAvailable, time.Date(2014, 8, 12, 9, 0, 0, 0, time.UTC), time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ok: strict, shrunk availability still covers the occupied event
+		require.NoError(t, strictSvc.UpdateSingleEvent(ctx, availableSingleId, 1, schema.SingleEventTypeAvailable, time.Date(2014, 8, 12, 10, 0, 0, 0, time.UTC), time.Hour))
+
+		// ok: warn, deleted with warnings
+		var warnings common.Warnings
+		require.NoError(t, warnSvc.DeleteSingleEvent(common.WithWarnings(ctx, &warnings), availableSingleId, 2))
+		require.Len(t, warnings, 1)
+	}
+
+	// Periodic Available event
+	{
+		// fail: strict, deleted / moved / skipped occurrence leaves the occupied event uncovered
+		err := strictSvc.DeletePeriodicEvent(ctx, availablePeriodicId, 1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = strictSvc.UpdatePeriodicEvent(ctx, availablePeriodicId, 1, schema.SingleEventTypeAvailable, time.Date(2014, 8, 6, 9, 0, 0, 0, time.UTC), 4*time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = strictSvc.AddPeriodicEventException(ctx, availablePeriodicId, 1, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = strictSvc.AddPeriodicEventOverride(ctx, availablePeriodicId, 1, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC), time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), 4*time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ok: strict, skipped occurrence doesn't cover anything
+		_, err = strictSvc.AddPeriodicEventException(ctx, availablePeriodicId, 1, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		// ok: warn, skipped with warnings
+		var warnings common.Warnings
+		_, err = warnSvc.AddPeriodicEventException(common.WithWarnings(ctx, &warnings), availablePeriodicId, 2, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, warnings, 1)
+	}
+
+	// Restored Occupied occurrence
+	// 25.08.2014 (MON) 12:00 - 13:00 occupied occurrence is skipped, then the availability occurrence is skipped as well
+	{
+		exceptionId, err := strictSvc.AddPeriodicEventException(ctx, occupiedPeriodicId, 1, time.Date(2014, 8, 25, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		_, err = strictSvc.AddPeriodicEventException(ctx, availablePeriodicId, 3, time.Date(2014, 8, 25, 9, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		// fail: strict, restored occurrence is not covered
+		err = strictSvc.RemovePeriodicEventException(ctx, exceptionId, 2)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ok: warn, restored with warnings
+		var warnings common.Warnings
+		require.NoError(t, warnSvc.RemovePeriodicEventException(common.WithWarnings(ctx, &warnings), exceptionId, 2))
+		require.Len(t, warnings, 1)
+	}
+}
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) RestoreSingleEvent(ctx context.Context, eventId int64) error {
+	// Restore and check intersection / availability coverage within a single (write locked) transaction
+	// (the slot might have been taken since the removal, the restore is rolled back then)
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		if err := txSvc.eventsSt.RestoreSingleEvent(ctx, eventId); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.RestoreSingleEvent(%d): %w", eventId, err)
+		}
+
+		event, err := txSvc.eventsSt.GetSingleEvent(ctx, eventId)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetSingleEvent(%d): %w", eventId, err)
+		}
+		if event == nil {
+			return fmt.Errorf("%s (%d): %w", "eventId", eventId, common.ErrNotFound)
+		}
+
+		// Check intersection and availability coverage (excluding the restored event itself)
+		if err := txSvc.checkSingleEventIntersections(ctx, event.ChargePointId, event.Type, event.StartDateTime, event.Duration, &eventKey{Id: eventId}); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, event.Type, event.StartDateTime, event.Duration, &eventKey{Id: eventId}); err != nil {
+			return err
+		}
+
+		if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationRestore, nil, event); err != nil {
+			return err
+		}
+		change = newSingleEventChange(*event)
+		svc.logger.Info().Stringer("event", event).Msgf("event restored")
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(change)
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+func (svc Scheduler) RestorePeriodicEvent(ctx context.Context, eventId int64) error {
+	// Restore and check intersection / availability coverage within a single (write locked) transaction
+	// (the slots might have been taken since the removal, the restore is rolled back then)
+	var change schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		if err := txSvc.eventsSt.RestorePeriodicEvent(ctx, eventId); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.RestorePeriodicEvent(%d): %w", eventId, err)
+		}
+
+		event, err := txSvc.getPeriodicEvent(ctx, eventId)
+		if err != nil {
+			return err
+		}
+
+		// Recurrences are expanded in the charge point time zone wall-clock time
+		loc, err := txSvc.getChargePointLocation(ctx, event.ChargePointId)
+		if err != nil {
+			return err
+		}
+		rule, err := newRule(event.Rrule.OrigOptions.Dtstart.In(loc), event.Rrule.OrigOptions.RRuleString())
+		if err != nil {
+			return err
+		}
+
+		// Check intersection and availability coverage (excluding the restored event itself)
+		if err := txSvc.checkPeriodicEventIntersections(ctx, event.ChargePointId, event.Type, rule, event.Duration, &eventKey{Id: eventId, Periodic: true}); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkPeriodicEventCoverage(ctx, event.ChargePointId, event.Type, rule, event.Duration, &eventKey{Id: eventId, Periodic: true}); err != nil {
+			return err
+		}
+
+		// Check the moved occurrences
+		for _, exception := range event.Exceptions {
+			if exception.OverrideStart == nil {
+				continue
+			}
+			if err := txSvc.checkOccurrenceIntersections(ctx, *event, *exception.OverrideStart, exception.OverrideDuration); err != nil {
+				return err
+			}
+			occurrenceWarnings, err := txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, event.Type, *exception.OverrideStart, exception.OverrideDuration, nil)
+			if err != nil {
+				return err
+			}
+			warnings = append(warnings, occurrenceWarnings...)
+		}
+
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationRestore, nil, event); err != nil {
+			return err
+		}
+		change = newPeriodicEventChange(*event)
+		svc.logger.Info().Stringer("event", event).Msgf("event restored")
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(change)
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+func (svc Scheduler) PurgeDeletedEvents(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	// Input checks
+	if deletedBefore.IsZero() {
+		return 0, fmt.Errorf("%s: zero: %w", "deletedBefore", common.ErrInvalidInput)
+	}
+
+	// Purged events are recorded to the history (with the last snapshot) and removed within a single transaction.
+	// Soft-deleted events are not visible, so there is nothing to notify about.
+	var cnt int64
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		singleEvents, err := txSvc.eventsSt.GetSingleEventsDeletedBefore(ctx, deletedBefore)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetSingleEventsDeletedBefore: %w", err)
+		}
+		for i := range singleEvents {
+			if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationPurge, &singleEvents[i], nil); err != nil {
+				return err
+			}
+		}
+
+		periodicEvents, err := txSvc.eventsSt.GetPeriodicEventsDeletedBefore(ctx, deletedBefore)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetPeriodicEventsDeletedBefore: %w", err)
+		}
+		for i := range periodicEvents {
+			if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationPurge, &periodicEvents[i], nil); err != nil {
+				return err
+			}
+		}
+
+		if cnt, err = txSvc.eventsSt.PurgeDeletedEvents(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.PurgeDeletedEvents: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	svc.logger.Info().Int64("count", cnt).Time("deletedBefore", deletedBefore).Msgf("deleted events purged")
+
+	return cnt, nil
+}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_Restore() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	singleStart := time.Date(2003, 3, 3, 9, 0, 0, 0, time.UTC)
+	periodicStart := time.Date(2003, 3, 4, 9, 0, 0, 0, time.UTC)
+	rangeStart, rangeEnd := time.Date(2003, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	// Init fixtures
+	// 03.03.2003 (MON) 09:00 - 12:00 (deleted)
+	// 04.03.2003 (TUE) 09:00 - 12:00 weekly (deleted)
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, 3*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart, 3*time.Hour))
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, 1, 1))
+		require.NoError(t, targetSvc.DeletePeriodicEvent(ctx, 1, 1))
+	}
+
+	// ok: deleted events are listed on demand only
+	{
+		sEvents, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Empty(t, sEvents)
+		require.Empty(t, pEvents)
+
+		sEvents, pEvents, err = targetSvc.GetEventsWithOptions(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, schema.EventsListOptions{IncludeDeleted: true})
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.NotNil(t, sEvents[0].DeletedAt)
+		require.True(t, singleStart.Equal(sEvents[0].StartDateTime))
+		require.Len(t, pEvents, 1)
+		require.NotNil(t, pEvents[0].DeletedAt)
+	}
+
+	// fail: non-existing / not deleted events
+	{
+		err := targetSvc.RestoreSingleEvent(ctx, 1000)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSvc.RestorePeriodicEvent(ctx, 1000)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// fail: slot is taken since the removal (event is kept deleted)
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart.Add(time.Hour), time.Hour))
+
+		err := targetSvc.RestoreSingleEvent(ctx, 1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.EqualValues(t, 2, sEvents[0].Id)
+
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, 2, 1))
+	}
+
+	// ok: RestoreSingleEvent / RestorePeriodicEvent
+	{
+		require.NoError(t, targetSvc.RestoreSingleEvent(common.WithActor(ctx, "alice"), 1))
+		require.NoError(t, targetSvc.RestorePeriodicEvent(ctx, 1))
+
+		sEvents, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.EqualValues(t, 1, sEvents[0].Id)
+		require.Nil(t, sEvents[0].DeletedAt)
+		require.Len(t, pEvents, 1)
+		require.Nil(t, pEvents[0].DeletedAt)
+
+		entries, err := targetSvc.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, schema.EventOperationDelete, entries[1].Operation)
+		require.Equal(t, schema.EventOperationRestore, entries[2].Operation)
+		require.Equal(t, "alice", entries[2].Actor)
+		require.Nil(t, entries[2].Before)
+		require.NotNil(t, entries[2].After)
+
+		// Already restored
+		err = targetSvc.RestoreSingleEvent(ctx, 1)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: restored events occupy their slots again
+	{
+		err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *ServiceTestSuite) Test_PurgeDeletedEvents() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	singleStart := time.Date(2003, 3, 3, 9, 0, 0, 0, time.UTC)
+	periodicStart := time.Date(2003, 3, 4, 9, 0, 0, 0, time.UTC)
+	rangeStart, rangeEnd := time.Date(2003, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	// Init fixtures
+	// 03.03.2003 (MON) 09:00 - 12:00 (deleted)
+	// 04.03.2003 (TUE) 09:00 - 12:00 weekly, 11.03.2003 occurrence skipped (deleted)
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, singleStart, 3*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, periodicStart, 3*time.Hour))
+		_, err := targetSvc.AddPeriodicEventException(ctx, 1, 1, periodicStart.AddDate(0, 0, 7))
+		require.NoError(t, err)
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, 1, 1))
+		require.NoError(t, targetSvc.DeletePeriodicEvent(ctx, 1, 2))
+	}
+
+	// fail: zero deletedBefore
+	{
+		_, err := targetSvc.PurgeDeletedEvents(ctx, time.Time{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: recently deleted event is kept
+	{
+		cnt, err := targetSvc.PurgeDeletedEvents(ctx, time.Now().UTC().Add(-time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, cnt)
+	}
+
+	// ok: PurgeDeletedEvents
+	{
+		cnt, err := targetSvc.PurgeDeletedEvents(ctx, time.Now().UTC().Add(time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, cnt)
+
+		sEvents, pEvents, err := targetSvc.GetEventsWithOptions(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, schema.EventsListOptions{IncludeDeleted: true})
+		require.NoError(t, err)
+		require.Empty(t, sEvents)
+		require.Empty(t, pEvents)
+
+		err = targetSvc.RestoreSingleEvent(ctx, 1)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSvc.RestorePeriodicEvent(ctx, 1)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: purged events history is kept (with the last snapshot)
+	{
+		entries, err := targetSvc.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		require.Equal(t, schema.EventOperationPurge, entries[2].Operation)
+		require.Nil(t, entries[2].After)
+
+		var purgedSingle schema.SingleEvent
+		require.NoError(t, json.Unmarshal(entries[2].Before, &purgedSingle))
+		require.NotNil(t, purgedSingle.DeletedAt)
+		require.True(t, singleStart.Equal(purgedSingle.StartDateTime))
+
+		entries, err = targetSvc.GetEventHistory(ctx, 1, true)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		require.Equal(t, schema.EventOperationPurge, entries[3].Operation)
+		require.Nil(t, entries[3].After)
+
+		var purgedPeriodic schema.PeriodicEvent
+		require.NoError(t, json.Unmarshal(entries[3].Before, &purgedPeriodic))
+		require.NotNil(t, purgedPeriodic.DeletedAt)
+		require.Len(t, purgedPeriodic.Exceptions, 1)
+	}
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+const (
+	// weeklyRRule is the default periodic event recurrence rule.
+	weeklyRRule = "FREQ=WEEKLY"
+	// periodicCheckHorizon limits the intersection check range for endless (no COUNT / UNTIL) periodic events.
+	periodicCheckHorizon = 366 * dayDur
+	// periodicCheckLimit limits the intersection check range for finite periodic events (full lifetime otherwise).
+	periodicCheckLimit = 10 * periodicCheckHorizon
+)
+
+// newRule builds an RRule from the RFC 5545 RRULE string (DTSTART excluded) starting from eventStart.
+// Rule is expanded within the eventStart location (DTSTART TZID), local UNTIL is parsed within it as well.
+// Sub-daily recurrences are not supported.
+func newRule(eventStart time.Time, rruleStr string) (*rrule.RRule, error) {
+	opts, err := rrule.StrToROptionInLocation(rruleStr, eventStart.Location())
+	if err != nil {
+		return nil, fmt.Errorf("%s: parsing (%v): %w", "rrule", err, common.ErrInvalidInput)
+	}
+	if !opts.Dtstart.IsZero() {
+		return nil, fmt.Errorf("%s: DTSTART must not be set (eventStart is used): %w", "rrule", common.ErrInvalidInput)
+	}
+	if opts.Freq > rrule.DAILY {
+		return nil, fmt.Errorf("%s: FREQ must be DAILY or longer: %w", "rrule", common.ErrInvalidInput)
+	}
+	if len(opts.Byhour) > 0 || len(opts.Byminute) > 0 || len(opts.Bysecond) > 0 {
+		return nil, fmt.Errorf("%s: BYHOUR / BYMINUTE / BYSECOND are not supported: %w", "rrule", common.ErrInvalidInput)
+	}
+	opts.Dtstart = eventStart
+
+	rule, err := rrule.NewRRule(*opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: rrule.NewRRule (%v): %w", "rrule", err, common.ErrInvalidInput)
+	}
+	if rule.After(eventStart, true).IsZero() {
+		return nil, fmt.Errorf("%s: no occurrences: %w", "rrule", common.ErrInvalidInput)
+	}
+
+	return rule, nil
+}
+
+// newWeeklyRule builds an endless weekly RRule starting from eventStart.
+func newWeeklyRule(eventStart time.Time) (*rrule.RRule, error) {
+	return newRule(eventStart, weeklyRRule)
+}
+
+// isFiniteRule checks if the rule has a limited number of occurrences.
+func isFiniteRule(rule *rrule.RRule) bool {
+	return rule.OrigOptions.Count > 0 || !rule.OrigOptions.Until.IsZero()
+}
+
+// getPeriodicCheckRange returns the range within which periodic event occurrences are checked for intersections.
+// Range covers the full rule lifetime for finite rules and periodicCheckHorizon for endless ones.
+func getPeriodicCheckRange(rule *rrule.RRule, eventDur time.Duration) (retStart, retEnd time.Time) {
+	eventStart := rule.OrigOptions.Dtstart
+
+	lastStart := eventStart.Add(periodicCheckHorizon)
+	if isFiniteRule(rule) {
+		lastStart = eventStart.Add(periodicCheckLimit)
+		if ruleLastStart := rule.Before(lastStart, true); !ruleLastStart.IsZero() {
+			lastStart = ruleLastStart
+		}
+	}
+
+	// [eventStart -1 day : lastEventEnd +1 day]
+	retStart = eventStart.Add(-dayDur)
+	retEnd = lastStart.Add(eventDur).Add(dayDur)
+
+	return
+}
+
+// getPeriodicEventRange returns the periodic event check range (see getPeriodicCheckRange) extended with its moved occurrences.
+func getPeriodicEventRange(obj schema.PeriodicEvent) (retStart, retEnd time.Time) {
+	retStart, retEnd = getPeriodicCheckRange(&obj.Rrule, obj.Duration)
+	for _, exception := range obj.Exceptions {
+		if !exception.IsOverride() {
+			continue
+		}
+		if overrideStart := exception.OverrideStart.Add(-dayDur); overrideStart.Before(retStart) {
+			retStart = overrideStart
+		}
+		if overrideEnd := exception.OverrideStart.Add(exception.OverrideDuration).Add(dayDur); overrideEnd.After(retEnd) {
+			retEnd = overrideEnd
+		}
+	}
+
+	return
+}
+
+// isRuleOccurrence checks if ts is one of the rule occurrences.
+func isRuleOccurrence(rule *rrule.RRule, ts time.Time) bool {
+	return rule.After(ts, true).Equal(ts)
+}
+
+// getPeriodicEventOccurrences returns the periodic event occurrences starting within the range applying its exceptions.
+// Skipped and moved occurrences are excluded (EXDATE), moved ones are included with the new time (RDATE).
+// Occurrences are returned within the loc time zone.
+func getPeriodicEventOccurrences(obj schema.PeriodicEvent, loc *time.Location, rangeStart, rangeEnd time.Time) []event {
+	rule := obj.Rrule
+	set := rrule.Set{}
+	set.RRule(&rule)
+
+	// Occurrence start (unix) -> altered duration
+	overrideDurs := make(map[int64]time.Duration, len(obj.Exceptions))
+	for _, exception := range obj.Exceptions {
+		if !exception.IsOverride() {
+			set.ExDate(exception.OccurrenceStart)
+			continue
+		}
+
+		overrideDurs[exception.OverrideStart.Unix()] = exception.OverrideDuration
+		// EXDATE also excludes the same RDATE, so only the duration is altered if the start is kept
+		if exception.OverrideStart.Equal(exception.OccurrenceStart) {
+			continue
+		}
+		set.ExDate(exception.OccurrenceStart)
+		set.RDate(*exception.OverrideStart)
+	}
+
+	occurrences := set.Between(rangeStart, rangeEnd, true)
+	retEvents := make([]event, 0, len(occurrences))
+	for _, t := range occurrences {
+		t = t.In(loc)
+		dur := obj.Duration
+		if overrideDur, ok := overrideDurs[t.Unix()]; ok {
+			dur = overrideDur
+		}
+
+		retEvents = append(retEvents, event{
+			Id:       obj.Id,
+			Periodic: true,
+			Type:     obj.Type,
+			Start:    t,
+			End:      t.Add(dur),
+		})
+	}
+
+	return retEvents
+}
+
+// getPeriodicEventMaxDuration returns the longest periodic event occurrence duration (overrides included).
+func getPeriodicEventMaxDuration(obj schema.PeriodicEvent) time.Duration {
+	maxDur := obj.Duration
+	for _, exception := range obj.Exceptions {
+		if exception.IsOverride() && exception.OverrideDuration > maxDur {
+			maxDur = exception.OverrideDuration
+		}
+	}
+
+	return maxDur
+}
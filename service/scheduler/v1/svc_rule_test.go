@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_PeriodicEventWithRule() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// fail: wrong rules
+	{
+		eventStart := time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC)
+
+		for _, rruleStr := range []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=FORTNIGHTLY",
+			"FREQ=HOURLY",
+			"FREQ=DAILY;BYHOUR=9,10",
+			"FREQ=DAILY;DTSTART=20140804T090000Z",
+			"FREQ=DAILY;UNTIL=20140801T000000Z",
+		} {
+			err := targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, rruleStr, 3*time.Hour)
+			require.Error(t, err, rruleStr)
+			require.True(t, errors.Is(err, common.ErrInvalidInput), rruleStr)
+		}
+	}
+
+	// ok: weekdays only until the end of the week
+	// 04.08.2014 (MON) - 08.08.2014 (FRI) 09:00 - 12:00
+	{
+		require.NoError(t, targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId,
+			schema.SingleEventTypeAvailable,
+			time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC),
+			"FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20140809T000000Z",
+			3*time.Hour,
+		))
+
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 4, 0, 0, 0, 0, time.UTC), 14*dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 14)
+		for i, agenda := range agendas {
+			if i < 5 {
+				require.Len(t, agenda.TimeSlots, 3, agenda.Date.String())
+				continue
+			}
+			require.Len(t, agenda.TimeSlots, 0, agenda.Date.String())
+		}
+	}
+
+	// ok: the same time after the rule UNTIL
+	// 11.08.2014 (MON) 09:00 - 12:00
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC), 3*time.Hour))
+	}
+
+	// fail: single event intersects a rule occurrence
+	// 07.08.2014 (THU) 11:00 - 13:00
+	{
+		err := targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 7, 11, 0, 0, 0, time.UTC), 2*time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: monthly
+	// 15th of each month (15.08.2014 is FRI) 09:00 - 10:00
+	{
+		require.NoError(t, targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId,
+			schema.SingleEventTypeOccupied,
+			time.Date(2014, 8, 15, 9, 0, 0, 0, time.UTC),
+			"FREQ=MONTHLY;BYMONTHDAY=15",
+			time.Hour,
+		))
+	}
+
+	// fail: weekly (SAT) intersects the monthly rule months later (15.11.2014 is SAT)
+	{
+		err := targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 16, 9, 30, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: every other week with COUNT ends before the intersection (16.08, 30.08, 13.09)
+	{
+		require.NoError(t, targetSvc.AddPeriodicEventWithRule(ctx, schema.DefaultChargePointId,
+			schema.SingleEventTypeOccupied,
+			time.Date(2014, 8, 16, 9, 30, 0, 0, time.UTC),
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			time.Hour,
+		))
+
+		_, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, pEvents, 3)
+		require.Len(t, pEvents[2].Rrule.All(), 3)
+	}
+
+	// ok: UpdatePeriodicEvent keeps the rule, moving its start
+	{
+		_, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		eventId := pEvents[2].Id
+
+		require.NoError(t, targetSvc.UpdatePeriodicEvent(ctx, eventId, pEvents[2].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 17, 9, 30, 0, 0, time.UTC), time.Hour))
+
+		event, err := s.r.StorageRes.Storage.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Equal(t, 2, event.Rrule.OrigOptions.Interval)
+		require.Equal(t, 3, event.Rrule.OrigOptions.Count)
+		require.Equal(t, time.Date(2014, 8, 17, 9, 30, 0, 0, time.UTC), event.Rrule.OrigOptions.Dtstart)
+	}
+
+	// fail: UpdatePeriodicEventWithRule: endless rule intersects the monthly one (15.02.2015 is SUN)
+	{
+		_, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2014, 9, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		eventId := pEvents[2].Id
+
+		err = targetSvc.UpdatePeriodicEventWithRule(ctx, eventId, pEvents[2].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 17, 9, 30, 0, 0, time.UTC), "", time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSvc.UpdatePeriodicEventWithRule(ctx, eventId, pEvents[2].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 17, 9, 30, 0, 0, time.UTC), "FREQ=WEEKLY", time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) FindEarliestSlot(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur, desiredDur time.Duration) (retSlot schema.TimeSlot, retErr error) {
+	// Input checks
+	if err := validatePeriod(periodStart, periodDur); err != nil {
+		retErr = err
+		return
+	}
+	if desiredDur <= 0 {
+		retErr = fmt.Errorf("%s: must be GT 0: %w", "desiredDur", common.ErrInvalidInput)
+		return
+	}
+
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+	periodStart = periodStart.In(loc)
+	periodEnd := addCalendarDuration(periodStart, periodDur)
+
+	// Slot must start within the period, but might end after it
+	windows, err := svc.getFreeWindows(ctx, chargePointId, periodStart, periodEnd.Add(desiredDur))
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, window := range windows {
+		slotStart := window.Start
+		if slotStart.Before(periodStart) {
+			slotStart = periodStart
+		}
+		if !slotStart.Before(periodEnd) {
+			break
+		}
+
+		if window.Start.Add(window.Duration).Sub(slotStart) >= desiredDur {
+			retSlot = schema.TimeSlot{
+				Start:    slotStart,
+				Duration: desiredDur,
+			}
+			return
+		}
+	}
+	retErr = fmt.Errorf("no %s slot within the period: %w", desiredDur, common.ErrNotFound)
+
+	return
+}
+
+func (svc Scheduler) GetFreeWindows(ctx context.Context, chargePointId int64, periodStart time.Time, periodDur time.Duration) (retWindows []schema.TimeSlot, retErr error) {
+	// Input checks
+	if err := validatePeriod(periodStart, periodDur); err != nil {
+		retErr = err
+		return
+	}
+
+	loc, err := svc.getChargePointLocation(ctx, chargePointId)
+	if err != nil {
+		retErr = err
+		return
+	}
+	periodStart = periodStart.In(loc)
+	periodEnd := addCalendarDuration(periodStart, periodDur)
+
+	windows, err := svc.getFreeWindows(ctx, chargePointId, periodStart, periodEnd)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	// Cut windows by the period
+	retWindows = make([]schema.TimeSlot, 0, len(windows))
+	for _, window := range windows {
+		windowStart, windowEnd := window.Start, window.Start.Add(window.Duration)
+		if windowStart.Before(periodStart) {
+			windowStart = periodStart
+		}
+		if windowEnd.After(periodEnd) {
+			windowEnd = periodEnd
+		}
+		if !windowEnd.After(windowStart) {
+			continue
+		}
+
+		retWindows = append(retWindows, schema.TimeSlot{
+			Start:    windowStart,
+			Duration: windowEnd.Sub(windowStart),
+		})
+	}
+
+	return
+}
+
+// getFreeWindows returns maximal free (available and not occupied) windows intersecting the [periodStart, periodEnd) range.
+// Windows are not cut by the range.
+func (svc Scheduler) getFreeWindows(ctx context.Context, chargePointId int64, periodStart, periodEnd time.Time) ([]schema.TimeSlot, error) {
+	// Get existing events [-1 day : +1 day]
+	greenEvents, redEvents, err := svc.getGreenRedEvents(ctx, chargePointId, periodStart.Add(-dayDur), periodEnd.Add(dayDur))
+	if err != nil {
+		return nil, fmt.Errorf("svc.getGreenRedEvents: %w", err)
+	}
+
+	// Touching greens are joined by the merge, so merged greens are maximal windows
+	var retWindows []schema.TimeSlot
+	for window := svc.mergeGreenRedEvents(greenEvents, redEvents); window != nil; window = window.Next {
+		if !window.End.After(periodStart) || !window.Start.Before(periodEnd) {
+			continue
+		}
+
+		retWindows = append(retWindows, schema.TimeSlot{
+			Start:    window.Start,
+			Duration: window.End.Sub(window.Start),
+		})
+	}
+
+	return retWindows, nil
+}
+
+// validatePeriod checks the requested period inputs.
+func validatePeriod(periodStart time.Time, periodDur time.Duration) error {
+	if periodStart.IsZero() {
+		return fmt.Errorf("%s: zero: %w", "periodStart", common.ErrInvalidInput)
+	}
+	if periodDur <= 0 {
+		return fmt.Errorf("%s: must be GT 0: %w", "periodDur", common.ErrInvalidInput)
+	}
+
+	return nil
+}
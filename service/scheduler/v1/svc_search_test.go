@@ -0,0 +1,97 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_FreeWindows() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// Init fixtures
+	// 04.08.2014 (MON) 09:30 - 13:30 weekly
+	// 11.08.2014 (MON) 14:00 - 15:00 available
+	// 11.08.2014 (MON) 10:30 - 11:30 occupied
+	// 12.08.2014 (TUE) 22:00 - 13.08.2014 (WED) 02:00 available
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 11, 14, 0, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC), time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 12, 22, 0, 0, 0, time.UTC), 4*time.Hour))
+	}
+	periodStart := time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)
+
+	// fail: wrong inputs
+	{
+		_, err := targetSvc.GetFreeWindows(ctx, schema.DefaultChargePointId, time.Time{}, dayDur)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.GetFreeWindows(ctx, schema.DefaultChargePointId, periodStart, 0)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, periodStart, dayDur, 0)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.FindEarliestSlot(ctx, 1000, periodStart, dayDur, time.Hour)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: free windows (windows are cut by the period)
+	{
+		windows, err := targetSvc.GetFreeWindows(ctx, schema.DefaultChargePointId, periodStart, 2*dayDur)
+		require.NoError(t, err)
+		require.Equal(t, []schema.TimeSlot{
+			{Start: time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), Duration: time.Hour},
+			{Start: time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC), Duration: 2 * time.Hour},
+			{Start: time.Date(2014, 8, 11, 14, 0, 0, 0, time.UTC), Duration: time.Hour},
+			{Start: time.Date(2014, 8, 12, 22, 0, 0, 0, time.UTC), Duration: 2 * time.Hour},
+		}, windows)
+
+		windows, err = targetSvc.GetFreeWindows(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, []schema.TimeSlot{
+			{Start: time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), Duration: time.Hour},
+		}, windows)
+	}
+
+	// ok: earliest slot
+	{
+		// 09:30 - 10:30 window fits
+		slot, err := targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, periodStart, dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, schema.TimeSlot{Start: time.Date(2014, 8, 11, 9, 30, 0, 0, time.UTC), Duration: time.Hour}, slot)
+
+		// 11:30 - 13:30 window fits
+		slot, err = targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, periodStart, dayDur, 2*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2014, 8, 11, 11, 30, 0, 0, time.UTC), slot.Start)
+
+		// Starts at the period start within the window
+		slot, err = targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), slot.Start)
+
+		// Starts within the period, but ends after it
+		slot, err = targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC), dayDur, 4*time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2014, 8, 12, 22, 0, 0, 0, time.UTC), slot.Start)
+
+		// The slot found is bookable
+		_, err = targetSvc.BookSlot(ctx, schema.DefaultChargePointId, slot.Start, slot.Duration, "")
+		require.NoError(t, err)
+	}
+
+	// fail: no slot fits
+	{
+		_, err := targetSvc.FindEarliestSlot(ctx, schema.DefaultChargePointId, periodStart, dayDur, 5*time.Hour)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
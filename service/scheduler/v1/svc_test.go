@@ -16,22 +16,22 @@ func (s *ServiceTestSuite) Test_LogicTest1() {
 
 	// Init fixtures
 	{
-		require.NoError(t, targetSvc.AddPeriodicEvent(ctx,
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId,
 			schema.SingleEventTypeAvailable,
 			time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC),
-			13, 30,
+			4*time.Hour,
 		))
 
-		require.NoError(t, targetSvc.AddSingleEvent(ctx,
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId,
 			schema.SingleEventTypeOccupied,
 			time.Date(2014, 8, 11, 10, 30, 0, 0, time.UTC),
-			11, 30,
+			time.Hour,
 		))
 	}
 
 	// Check
 	{
-		agendas, err := targetSvc.GetAvailableAgenda(ctx,
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId,
 			time.Date(2014, 8, 10, 0, 0, 0, 0, time.UTC),
 			10*dayDur,
 			30*time.Minute,
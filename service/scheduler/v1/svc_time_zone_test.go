@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_TimeZone() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	cpId, err := targetSvc.CreateChargePoint(ctx, "CP-BER", "Berlin Mitte", 1, "Europe/Berlin", 0)
+	require.NoError(t, err)
+
+	// ok: weekly event keeps the local wall-clock time across DST changes (30.03.2014 and 26.10.2014)
+	// 24.03.2014 (MON) 09:30 - 13:30 CET (08:30 UTC)
+	{
+		eventStart := time.Date(2014, 3, 24, 8, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, cpId, schema.SingleEventTypeAvailable, eventStart, 4*time.Hour))
+
+		for _, agendaStart := range []time.Time{
+			time.Date(2014, 3, 24, 0, 0, 0, 0, berlin),  // CET
+			time.Date(2014, 3, 31, 0, 0, 0, 0, berlin),  // CEST
+			time.Date(2014, 10, 27, 0, 0, 0, 0, berlin), // CET
+		} {
+			agendas, err := targetSvc.GetAvailableAgenda(ctx, cpId, agendaStart, dayDur, time.Hour)
+			require.NoError(t, err)
+			require.Len(t, agendas, 1, agendaStart.String())
+			require.Len(t, agendas[0].TimeSlots, 4, agendaStart.String())
+
+			slotStart := agendas[0].TimeSlots[0].Start
+			require.Equal(t, berlin.String(), slotStart.Location().String())
+			require.Equal(t, 9, slotStart.Hour(), agendaStart.String())
+			require.Equal(t, 30, slotStart.Minute(), agendaStart.String())
+		}
+	}
+
+	// ok: single event is returned within the charge point time zone
+	// 31.03.2014 (MON) 10:30 - 11:30 CEST (08:30 UTC)
+	{
+		eventStart := time.Date(2014, 3, 31, 8, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, cpId, schema.SingleEventTypeOccupied, eventStart, time.Hour))
+
+		sEvents, _, err := targetSvc.GetEvents(ctx, cpId, time.Date(2014, 3, 31, 0, 0, 0, 0, berlin), time.Date(2014, 4, 1, 0, 0, 0, 0, berlin))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+		require.True(t, eventStart.Equal(sEvents[0].StartDateTime))
+		require.Equal(t, 10, sEvents[0].StartDateTime.Hour())
+
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, cpId, time.Date(2014, 3, 31, 0, 0, 0, 0, berlin), dayDur, 30*time.Minute)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 6)
+		require.True(t, time.Date(2014, 3, 31, 11, 30, 0, 0, berlin).Equal(agendas[0].TimeSlots[2].Start))
+	}
+
+	// ok: agenda days are cut at the local midnight and a period crossing the DST change keeps whole days
+	{
+		agendaStart := time.Date(2014, 3, 24, 0, 0, 0, 0, berlin)
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, cpId, agendaStart, 10*dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 10)
+		for i, agenda := range agendas {
+			expectedDate := agendaStart.AddDate(0, 0, i)
+			require.True(t, expectedDate.Equal(agenda.Date), "%d: %s", i, agenda.Date)
+
+			for _, slot := range agenda.TimeSlots {
+				require.Equal(t, expectedDate.Day(), slot.Start.In(berlin).Day())
+			}
+		}
+	}
+
+	// ok: UTC charge point with the same UTC input is not affected
+	// 24.03.2014 (MON) 08:30 - 13:30 UTC
+	{
+		eventStart := time.Date(2014, 3, 24, 8, 30, 0, 0, time.UTC)
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, eventStart, 5*time.Hour))
+
+		agendas, err := targetSvc.GetAvailableAgenda(ctx, schema.DefaultChargePointId, time.Date(2014, 3, 31, 0, 0, 0, 0, time.UTC), dayDur, time.Hour)
+		require.NoError(t, err)
+		require.Len(t, agendas, 1)
+		require.Len(t, agendas[0].TimeSlots, 5)
+		require.Equal(t, time.Date(2014, 3, 31, 8, 30, 0, 0, time.UTC), agendas[0].TimeSlots[0].Start)
+	}
+}
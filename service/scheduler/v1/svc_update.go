@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (svc Scheduler) UpdateSingleEvent(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error {
+	// Read, check intersection and update within a single (write locked) transaction
+	var changes []schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		event, err := txSvc.eventsSt.GetSingleEvent(ctx, eventId)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetSingleEvent(%d): %w", eventId, err)
+		}
+		if event == nil {
+			return fmt.Errorf("%s (%d): %w", "eventId", eventId, common.ErrNotFound)
+		}
+		if err := checkEventVersion(eventId, version, event.Version); err != nil {
+			return err
+		}
+
+		// Event is defined within the charge point time zone
+		loc, err := txSvc.getChargePointLocation(ctx, event.ChargePointId)
+		if err != nil {
+			return err
+		}
+		eventStart = eventStart.In(loc)
+
+		// Common check
+		if err := txSvc.validateEventInput(eventType, eventStart, eventDur); err != nil {
+			return err
+		}
+
+		// Check intersection and availability coverage (excluding the updated event itself)
+		if err := txSvc.checkSingleEventIntersections(ctx, event.ChargePointId, eventType, eventStart, eventDur, &eventKey{Id: eventId}); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, eventType, eventStart, eventDur, &eventKey{Id: eventId}); err != nil {
+			return err
+		}
+
+		// Update
+		changes = append(changes, newSingleEventChange(*event))
+		prevEvent := *event
+		event.Type = eventType
+		event.StartDateTime = eventStart
+		event.Duration = eventDur
+		if err := txSvc.eventsSt.UpdateSingleEvent(ctx, *event); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.UpdateSingleEvent: %w", err)
+		}
+		event.Version++
+		if err := txSvc.recordSingleEventChange(ctx, schema.EventOperationUpdate, &prevEvent, event); err != nil {
+			return err
+		}
+		changes = append(changes, newSingleEventChange(*event))
+
+		// Occupied events might have been covered by the previous availability
+		if prevEvent.Type == schema.SingleEventTypeAvailable {
+			removedWarnings, err := txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, prevEvent.StartDateTime, prevEvent.StartDateTime.Add(prevEvent.Duration), &eventKey{Id: eventId})
+			if err != nil {
+				return err
+			}
+			warnings = append(warnings, removedWarnings...)
+		}
+		svc.logger.Info().Stringer("event", event).Msgf("event updated")
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(changes...)
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+func (svc Scheduler) UpdatePeriodicEvent(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, eventDur time.Duration) error {
+	return svc.updatePeriodicEvent(ctx, eventId, version, eventType, eventStart, "", eventDur)
+}
+
+func (svc Scheduler) UpdatePeriodicEventWithRule(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, rruleStr string, eventDur time.Duration) error {
+	if rruleStr == "" {
+		return fmt.Errorf("%s: empty: %w", "rruleStr", common.ErrInvalidInput)
+	}
+
+	return svc.updatePeriodicEvent(ctx, eventId, version, eventType, eventStart, rruleStr, eventDur)
+}
+
+// updatePeriodicEvent alters the periodic event (the existing recurrence is kept if rruleStr is empty).
+func (svc Scheduler) updatePeriodicEvent(ctx context.Context, eventId, version int64, eventType schema.SingleEventType, eventStart time.Time, rruleStr string, eventDur time.Duration) error {
+	// Read, check intersection and update within a single (write locked) transaction
+	var changes []schema.EventsChange
+	var warnings common.Warnings
+	err := svc.withTx(ctx, func(txSvc Scheduler) error {
+		event, err := txSvc.eventsSt.GetPeriodicEvent(ctx, eventId)
+		if err != nil {
+			return fmt.Errorf("txSvc.eventsSt.GetPeriodicEvent(%d): %w", eventId, err)
+		}
+		if event == nil {
+			return fmt.Errorf("%s (%d): %w", "eventId", eventId, common.ErrNotFound)
+		}
+		if err := checkEventVersion(eventId, version, event.Version); err != nil {
+			return err
+		}
+
+		// Recurrences are expanded in the charge point time zone wall-clock time
+		loc, err := txSvc.getChargePointLocation(ctx, event.ChargePointId)
+		if err != nil {
+			return err
+		}
+		eventStart = eventStart.In(loc)
+
+		// Common check
+		if err := txSvc.validateEventInput(eventType, eventStart, eventDur); err != nil {
+			return err
+		}
+
+		if rruleStr == "" {
+			rruleStr = event.Rrule.OrigOptions.RRuleString()
+		}
+		rule, err := newRule(eventStart, rruleStr)
+		if err != nil {
+			return err
+		}
+
+		// Check intersection and availability coverage (excluding the updated event itself)
+		if err := txSvc.checkPeriodicEventIntersections(ctx, event.ChargePointId, eventType, rule, eventDur, &eventKey{Id: eventId, Periodic: true}); err != nil {
+			return err
+		}
+		if warnings, err = txSvc.checkPeriodicEventCoverage(ctx, event.ChargePointId, eventType, rule, eventDur, &eventKey{Id: eventId, Periodic: true}); err != nil {
+			return err
+		}
+
+		// Update
+		changes = append(changes, newPeriodicEventChange(*event))
+		prevEvent := *event
+		event.Type = eventType
+		event.Rrule = *rule
+		event.Duration = eventDur
+		if err := txSvc.eventsSt.UpdatePeriodicEvent(ctx, *event); err != nil {
+			return fmt.Errorf("txSvc.eventsSt.UpdatePeriodicEvent: %w", err)
+		}
+
+		// Remove exceptions for occurrences not generated by the new rule
+		for _, exception := range event.Exceptions {
+			if isRuleOccurrence(rule, exception.OccurrenceStart) {
+				continue
+			}
+			if err := txSvc.eventsSt.DeletePeriodicEventException(ctx, exception.Id); err != nil {
+				return fmt.Errorf("txSvc.eventsSt.DeletePeriodicEventException(%d): %w", exception.Id, err)
+			}
+		}
+
+		// Stored state (remaining exceptions) is recorded
+		updatedEvent, err := txSvc.getPeriodicEvent(ctx, eventId)
+		if err != nil {
+			return err
+		}
+
+		// Check kept moved occurrences against the updated event (its type and new occurrences included)
+		for _, exception := range updatedEvent.Exceptions {
+			if !exception.IsOverride() {
+				continue
+			}
+			if err := txSvc.checkOccurrenceIntersections(ctx, *updatedEvent, *exception.OverrideStart, exception.OverrideDuration); err != nil {
+				return fmt.Errorf("moved occurrence (%d): %w", exception.Id, err)
+			}
+			overrideWarnings, err := txSvc.checkSingleEventCoverage(ctx, event.ChargePointId, eventType, *exception.OverrideStart, exception.OverrideDuration, nil)
+			if err != nil {
+				return fmt.Errorf("moved occurrence (%d): %w", exception.Id, err)
+			}
+			warnings = append(warnings, overrideWarnings...)
+		}
+		if err := txSvc.recordPeriodicEventChange(ctx, schema.EventOperationUpdate, &prevEvent, updatedEvent); err != nil {
+			return err
+		}
+		changes = append(changes, newPeriodicEventChange(*event))
+
+		// Occupied events might have been covered by the previous availability occurrences
+		if prevEvent.Type == schema.SingleEventTypeAvailable {
+			rangeStart, rangeEnd := getPeriodicEventRange(prevEvent)
+			removedWarnings, err := txSvc.checkRemovedAvailabilityCoverage(ctx, event.ChargePointId, rangeStart, rangeEnd, &eventKey{Id: eventId, Periodic: true})
+			if err != nil {
+				return err
+			}
+			warnings = append(warnings, removedWarnings...)
+		}
+		svc.logger.Info().Stringer("event", event).Msgf("event updated")
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	svc.notifyEventsChanged(changes...)
+	common.AddWarnings(ctx, warnings...)
+
+	return nil
+}
+
+// checkEventVersion checks that the client known event version is the stored one (the event wasn't changed since it was read).
+func checkEventVersion(eventId, version, storedVersion int64) error {
+	if version <= 0 {
+		return fmt.Errorf("%s: must be GT 0: %w", "version", common.ErrInvalidInput)
+	}
+	if version != storedVersion {
+		return fmt.Errorf("%s (%d): %d is outdated, the current one is %d: %w", "version", eventId, version, storedVersion, common.ErrVersionConflict)
+	}
+
+	return nil
+}
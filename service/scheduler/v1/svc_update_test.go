@@ -0,0 +1,219 @@
+package v1
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_Update() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	// Init fixtures
+	// 03.02.2003 (MON) 09:00 - 12:00, 13:00 - 15:00
+	// 04.02.2003 (TUE) 09:00 - 11:00 weekly
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 9, 0, 0, 0, time.UTC), 3*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 13, 0, 0, 0, time.UTC), 2*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2003, 2, 4, 9, 0, 0, 0, time.UTC), 2*time.Hour))
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2003, 2, 11, 12, 30, 0, 0, time.UTC), 30*time.Minute))
+	}
+
+	sEvents, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2003, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2003, 2, 28, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, sEvents, 3)
+	require.Len(t, pEvents, 1)
+	singleId, periodicId := sEvents[0].Id, pEvents[0].Id
+
+	// fail: wrong inputs
+	{
+		err := targetSvc.UpdateSingleEvent(ctx, singleId, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 9, 0, 0, 0, time.UTC), -time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// fail: non-existing events
+	{
+		err := targetSvc.UpdateSingleEvent(ctx, 1000, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 9, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSvc.UpdatePeriodicEvent(ctx, 1000, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 4, 9, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: UpdateSingleEvent: overlaps only the event itself
+	// 03.02.2003 (MON) 10:00 - 12:30
+	{
+		require.NoError(t, targetSvc.UpdateSingleEvent(ctx, singleId, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 10, 0, 0, 0, time.UTC), 2*time.Hour+30*time.Minute))
+	}
+
+	// fail: UpdateSingleEvent: intersects the other event
+	// 03.02.2003 (MON) 12:00 - 14:00
+	{
+		err := targetSvc.UpdateSingleEvent(ctx, singleId, 2, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 12, 0, 0, 0, time.UTC), 2*time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: UpdatePeriodicEvent: overlaps only the event itself
+	// 04.02.2003 (TUE) 10:00 - 12:00 weekly
+	{
+		require.NoError(t, targetSvc.UpdatePeriodicEvent(ctx, periodicId, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 4, 10, 0, 0, 0, time.UTC), 2*time.Hour))
+	}
+
+	// fail: UpdatePeriodicEvent: intersects the next week single event
+	// 04.02.2003 (TUE) 12:00 - 13:00 weekly
+	{
+		err := targetSvc.UpdatePeriodicEvent(ctx, periodicId, 2, schema.SingleEventTypeAvailable, time.Date(2003, 2, 4, 12, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check the resulting green events
+	{
+		greenEvents, redEvents, err := targetSvc.getGreenRedEvents(ctx, schema.DefaultChargePointId, time.Date(2003, 2, 3, 0, 0, 0, 0, time.UTC), time.Date(2003, 2, 12, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+
+		require.Len(t, greenEvents, 5)
+		require.Len(t, redEvents, 0)
+
+		checkEventTimeRange(t,
+			2003, 2, 3, 10, 0,
+			2003, 2, 3, 12, 30,
+			greenEvents[0],
+		)
+		checkEventTimeRange(t,
+			2003, 2, 3, 13, 0,
+			2003, 2, 3, 15, 0,
+			greenEvents[1],
+		)
+		checkEventTimeRange(t,
+			2003, 2, 4, 10, 0,
+			2003, 2, 4, 12, 0,
+			greenEvents[2],
+		)
+		checkEventTimeRange(t,
+			2003, 2, 11, 10, 0,
+			2003, 2, 11, 12, 0,
+			greenEvents[3],
+		)
+		checkEventTimeRange(t,
+			2003, 2, 11, 12, 30,
+			2003, 2, 11, 13, 0,
+			greenEvents[4],
+		)
+	}
+
+	// ok: UpdateSingleEvent: type change
+	{
+		require.NoError(t, targetSvc.UpdateSingleEvent(ctx, singleId, 2, schema.SingleEventTypeOccupied, time.Date(2003, 2, 3, 10, 0, 0, 0, time.UTC), 2*time.Hour+30*time.Minute))
+
+		event, err := s.r.StorageRes.Storage.GetSingleEvent(ctx, singleId)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Equal(t, schema.SingleEventTypeOccupied, event.Type)
+		require.EqualValues(t, 3, event.Version)
+	}
+
+	// fail: stale / missing version (the event was changed since it was read)
+	{
+		err := targetSvc.UpdateSingleEvent(ctx, singleId, 2, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 10, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		err = targetSvc.DeleteSingleEvent(ctx, singleId, 2)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		err = targetSvc.UpdatePeriodicEvent(ctx, periodicId, 1, schema.SingleEventTypeAvailable, time.Date(2003, 2, 4, 10, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		err = targetSvc.DeletePeriodicEvent(ctx, periodicId, 1)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		err = targetSvc.UpdateSingleEvent(ctx, singleId, 0, schema.SingleEventTypeAvailable, time.Date(2003, 2, 3, 10, 0, 0, 0, time.UTC), time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		event, err := s.r.StorageRes.Storage.GetSingleEvent(ctx, singleId)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Equal(t, schema.SingleEventTypeOccupied, event.Type)
+	}
+}
+
+func (s *ServiceTestSuite) Test_UpdatePeriodicEvent_KeptOverrides() {
+	t := s.T()
+	ctx := s.ctx
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(ctx))
+
+	warnSvc, strictSvc := *targetSvc, *targetSvc
+	warnSvc.occupancyPolicy = OccupancyPolicyWarn
+	strictSvc.occupancyPolicy = OccupancyPolicyStrict
+
+	// Init fixtures
+	// 04.08.2014 (MON) 08:00 - 12:00 weekly available
+	// 04.08.2014 (MON) 09:00 - 10:00 weekly occupied, 11.08.2014 occurrence is moved to 13.08.2014 (WED) 10:00 - 11:00 (not covered)
+	var periodicId int64
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 8, 0, 0, 0, time.UTC), 4*time.Hour))
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), time.Hour))
+
+		_, pEvents, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 4, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 5, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		for _, pEvent := range pEvents {
+			if pEvent.Type == schema.SingleEventTypeOccupied {
+				periodicId = pEvent.Id
+			}
+		}
+		require.NotZero(t, periodicId)
+
+		_, err = targetSvc.AddPeriodicEventOverride(ctx, periodicId, 1, time.Date(2014, 8, 11, 9, 0, 0, 0, time.UTC), time.Date(2014, 8, 13, 10, 0, 0, 0, time.UTC), time.Hour)
+		require.NoError(t, err)
+	}
+
+	// fail: strict, the kept moved occurrence is not covered
+	{
+		err := strictSvc.UpdatePeriodicEventWithRule(ctx, periodicId, 2, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), "FREQ=WEEKLY;COUNT=10", time.Hour)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: warn, updated with warnings
+	{
+		var warnings common.Warnings
+		require.NoError(t, warnSvc.UpdatePeriodicEventWithRule(common.WithWarnings(ctx, &warnings), periodicId, 2, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), "FREQ=WEEKLY;COUNT=10", time.Hour))
+		require.Len(t, warnings, 1)
+	}
+
+	// fail: the kept moved occurrence intersects the new rule occurrence
+	// 13.08.2014 (WED) 09:00 - 10:30
+	{
+		err := targetSvc.UpdatePeriodicEventWithRule(ctx, periodicId, 3, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", time.Hour+30*time.Minute)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: the kept moved occurrence touches the new rule occurrence
+	// 13.08.2014 (WED) 09:00 - 10:00
+	{
+		require.NoError(t, targetSvc.UpdatePeriodicEventWithRule(ctx, periodicId, 3, schema.SingleEventTypeOccupied, time.Date(2014, 8, 4, 9, 0, 0, 0, time.UTC), "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", time.Hour))
+
+		event, err := s.r.StorageRes.Storage.GetPeriodicEvent(ctx, periodicId)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Len(t, event.Exceptions, 1)
+	}
+}
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// eventsWatcher fans out committed events changes to subscribers.
+type eventsWatcher struct {
+	lock      sync.Mutex
+	lastSubId uint64
+	subs      map[uint64]*eventsSubscription
+}
+
+type eventsSubscription struct {
+	chargePointId int64
+	rangeStart    time.Time
+	rangeEnd      time.Time
+	notifyCh      chan struct{}
+}
+
+func (svc Scheduler) WatchEvents(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (<-chan struct{}, error) {
+	// Input checks
+	if rangeStart.IsZero() {
+		return nil, fmt.Errorf("%s: zero: %w", "rangeStart", common.ErrInvalidInput)
+	}
+	if !rangeEnd.After(rangeStart) {
+		return nil, fmt.Errorf("%s: must be GT rangeStart: %w", "rangeEnd", common.ErrInvalidInput)
+	}
+	if _, err := svc.getChargePoint(ctx, chargePointId); err != nil {
+		return nil, err
+	}
+
+	sub := &eventsSubscription{
+		chargePointId: chargePointId,
+		rangeStart:    rangeStart,
+		rangeEnd:      rangeEnd,
+		notifyCh:      make(chan struct{}, 1),
+	}
+	subId := svc.watcher.subscribe(sub)
+
+	go func() {
+		<-ctx.Done()
+		svc.watcher.unsubscribe(subId)
+	}()
+
+	return sub.notifyCh, nil
+}
+
+// notifyEventsChanged invalidates the occurrences cache and notifies subscribers about committed changes
+// (must be called after the transaction is committed).
+func (svc Scheduler) notifyEventsChanged(changes ...schema.EventsChange) {
+	svc.invalidateOccurrenceCache(changes)
+	svc.watcher.notify(changes)
+}
+
+func (w *eventsWatcher) subscribe(sub *eventsSubscription) uint64 {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.lastSubId++
+	w.subs[w.lastSubId] = sub
+
+	return w.lastSubId
+}
+
+func (w *eventsWatcher) unsubscribe(subId uint64) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if sub, found := w.subs[subId]; found {
+		delete(w.subs, subId)
+		close(sub.notifyCh)
+	}
+}
+
+func (w *eventsWatcher) notify(changes []schema.EventsChange) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for _, sub := range w.subs {
+		for _, change := range changes {
+			if change.ChargePointId != sub.chargePointId || !change.Intersects(sub.rangeStart, sub.rangeEnd) {
+				continue
+			}
+
+			// Non-blocking: a pending notification already covers this change
+			select {
+			case sub.notifyCh <- struct{}{}:
+			default:
+			}
+			break
+		}
+	}
+}
+
+// newSingleEventChange returns the schema.SingleEvent change.
+func newSingleEventChange(obj schema.SingleEvent) schema.EventsChange {
+	return schema.EventsChange{
+		ChargePointId: obj.ChargePointId,
+		RangeStart:    obj.StartDateTime,
+		RangeEnd:      obj.EndDateTime(),
+	}
+}
+
+// newPeriodicEventChange returns the schema.PeriodicEvent change (the whole rule lifetime, endless for endless rules).
+func newPeriodicEventChange(obj schema.PeriodicEvent) schema.EventsChange {
+	rule := obj.Rrule
+	change := schema.EventsChange{
+		ChargePointId: obj.ChargePointId,
+		Periodic:      true,
+		RangeStart:    rule.OrigOptions.Dtstart,
+	}
+	if isFiniteRule(&rule) {
+		_, change.RangeEnd = getPeriodicCheckRange(&rule, getPeriodicEventMaxDuration(obj))
+	}
+
+	// Moved occurrences might be out of the rule range
+	for _, exception := range obj.Exceptions {
+		if !exception.IsOverride() {
+			continue
+		}
+		if exception.OverrideStart.Before(change.RangeStart) {
+			change.RangeStart = *exception.OverrideStart
+		}
+		if overrideEnd := exception.OverrideStart.Add(exception.OverrideDuration); !change.RangeEnd.IsZero() && overrideEnd.After(change.RangeEnd) {
+			change.RangeEnd = overrideEnd
+		}
+	}
+
+	return change
+}
+
+// newOccurrenceChange returns a single periodic event occurrence change.
+func newOccurrenceChange(obj schema.PeriodicEvent, occurrenceStart time.Time, occurrenceDur time.Duration) schema.EventsChange {
+	return schema.EventsChange{
+		ChargePointId: obj.ChargePointId,
+		Periodic:      true,
+		RangeStart:    occurrenceStart,
+		RangeEnd:      occurrenceStart.Add(occurrenceDur),
+	}
+}
+
+func newEventsWatcher() *eventsWatcher {
+	return &eventsWatcher{
+		subs: make(map[uint64]*eventsSubscription),
+	}
+}
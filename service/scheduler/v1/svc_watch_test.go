@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ServiceTestSuite) Test_WatchEvents() {
+	t := s.T()
+	targetSvc := s.r.Svc.(*Scheduler)
+	require.NoError(t, s.r.StorageRes.Storage.DropData(s.ctx))
+
+	ctx, ctxCancel := context.WithCancel(s.ctx)
+	defer ctxCancel()
+
+	requireNotified := func(notifyCh <-chan struct{}) {
+		select {
+		case _, ok := <-notifyCh:
+			require.True(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("no notification")
+		}
+	}
+	requireNotNotified := func(notifyCh <-chan struct{}) {
+		select {
+		case <-notifyCh:
+			t.Fatal("unexpected notification")
+		default:
+		}
+	}
+
+	// fail: wrong inputs
+	{
+		rangeStart := time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC)
+
+		_, err := targetSvc.WatchEvents(ctx, schema.DefaultChargePointId, time.Time{}, rangeStart)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.WatchEvents(ctx, schema.DefaultChargePointId, rangeStart, rangeStart)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.WatchEvents(ctx, 1000, rangeStart, rangeStart.Add(dayDur))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// 11.08.2014 (MON) window
+	notifyCh, err := targetSvc.WatchEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// ok: weekly periodic event touches the window
+	{
+		require.NoError(t, targetSvc.AddPeriodicEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeAvailable, time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC), 4*time.Hour))
+		requireNotified(notifyCh)
+	}
+
+	// ok: single events outside the window / for other charge points are ignored
+	{
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 12, 10, 0, 0, 0, time.UTC), time.Hour))
+
+		cpId, err := targetSvc.CreateChargePoint(ctx, "CP-WATCH", "", 1, "", 0)
+		require.NoError(t, err)
+		require.NoError(t, targetSvc.AddSingleEvent(ctx, cpId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour))
+
+		requireNotNotified(notifyCh)
+	}
+
+	// ok: booking and its cancellation touch the window, notifications are coalesced
+	{
+		bookingId, err := targetSvc.BookSlot(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC), time.Hour, "driver-42")
+		require.NoError(t, err)
+		require.NoError(t, targetSvc.DeleteSingleEvent(ctx, bookingId, 1))
+
+		requireNotified(notifyCh)
+		requireNotNotified(notifyCh)
+	}
+
+	// ok: failed operations don't notify
+	{
+		require.Error(t, targetSvc.AddSingleEvent(ctx, schema.DefaultChargePointId, schema.SingleEventTypeOccupied, time.Date(2014, 8, 12, 10, 30, 0, 0, time.UTC), time.Hour))
+		requireNotNotified(notifyCh)
+	}
+
+	// ok: single event moved into the window
+	{
+		sEvents, _, err := targetSvc.GetEvents(ctx, schema.DefaultChargePointId, time.Date(2014, 8, 12, 0, 0, 0, 0, time.UTC), time.Date(2014, 8, 13, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 1)
+
+		require.NoError(t, targetSvc.UpdateSingleEvent(ctx, sEvents[0].Id, sEvents[0].Version, schema.SingleEventTypeOccupied, time.Date(2014, 8, 11, 12, 0, 0, 0, time.UTC), time.Hour))
+		requireNotified(notifyCh)
+	}
+
+	// ok: the channel is closed once ctx is done
+	{
+		ctxCancel()
+		select {
+		case _, ok := <-notifyCh:
+			require.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel is not closed")
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/itiky/charge_scheduler/service/scheduler/testutil"
+	cpSt "github.com/itiky/charge_scheduler/storage/chargepoints/sqlite"
 	eventsSt "github.com/itiky/charge_scheduler/storage/events/sqlite"
 	"github.com/itiky/charge_scheduler/storage/sqlite_base"
 )
@@ -16,13 +17,19 @@ func NewTestResource(baseSt *sqlite_base.SQLiteBase) (*testutil.SchedulerService
 		return nil, fmt.Errorf("eventsSt.NewTestResource: %w", err)
 	}
 
-	schedulerSvc, err := NewScheduler(zerolog.Nop(), stRes.Storage)
+	cpStRes, err := cpSt.NewTestResource(baseSt)
+	if err != nil {
+		return nil, fmt.Errorf("cpSt.NewTestResource: %w", err)
+	}
+
+	schedulerSvc, err := NewScheduler(zerolog.Nop(), stRes.Storage, cpStRes.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("NewScheduler: %w", err)
 	}
 
 	return &testutil.SchedulerServiceTestResource{
-		Svc:        schedulerSvc,
-		StorageRes: stRes,
+		Svc:                    schedulerSvc,
+		StorageRes:             stRes,
+		ChargePointsStorageRes: cpStRes,
 	}, nil
 }
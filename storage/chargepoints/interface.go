@@ -0,0 +1,17 @@
+package chargepoints
+
+import (
+	"context"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// ChargePointsStorage provides charge points repository operations.
+type ChargePointsStorage interface {
+	// CreateChargePoint creates a new schema.ChargePoint object and returns its ID.
+	CreateChargePoint(ctx context.Context, obj schema.ChargePoint) (int64, error)
+	// GetChargePoint gets a schema.ChargePoint by ID (if exists).
+	GetChargePoint(ctx context.Context, id int64) (*schema.ChargePoint, error)
+	// GetAllChargePoints gets all schema.ChargePoint objects.
+	GetAllChargePoints(ctx context.Context) ([]schema.ChargePoint, error)
+}
@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/chargepoints"
+)
+
+var _ chargepoints.ChargePointsStorage = (*ChargePointsStorage)(nil)
+
+// ChargePointsStorage is a pure-Go in-memory chargepoints.ChargePointsStorage (data is lost on exit).
+// Storage is concurrency-safe and is created with the default charge point (as the SQLite migration does).
+type ChargePointsStorage struct {
+	lock   sync.RWMutex
+	lastId int64
+	points map[int64]schema.ChargePoint
+}
+
+func NewChargePointsStorage() *ChargePointsStorage {
+	return &ChargePointsStorage{
+		lastId: schema.DefaultChargePointId,
+		points: map[int64]schema.ChargePoint{
+			schema.DefaultChargePointId: {
+				Id:              schema.DefaultChargePointId,
+				Name:            "default",
+				ConnectorsCount: 1,
+				TimeZone:        schema.DefaultTimeZone,
+				CreatedAt:       time.Now().UTC(),
+			},
+		},
+	}
+}
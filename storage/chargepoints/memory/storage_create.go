@@ -0,0 +1,20 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ChargePointsStorage) CreateChargePoint(ctx context.Context, obj schema.ChargePoint) (retId int64, retErr error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastId++
+	obj.Id = s.lastId
+	obj.CreatedAt = obj.CreatedAt.UTC()
+	s.points[obj.Id] = obj
+	retId = obj.Id
+
+	return
+}
@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ChargePointsStorage) GetChargePoint(ctx context.Context, id int64) (retObj *schema.ChargePoint, retErr error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	obj, found := s.points[id]
+	if !found {
+		return
+	}
+	retObj = &obj
+
+	return
+}
+
+func (s *ChargePointsStorage) GetAllChargePoints(ctx context.Context) (retObjs []schema.ChargePoint, retErr error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	retObjs = make([]schema.ChargePoint, 0, len(s.points))
+	for _, obj := range s.points {
+		retObjs = append(retObjs, obj)
+	}
+	sort.Slice(retObjs, func(i, j int) bool { return retObjs[i].Id < retObjs[j].Id })
+
+	return
+}
@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/storage/chargepoints/testutil"
+)
+
+func TestSuite_ChargePointsStorage(t *testing.T) {
+	suite.Run(t, testutil.NewChargePointsStorageTestSuite(func(t *testing.T) (*testutil.ChargePointsStorageTestResource, error) {
+		return NewTestResource()
+	}))
+}
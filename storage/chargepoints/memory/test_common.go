@@ -0,0 +1,11 @@
+package memory
+
+import (
+	"github.com/itiky/charge_scheduler/storage/chargepoints/testutil"
+)
+
+func NewTestResource() (*testutil.ChargePointsStorageTestResource, error) {
+	return &testutil.ChargePointsStorageTestResource{
+		Storage: NewChargePointsStorage(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/itiky/charge_scheduler/storage/chargepoints"
+	"github.com/itiky/charge_scheduler/storage/postgres_base"
+)
+
+var _ chargepoints.ChargePointsStorage = (*ChargePointsStorage)(nil)
+
+type ChargePointsStorage struct {
+	*postgres_base.PostgresBase
+	logger zerolog.Logger
+}
+
+func NewChargePointsStorage(base *postgres_base.PostgresBase) (*ChargePointsStorage, error) {
+	if base == nil {
+		return nil, fmt.Errorf("%s: nil", "base")
+	}
+
+	storage := &ChargePointsStorage{
+		PostgresBase: base,
+		logger:       base.Logger.With().Str("repository", "chargePoints").Logger(),
+	}
+
+	return storage, nil
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s ChargePointsStorage) CreateChargePoint(ctx context.Context, obj schema.ChargePoint) (retId int64, retErr error) {
+	dbObj, err := newChargePoint(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, "INSERT INTO charge_points (name, site, connectors_count, time_zone, max_power_kw, created_at) VALUES (:name, :site, :connectors_count, :time_zone, :max_power_kw, :created_at) RETURNING id", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("sqlx.BindNamed: %w", err)
+		return
+	}
+
+	if err := s.Db.GetContext(ctx, &retId, query, args...); err != nil {
+		retErr = fmt.Errorf("s.Db.GetContext: %w", err)
+		return
+	}
+
+	return
+}
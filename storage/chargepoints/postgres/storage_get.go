@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s ChargePointsStorage) GetChargePoint(ctx context.Context, id int64) (retObj *schema.ChargePoint, retErr error) {
+	dbObj := chargePoint{}
+	err := s.Db.GetContext(ctx, &dbObj, "SELECT id, name, site, connectors_count, time_zone, max_power_kw, created_at FROM charge_points WHERE id=$1", id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.Db.GetContext: %w", err)
+		return
+	}
+
+	obj, err := dbObj.ToSchema()
+	if err != nil {
+		retErr = fmt.Errorf("obj unmarshal: %w", err)
+		return
+	}
+	retObj = &obj
+
+	return
+}
+
+func (s ChargePointsStorage) GetAllChargePoints(ctx context.Context) (retObjs []schema.ChargePoint, retErr error) {
+	var dbObjs []chargePoint
+	err := s.Db.SelectContext(ctx, &dbObjs, "SELECT id, name, site, connectors_count, time_zone, max_power_kw, created_at FROM charge_points ORDER BY id")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.Db.SelectContext: %w", err)
+		return
+	}
+
+	retObjs = make([]schema.ChargePoint, 0, len(dbObjs))
+	for i, dbObj := range dbObjs {
+		obj, err := dbObj.ToSchema()
+		if err != nil {
+			retErr = fmt.Errorf("dbObj[%d] unmarshal: %w", i, err)
+			return
+		}
+		retObjs = append(retObjs, obj)
+	}
+
+	return
+}
@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+type chargePoint struct {
+	Id              int64     `db:"rowid"`
+	Name            string    `db:"name"`
+	Site            string    `db:"site"`
+	ConnectorsCount uint      `db:"connectors_count"`
+	TimeZone        string    `db:"time_zone"`
+	MaxPowerKW      float64   `db:"max_power_kw"`
+	CreatedAt       time.Time `db:"created_at"`
+}
+
+func (p chargePoint) ToSchema() (schema.ChargePoint, error) {
+	return schema.ChargePoint{
+		Id:              p.Id,
+		Name:            p.Name,
+		Site:            p.Site,
+		ConnectorsCount: p.ConnectorsCount,
+		TimeZone:        p.TimeZone,
+		MaxPowerKW:      p.MaxPowerKW,
+		CreatedAt:       p.CreatedAt,
+	}, nil
+}
+
+func newChargePoint(obj schema.ChargePoint) (chargePoint, error) {
+	return chargePoint{
+		Name:            obj.Name,
+		Site:            obj.Site,
+		ConnectorsCount: obj.ConnectorsCount,
+		TimeZone:        obj.TimeZone,
+		MaxPowerKW:      obj.MaxPowerKW,
+		CreatedAt:       obj.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/itiky/charge_scheduler/storage/chargepoints"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+var _ chargepoints.ChargePointsStorage = (*ChargePointsStorage)(nil)
+
+type ChargePointsStorage struct {
+	*sqlite_base.SQLiteBase
+	logger zerolog.Logger
+}
+
+func NewChargePointsStorage(base *sqlite_base.SQLiteBase) (*ChargePointsStorage, error) {
+	if base == nil {
+		return nil, fmt.Errorf("%s: nil", "base")
+	}
+
+	storage := &ChargePointsStorage{
+		SQLiteBase: base,
+		logger:     base.Logger.With().Str("repository", "chargePoints").Logger(),
+	}
+
+	return storage, nil
+}
@@ -0,0 +1,32 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s ChargePointsStorage) CreateChargePoint(ctx context.Context, obj schema.ChargePoint) (retId int64, retErr error) {
+	dbObj, err := newChargePoint(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	res, err := s.Db.NamedExecContext(ctx, "INSERT INTO charge_points (name, site, connectors_count, time_zone, max_power_kw, created_at) VALUES (:name, :site, :connectors_count, :time_zone, :max_power_kw, :created_at)", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("s.Db.NamedExecContext: %w", err)
+		return
+	}
+
+	resId, err := res.LastInsertId()
+	if err != nil {
+		retErr = fmt.Errorf("res.LastInsertId(): %w", err)
+		return
+	}
+	retId = resId
+
+	return
+}
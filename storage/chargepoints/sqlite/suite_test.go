@@ -0,0 +1,24 @@
+package sqlite
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/storage/chargepoints/testutil"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+// nolint:errcheck
+func TestSuite_ChargePointsStorage(t *testing.T) {
+	suite.Run(t, testutil.NewChargePointsStorageTestSuite(func(t *testing.T) (*testutil.ChargePointsStorageTestResource, error) {
+		baseSt, err := sqlite_base.SetupTempSQLiteBase(t.TempDir())
+		if err != nil {
+			return nil, fmt.Errorf("base storage init: %w", err)
+		}
+		t.Cleanup(func() { baseSt.Close() })
+
+		return NewTestResource(baseSt)
+	}))
+}
@@ -0,0 +1,19 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/storage/chargepoints/testutil"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+func NewTestResource(baseSt *sqlite_base.SQLiteBase) (*testutil.ChargePointsStorageTestResource, error) {
+	st, err := NewChargePointsStorage(baseSt)
+	if err != nil {
+		return nil, fmt.Errorf("NewChargePointsStorage: %w", err)
+	}
+
+	return &testutil.ChargePointsStorageTestResource{
+		Storage: st,
+	}, nil
+}
@@ -0,0 +1,7 @@
+package testutil
+
+import "github.com/itiky/charge_scheduler/storage/chargepoints"
+
+type ChargePointsStorageTestResource struct {
+	Storage chargepoints.ChargePointsStorage
+}
@@ -0,0 +1,36 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ChargePointsStorageTestSuite is the chargepoints.ChargePointsStorage conformance test suite shared by the storage implementations.
+// Storage must be a fresh one (having only the default charge point).
+type ChargePointsStorageTestSuite struct {
+	suite.Suite
+	ctx         context.Context
+	r           *ChargePointsStorageTestResource
+	newResource func(t *testing.T) (*ChargePointsStorageTestResource, error)
+}
+
+func (s *ChargePointsStorageTestSuite) SetupSuite() {
+	r, err := s.newResource(s.T())
+	if err != nil {
+		panic(fmt.Errorf("resource init: %w", err))
+	}
+
+	s.ctx = context.TODO()
+	s.r = r
+}
+
+// NewChargePointsStorageTestSuite creates the suite for the storage built by newResource (called once per suite run,
+// the storage should be closed with t.Cleanup).
+func NewChargePointsStorageTestSuite(newResource func(t *testing.T) (*ChargePointsStorageTestResource, error)) *ChargePointsStorageTestSuite {
+	return &ChargePointsStorageTestSuite{
+		newResource: newResource,
+	}
+}
@@ -0,0 +1,75 @@
+package testutil
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *ChargePointsStorageTestSuite) Test_ChargePoint() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := time.Now().UTC()
+	points := []schema.ChargePoint{
+		{
+			Id:              2,
+			Name:            "CP-01",
+			Site:            "Berlin Mitte",
+			ConnectorsCount: 2,
+			TimeZone:        "Europe/Berlin",
+			MaxPowerKW:      22,
+			CreatedAt:       now,
+		},
+		{
+			Id:              3,
+			Name:            "CP-02",
+			Site:            "Berlin Mitte",
+			ConnectorsCount: 1,
+			TimeZone:        schema.DefaultTimeZone,
+			CreatedAt:       now,
+		},
+	}
+
+	// ok: GetChargePoint: default (created by migration)
+	{
+		res, err := targetSt.GetChargePoint(ctx, schema.DefaultChargePointId)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, schema.DefaultChargePointId, res.Id)
+		require.Equal(t, schema.DefaultTimeZone, res.TimeZone)
+	}
+
+	// ok: GetChargePoint: non-existing
+	{
+		res, err := targetSt.GetChargePoint(ctx, 2)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+
+	// ok: CreateChargePoint / GetChargePoint
+	{
+		for _, point := range points {
+			id, err := targetSt.CreateChargePoint(ctx, point)
+			require.NoError(t, err)
+			require.Equal(t, point.Id, id)
+
+			res, err := targetSt.GetChargePoint(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, point, *res)
+		}
+	}
+
+	// ok: GetAllChargePoints
+	{
+		res, err := targetSt.GetAllChargePoints(ctx)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+		require.Equal(t, points, res[1:])
+	}
+}
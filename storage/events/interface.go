@@ -8,19 +8,66 @@ import (
 )
 
 // EventsStorage provides events repository operations.
+// Soft-deleted events are not found / listed / updated unless stated otherwise.
 type EventsStorage interface {
 	// CreateSingleEvent creates a new schema.SingleEvent object and returns its ID.
 	CreateSingleEvent(ctx context.Context, obj schema.SingleEvent) (int64, error)
 	// CreatePeriodicEvent creates a new schema.PeriodicEvent object and returns its ID.
 	CreatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) (int64, error)
+	// UpdateSingleEvent updates an existing schema.SingleEvent object (common.ErrNotFound if not exists) incrementing its version.
+	// obj.Version is the expected stored one (common.ErrVersionConflict if it was changed).
+	UpdateSingleEvent(ctx context.Context, obj schema.SingleEvent) error
+	// UpdatePeriodicEvent updates an existing schema.PeriodicEvent object (common.ErrNotFound if not exists) incrementing its version.
+	// obj.Version is the expected stored one (common.ErrVersionConflict if it was changed).
+	UpdatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) error
+	// DeleteSingleEvent soft-deletes a schema.SingleEvent by ID setting its deletedAt (common.ErrNotFound if not exists).
+	DeleteSingleEvent(ctx context.Context, id int64, deletedAt time.Time) error
+	// DeletePeriodicEvent soft-deletes a schema.PeriodicEvent by ID setting its deletedAt (common.ErrNotFound if not exists),
+	// its exceptions are kept for the restore.
+	DeletePeriodicEvent(ctx context.Context, id int64, deletedAt time.Time) error
+	// RestoreSingleEvent restores a soft-deleted schema.SingleEvent by ID (common.ErrNotFound if not exists or not deleted).
+	RestoreSingleEvent(ctx context.Context, id int64) error
+	// RestorePeriodicEvent restores a soft-deleted schema.PeriodicEvent by ID (common.ErrNotFound if not exists or not deleted).
+	RestorePeriodicEvent(ctx context.Context, id int64) error
+	// PurgeDeletedEvents removes single / periodic events (with exceptions) soft-deleted before deletedBefore
+	// and returns the number of removed events.
+	PurgeDeletedEvents(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// GetSingleEventsDeletedBefore gets schema.SingleEvent objects soft-deleted before deletedBefore (sorted by ID).
+	GetSingleEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]schema.SingleEvent, error)
+	// GetPeriodicEventsDeletedBefore gets schema.PeriodicEvent objects (with exceptions) soft-deleted before deletedBefore (sorted by ID).
+	GetPeriodicEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) ([]schema.PeriodicEvent, error)
+	// CreatePeriodicEventException creates a new schema.PeriodicEventException object and returns its ID.
+	CreatePeriodicEventException(ctx context.Context, obj schema.PeriodicEventException) (int64, error)
+	// DeletePeriodicEventException removes a schema.PeriodicEventException by ID (common.ErrNotFound if not exists).
+	DeletePeriodicEventException(ctx context.Context, id int64) error
+	// GetPeriodicEventException gets a schema.PeriodicEventException by ID (if exists).
+	GetPeriodicEventException(ctx context.Context, id int64) (*schema.PeriodicEventException, error)
 	// GetSingleEvent gets a schema.SingleEvent by ID (if exists).
 	GetSingleEvent(ctx context.Context, id int64) (*schema.SingleEvent, error)
-	// GetPeriodicEvent gets a schema.PeriodicEvent by ID (if exists).
+	// GetPeriodicEvent gets a schema.PeriodicEvent with its exceptions by ID (if exists).
 	GetPeriodicEvent(ctx context.Context, id int64) (*schema.PeriodicEvent, error)
-	// GetSingleEventsWithinRange gets a schema.SingleEvent list filtered by eventStart time range.
-	GetSingleEventsWithinRange(ctx context.Context, rangeStart, rangeEnd time.Time) ([]schema.SingleEvent, error)
-	// GetAllPeriodicEvents gets all schema.PeriodicEvent objects.
-	GetAllPeriodicEvents(ctx context.Context) ([]schema.PeriodicEvent, error)
+	// GetSingleEventsWithinRange gets a charge point schema.SingleEvent list filtered by eventStart time range
+	// (soft-deleted events are included if includeDeleted is set).
+	GetSingleEventsWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) ([]schema.SingleEvent, error)
+	// GetSingleEventsIntersectingRange gets a charge point schema.SingleEvent list overlapping the [rangeStart, rangeEnd) time range
+	// (events touching the range are not included), sorted by the start (soft-deleted events are included if includeDeleted is set).
+	GetSingleEventsIntersectingRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) ([]schema.SingleEvent, error)
+	// GetAllPeriodicEvents gets all charge point schema.PeriodicEvent objects with their exceptions
+	// (soft-deleted events are included if includeDeleted is set).
+	GetAllPeriodicEvents(ctx context.Context, chargePointId int64, includeDeleted bool) ([]schema.PeriodicEvent, error)
+	// GetPeriodicEventsActiveWithin gets charge point schema.PeriodicEvent objects with their exceptions which occurrences
+	// might overlap or touch the [rangeStart, rangeEnd] time range (see schema.PeriodicEvent.ActiveRange).
+	GetPeriodicEventsActiveWithin(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) ([]schema.PeriodicEvent, error)
+	// CreateEventHistoryEntry appends a new schema.EventHistoryEntry and returns its ID (entries are never updated or removed).
+	CreateEventHistoryEntry(ctx context.Context, obj schema.EventHistoryEntry) (int64, error)
+	// GetEventHistory gets the single / periodic event schema.EventHistoryEntry list sorted by ID (the changes order).
+	GetEventHistory(ctx context.Context, eventId int64, periodic bool) ([]schema.EventHistoryEntry, error)
+	// GetEventHistoryWithinRange gets a charge point schema.EventHistoryEntry list filtered by the [rangeStart, rangeEnd]
+	// createdAt time range, sorted by ID.
+	GetEventHistoryWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) ([]schema.EventHistoryEntry, error)
+	// RunInTx executes fn within a single transaction passing the transaction bound storage to it.
+	// Transaction is rolled back if fn fails, fn error is returned "as is"; nested calls share the outer transaction.
+	RunInTx(ctx context.Context, fn func(txSt EventsStorage) error) error
 	// DropData removes all storage data (for debug purposes only)
 	DropData(ctx context.Context) error
 }
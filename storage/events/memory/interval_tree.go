@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"math/rand"
+	"time"
+)
+
+// intervalTree is an interval index: a treap ordered by (start, id) with the subtree max end augmentation.
+// Insert / delete are O(log n) (expected), overlap queries are O(log n + k). Not concurrency-safe.
+type intervalTree struct {
+	root *intervalNode
+	rnd  *rand.Rand
+	size int
+}
+
+type intervalNode struct {
+	id          int64
+	start, end  time.Time
+	maxEnd      time.Time
+	priority    int64
+	left, right *intervalNode
+}
+
+// Insert adds the [start, end] interval (start / id pair must be unique).
+func (t *intervalTree) Insert(id int64, start, end time.Time) {
+	node := &intervalNode{
+		id:       id,
+		start:    start,
+		end:      end,
+		maxEnd:   end,
+		priority: t.rnd.Int63(),
+	}
+	t.root = t.insert(t.root, node)
+	t.size++
+}
+
+// Delete removes the interval by its start / id pair (if exists).
+func (t *intervalTree) Delete(id int64, start time.Time) {
+	var found bool
+	t.root, found = t.delete(t.root, id, start)
+	if found {
+		t.size--
+	}
+}
+
+// Len returns the intervals count.
+func (t *intervalTree) Len() int {
+	return t.size
+}
+
+// Overlapping calls fn for intervals overlapping the [rangeStart, rangeEnd) range (start < rangeEnd AND end > rangeStart)
+// or touching it as well if inclusive is set (start <= rangeEnd AND end >= rangeStart), sorted by start.
+func (t *intervalTree) Overlapping(rangeStart, rangeEnd time.Time, inclusive bool, fn func(id int64)) {
+	endOk := func(end time.Time) bool {
+		return end.After(rangeStart) || (inclusive && end.Equal(rangeStart))
+	}
+	startOk := func(start time.Time) bool {
+		return start.Before(rangeEnd) || (inclusive && start.Equal(rangeEnd))
+	}
+
+	var walk func(node *intervalNode)
+	walk = func(node *intervalNode) {
+		// Subtree has no intervals ending within the range
+		if node == nil || !endOk(node.maxEnd) {
+			return
+		}
+
+		walk(node.left)
+		// Right subtree intervals start after the node one
+		if !startOk(node.start) {
+			return
+		}
+		if endOk(node.end) {
+			fn(node.id)
+		}
+		walk(node.right)
+	}
+	walk(t.root)
+}
+
+// StartingWithin calls fn for intervals starting within the [rangeStart, rangeEnd] range sorted by start.
+func (t *intervalTree) StartingWithin(rangeStart, rangeEnd time.Time, fn func(id int64)) {
+	var walk func(node *intervalNode)
+	walk = func(node *intervalNode) {
+		if node == nil {
+			return
+		}
+
+		if !node.start.Before(rangeStart) {
+			walk(node.left)
+		}
+		if !node.start.Before(rangeStart) && !node.start.After(rangeEnd) {
+			fn(node.id)
+		}
+		if !node.start.After(rangeEnd) {
+			walk(node.right)
+		}
+	}
+	walk(t.root)
+}
+
+func (t *intervalTree) insert(root, node *intervalNode) *intervalNode {
+	if root == nil {
+		return node
+	}
+
+	if node.less(root.id, root.start) {
+		root.left = t.insert(root.left, node)
+		if root.left.priority > root.priority {
+			root = root.rotateRight()
+		}
+	} else {
+		root.right = t.insert(root.right, node)
+		if root.right.priority > root.priority {
+			root = root.rotateLeft()
+		}
+	}
+	root.update()
+
+	return root
+}
+
+func (t *intervalTree) delete(root *intervalNode, id int64, start time.Time) (*intervalNode, bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	var found bool
+	switch {
+	case root.id == id && root.start.Equal(start):
+		// Rotate the node down until it becomes a leaf
+		switch {
+		case root.left == nil:
+			return root.right, true
+		case root.right == nil:
+			return root.left, true
+		case root.left.priority > root.right.priority:
+			root = root.rotateRight()
+			root.right, found = t.delete(root.right, id, start)
+		default:
+			root = root.rotateLeft()
+			root.left, found = t.delete(root.left, id, start)
+		}
+	case (&intervalNode{id: id, start: start}).less(root.id, root.start):
+		root.left, found = t.delete(root.left, id, start)
+	default:
+		root.right, found = t.delete(root.right, id, start)
+	}
+	root.update()
+
+	return root, found
+}
+
+// less checks if the node key is less than the (id, start) one.
+func (n *intervalNode) less(id int64, start time.Time) bool {
+	if n.start.Equal(start) {
+		return n.id < id
+	}
+
+	return n.start.Before(start)
+}
+
+// update recalculates the subtree max end.
+func (n *intervalNode) update() {
+	n.maxEnd = n.end
+	if n.left != nil && n.left.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.right.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+func (n *intervalNode) rotateRight() *intervalNode {
+	root := n.left
+	n.left, root.right = root.right, n
+	n.update()
+	root.update()
+
+	return root
+}
+
+func (n *intervalNode) rotateLeft() *intervalNode {
+	root := n.right
+	n.right, root.left = root.left, n
+	n.update()
+	root.update()
+
+	return root
+}
+
+func newIntervalTree() *intervalTree {
+	return &intervalTree{
+		rnd: rand.New(rand.NewSource(1)),
+	}
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test_intervalTree compares the tree queries with the brute force ones on random intervals.
+func Test_intervalTree(t *testing.T) {
+	type interval struct {
+		id         int64
+		start, end time.Time
+	}
+
+	rnd := rand.New(rand.NewSource(42))
+	baseTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	randTime := func() time.Time {
+		return baseTime.Add(time.Duration(rnd.Intn(100)) * time.Hour)
+	}
+
+	// bruteForce returns the matching intervals IDs sorted by (start, id)
+	bruteForce := func(intervals map[int64]interval, match func(v interval) bool) []int64 {
+		var matched []interval
+		for _, v := range intervals {
+			if match(v) {
+				matched = append(matched, v)
+			}
+		}
+		sort.Slice(matched, func(i, j int) bool {
+			if !matched[i].start.Equal(matched[j].start) {
+				return matched[i].start.Before(matched[j].start)
+			}
+			return matched[i].id < matched[j].id
+		})
+
+		var ids []int64
+		for _, v := range matched {
+			ids = append(ids, v.id)
+		}
+
+		return ids
+	}
+
+	tree := newIntervalTree()
+	intervals := make(map[int64]interval)
+	for i := int64(1); i <= 1000; i++ {
+		// Init fixtures: insert or delete a random interval
+		if len(intervals) > 0 && rnd.Intn(3) == 0 {
+			for id, v := range intervals {
+				tree.Delete(id, v.start)
+				delete(intervals, id)
+				break
+			}
+		} else {
+			start := randTime()
+			v := interval{id: i, start: start, end: start.Add(time.Duration(rnd.Intn(10)) * time.Hour)}
+			tree.Insert(v.id, v.start, v.end)
+			intervals[v.id] = v
+		}
+		require.Equal(t, len(intervals), tree.Len())
+
+		rangeStart := randTime()
+		rangeEnd := rangeStart.Add(time.Duration(rnd.Intn(20)) * time.Hour)
+
+		// ok: Overlapping (strict / inclusive)
+		for _, inclusive := range []bool{false, true} {
+			expIds := bruteForce(intervals, func(v interval) bool {
+				if inclusive {
+					return !v.start.After(rangeEnd) && !v.end.Before(rangeStart)
+				}
+				return v.start.Before(rangeEnd) && v.end.After(rangeStart)
+			})
+
+			var ids []int64
+			tree.Overlapping(rangeStart, rangeEnd, inclusive, func(id int64) { ids = append(ids, id) })
+			require.Equal(t, expIds, ids, "step %d: inclusive %v", i, inclusive)
+		}
+
+		// ok: StartingWithin
+		{
+			expIds := bruteForce(intervals, func(v interval) bool {
+				return !v.start.Before(rangeStart) && !v.start.After(rangeEnd)
+			})
+
+			var ids []int64
+			tree.StartingWithin(rangeStart, rangeEnd, func(id int64) { ids = append(ids, id) })
+			require.Equal(t, expIds, ids, "step %d", i)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// maxTime is the endless periodic event active range end.
+var maxTime = time.Unix(1<<62, 0).UTC()
+
+// Objects are stored with UTC timestamps (as the SQLite storage returns them), RRule is stored as a string,
+// so the returned objects don't share any state with the stored ones.
+type singleEvent struct {
+	schema.SingleEvent
+}
+
+func (e singleEvent) ToSchema() schema.SingleEvent {
+	obj := e.SingleEvent
+	obj.DeletedAt = copyTimePtr(obj.DeletedAt)
+
+	return obj
+}
+
+func newSingleEvent(obj schema.SingleEvent) (singleEvent, error) {
+	if !obj.Type.IsValid() {
+		return singleEvent{}, fmt.Errorf("%s: invalid", "type")
+	}
+
+	obj.StartDateTime = obj.StartDateTime.UTC()
+	obj.CreatedAt = obj.CreatedAt.UTC()
+	// Events are soft-deleted by the storage only
+	obj.DeletedAt = nil
+
+	return singleEvent{SingleEvent: obj}, nil
+}
+
+type periodicEvent struct {
+	Id            int64
+	ChargePointId int64
+	Type          schema.SingleEventType
+	Rrule         string
+	Duration      time.Duration
+	// ActiveStart / ActiveEnd is the indexed active range (the end is maxTime for endless rules).
+	ActiveStart time.Time
+	ActiveEnd   time.Time
+	CreatedAt   time.Time
+	Version     int64
+	DeletedAt   *time.Time
+}
+
+func (e periodicEvent) ToSchema() (schema.PeriodicEvent, error) {
+	r, err := rrule.StrToRRule(e.Rrule)
+	if err != nil {
+		return schema.PeriodicEvent{}, fmt.Errorf("creating rrule (%s): %w", e.Rrule, err)
+	}
+
+	return schema.PeriodicEvent{
+		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
+		Type:          e.Type,
+		Rrule:         *r,
+		Duration:      e.Duration,
+		CreatedAt:     e.CreatedAt,
+		Version:       e.Version,
+		DeletedAt:     copyTimePtr(e.DeletedAt),
+	}, nil
+}
+
+func newPeriodicEvent(obj schema.PeriodicEvent) (periodicEvent, error) {
+	if !obj.Type.IsValid() {
+		return periodicEvent{}, fmt.Errorf("%s: invalid", "type")
+	}
+
+	dbObj := periodicEvent{
+		ChargePointId: obj.ChargePointId,
+		Type:          obj.Type,
+		Rrule:         obj.Rrule.String(),
+		Duration:      obj.Duration,
+		CreatedAt:     obj.CreatedAt.UTC(),
+		Version:       obj.Version,
+	}
+	dbObj.ActiveStart, dbObj.ActiveEnd = newPeriodicEventActiveRange(obj)
+
+	return dbObj, nil
+}
+
+// newPeriodicEventActiveRange returns the periodic event active range index bounds.
+func newPeriodicEventActiveRange(obj schema.PeriodicEvent) (retStart, retEnd time.Time) {
+	retStart, retEnd = obj.ActiveRange()
+	retStart = retStart.UTC()
+	if retEnd.IsZero() {
+		retEnd = maxTime
+	}
+	retEnd = retEnd.UTC()
+
+	return
+}
+
+type periodicEventException struct {
+	schema.PeriodicEventException
+}
+
+func (e periodicEventException) ToSchema() schema.PeriodicEventException {
+	obj := e.PeriodicEventException
+	if obj.OverrideStart != nil {
+		overrideStart := *obj.OverrideStart
+		obj.OverrideStart = &overrideStart
+	}
+
+	return obj
+}
+
+func newPeriodicEventException(obj schema.PeriodicEventException) periodicEventException {
+	obj.OccurrenceStart = obj.OccurrenceStart.UTC()
+	obj.CreatedAt = obj.CreatedAt.UTC()
+	if obj.OverrideStart != nil {
+		overrideStart := obj.OverrideStart.UTC()
+		obj.OverrideStart = &overrideStart
+	}
+
+	return periodicEventException{PeriodicEventException: obj}
+}
+
+// eventHistoryEntry snapshots are copied, so the stored entry doesn't share them with the caller.
+type eventHistoryEntry struct {
+	schema.EventHistoryEntry
+}
+
+func (e eventHistoryEntry) ToSchema() schema.EventHistoryEntry {
+	obj := e.EventHistoryEntry
+	obj.Before, obj.After = copyRawJSON(obj.Before), copyRawJSON(obj.After)
+
+	return obj
+}
+
+func newEventHistoryEntry(obj schema.EventHistoryEntry) (eventHistoryEntry, error) {
+	if !obj.Operation.IsValid() {
+		return eventHistoryEntry{}, fmt.Errorf("%s: invalid", "operation")
+	}
+
+	obj.Before, obj.After = copyRawJSON(obj.Before), copyRawJSON(obj.After)
+	obj.CreatedAt = obj.CreatedAt.UTC()
+
+	return eventHistoryEntry{EventHistoryEntry: obj}, nil
+}
+
+// eventHistoryKey is the event history index key.
+type eventHistoryKey struct {
+	periodic bool
+	eventId  int64
+}
+
+func copyRawJSON(data json.RawMessage) json.RawMessage {
+	if data == nil {
+		return nil
+	}
+
+	return append(json.RawMessage{}, data...)
+}
+
+func copyTimePtr(ts *time.Time) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	tsCopy := *ts
+
+	return &tsCopy
+}
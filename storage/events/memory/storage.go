@@ -0,0 +1,147 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/itiky/charge_scheduler/storage/events"
+)
+
+var _ events.EventsStorage = (*EventsStorage)(nil)
+
+// EventsStorage is a pure-Go in-memory events.EventsStorage (data is lost on exit).
+// Storage is concurrency-safe: reads share the lock, a transaction holds the write lock until it is finished,
+// so transactions are serialized (as SQLite "BEGIN IMMEDIATE" ones are) and uncommitted changes are not visible outside.
+// Transaction bound storage must not be used after fn returns, the base one must not be used within fn (deadlock).
+type EventsStorage struct {
+	data *storageData
+	// tx is the ongoing transaction undo log (nil outside RunInTx)
+	tx *txLog
+}
+
+// storageData is the storage state shared by the storage and its transaction bound copies.
+type storageData struct {
+	lock   sync.RWMutex
+	tables *eventsTables
+}
+
+// eventsTables are the stored objects with their indexes.
+type eventsTables struct {
+	lastSingleEventId    int64
+	lastPeriodicEventId  int64
+	lastEventExceptionId int64
+	lastEventHistoryId   int64
+
+	singleEvents    map[int64]singleEvent
+	periodicEvents  map[int64]periodicEvent
+	eventExceptions map[int64]periodicEventException
+	// eventHistory is append-only, so entries are ordered by ID
+	eventHistory []eventHistoryEntry
+
+	// singleEventsIdx is the [start, end) index (chargePointId -> tree)
+	singleEventsIdx map[int64]*intervalTree
+	// periodicEventsIdx is the active range index (chargePointId -> tree)
+	periodicEventsIdx map[int64]*intervalTree
+	// periodicEventExceptionsIdx is the periodic event exceptions set (periodicEventId -> exceptions IDs)
+	periodicEventExceptionsIdx map[int64]map[int64]struct{}
+	// eventHistoryIdx is the event history entries index (eventHistory positions)
+	eventHistoryIdx map[eventHistoryKey][]int
+}
+
+// txLog holds the transaction undo operations (applied in the reverse order on rollback).
+type txLog struct {
+	undo []func()
+}
+
+func (s EventsStorage) RunInTx(ctx context.Context, fn func(txSt events.EventsStorage) error) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		return fn(txSt)
+	})
+}
+
+func (s EventsStorage) DropData(ctx context.Context) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		// ID sequences start over with the new tables, so tests get deterministic IDs
+		prevTables := txSt.data.tables
+		txSt.data.tables = newEventsTables()
+		txSt.addUndo(func() {
+			txSt.data.tables = prevTables
+		})
+
+		return nil
+	})
+}
+
+// runInTx executes fn within a new transaction (or within the ongoing one for nested calls).
+// Changes are rolled back if fn fails (or panics), fn error is returned "as is" to preserve its type.
+func (s EventsStorage) runInTx(ctx context.Context, fn func(txSt EventsStorage) error) (retErr error) {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("ctx: %w", err)
+	}
+
+	s.data.lock.Lock()
+	defer s.data.lock.Unlock()
+
+	txSt := s
+	txSt.tx = &txLog{}
+	committed := false
+	defer func() {
+		if !committed {
+			txSt.tx.rollback()
+		}
+	}()
+
+	if err := fn(txSt); err != nil {
+		return err
+	}
+	committed = true
+
+	return nil
+}
+
+// read executes fn holding the read lock (the ongoing transaction holds the write lock already).
+func (s EventsStorage) read(fn func()) {
+	if s.tx == nil {
+		s.data.lock.RLock()
+		defer s.data.lock.RUnlock()
+	}
+
+	fn()
+}
+
+// addUndo registers the transaction undo operation.
+func (s EventsStorage) addUndo(fn func()) {
+	s.tx.undo = append(s.tx.undo, fn)
+}
+
+func (l *txLog) rollback() {
+	for i := len(l.undo) - 1; i >= 0; i-- {
+		l.undo[i]()
+	}
+	l.undo = nil
+}
+
+func newEventsTables() *eventsTables {
+	return &eventsTables{
+		singleEvents:               make(map[int64]singleEvent),
+		periodicEvents:             make(map[int64]periodicEvent),
+		eventExceptions:            make(map[int64]periodicEventException),
+		singleEventsIdx:            make(map[int64]*intervalTree),
+		periodicEventsIdx:          make(map[int64]*intervalTree),
+		periodicEventExceptionsIdx: make(map[int64]map[int64]struct{}),
+		eventHistoryIdx:            make(map[eventHistoryKey][]int),
+	}
+}
+
+func NewEventsStorage() *EventsStorage {
+	return &EventsStorage{
+		data: &storageData{
+			tables: newEventsTables(),
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s EventsStorage) CreateSingleEvent(ctx context.Context, obj schema.SingleEvent) (retId int64, retErr error) {
+	dbObj, err := newSingleEvent(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		id, undo := tables.nextId(&tables.lastSingleEventId)
+		txSt.addUndo(undo)
+		dbObj.Id = id
+		txSt.addUndo(tables.putSingleEvent(dbObj))
+		retId = id
+
+		return nil
+	})
+
+	return
+}
+
+func (s EventsStorage) CreatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) (retId int64, retErr error) {
+	dbObj, err := newPeriodicEvent(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		id, undo := tables.nextId(&tables.lastPeriodicEventId)
+		txSt.addUndo(undo)
+		dbObj.Id = id
+		txSt.addUndo(tables.putPeriodicEvent(dbObj))
+		retId = id
+
+		return nil
+	})
+
+	return
+}
+
+func (s EventsStorage) CreatePeriodicEventException(ctx context.Context, obj schema.PeriodicEventException) (retId int64, retErr error) {
+	dbObj := newPeriodicEventException(obj)
+
+	// Moved occurrence might extend the periodic event active range
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		// Unique (periodic_event_id, occurrence_start) index equivalent
+		for exceptionId := range tables.periodicEventExceptionsIdx[dbObj.PeriodicEventId] {
+			if tables.eventExceptions[exceptionId].OccurrenceStart.Equal(dbObj.OccurrenceStart) {
+				return fmt.Errorf("%s: occurrence exception exists (%d): %w", "occurrenceStart", exceptionId, common.ErrInvalidInput)
+			}
+		}
+
+		id, undo := tables.nextId(&tables.lastEventExceptionId)
+		txSt.addUndo(undo)
+		dbObj.Id = id
+		txSt.addUndo(tables.putEventException(dbObj))
+		retId = id
+
+		return txSt.updatePeriodicEventActiveRange(dbObj.PeriodicEventId)
+	})
+
+	return
+}
+
+func (s EventsStorage) CreateEventHistoryEntry(ctx context.Context, obj schema.EventHistoryEntry) (retId int64, retErr error) {
+	dbObj, err := newEventHistoryEntry(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		id, undo := tables.nextId(&tables.lastEventHistoryId)
+		txSt.addUndo(undo)
+		dbObj.Id = id
+		txSt.addUndo(tables.appendEventHistoryEntry(dbObj))
+		retId = id
+
+		return nil
+	})
+
+	return
+}
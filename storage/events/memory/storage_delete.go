@@ -0,0 +1,117 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+func (s EventsStorage) DeleteSingleEvent(ctx context.Context, id int64, deletedAt time.Time) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		dbObj, found := tables.singleEvents[id]
+		if !found || dbObj.DeletedAt != nil {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+
+		deletedAt = deletedAt.UTC()
+		dbObj.DeletedAt = &deletedAt
+		txSt.addUndo(tables.putSingleEvent(dbObj))
+
+		return nil
+	})
+}
+
+func (s EventsStorage) DeletePeriodicEvent(ctx context.Context, id int64, deletedAt time.Time) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		dbObj, found := tables.periodicEvents[id]
+		if !found || dbObj.DeletedAt != nil {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+
+		deletedAt = deletedAt.UTC()
+		dbObj.DeletedAt = &deletedAt
+		txSt.addUndo(tables.putPeriodicEvent(dbObj))
+
+		return nil
+	})
+}
+
+func (s EventsStorage) RestoreSingleEvent(ctx context.Context, id int64) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		dbObj, found := tables.singleEvents[id]
+		if !found || dbObj.DeletedAt == nil {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+
+		dbObj.DeletedAt = nil
+		txSt.addUndo(tables.putSingleEvent(dbObj))
+
+		return nil
+	})
+}
+
+func (s EventsStorage) RestorePeriodicEvent(ctx context.Context, id int64) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		dbObj, found := tables.periodicEvents[id]
+		if !found || dbObj.DeletedAt == nil {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+
+		dbObj.DeletedAt = nil
+		txSt.addUndo(tables.putPeriodicEvent(dbObj))
+
+		return nil
+	})
+}
+
+// PurgeDeletedEvents scans all the events (soft-deleted ones are not indexed by the deletion time).
+func (s EventsStorage) PurgeDeletedEvents(ctx context.Context, deletedBefore time.Time) (retCnt int64, retErr error) {
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		for id, dbObj := range tables.singleEvents {
+			if dbObj.DeletedAt == nil || !dbObj.DeletedAt.Before(deletedBefore) {
+				continue
+			}
+			txSt.addUndo(tables.deleteSingleEvent(id))
+			retCnt++
+		}
+
+		for id, dbObj := range tables.periodicEvents {
+			if dbObj.DeletedAt == nil || !dbObj.DeletedAt.Before(deletedBefore) {
+				continue
+			}
+			txSt.addUndo(tables.deletePeriodicEvent(id))
+			for exceptionId := range tables.periodicEventExceptionsIdx[id] {
+				txSt.addUndo(tables.deleteEventException(exceptionId))
+			}
+			retCnt++
+		}
+
+		return nil
+	})
+	if retErr != nil {
+		retCnt = 0
+	}
+
+	return
+}
+
+func (s EventsStorage) DeletePeriodicEventException(ctx context.Context, id int64) error {
+	// Restored occurrence might shrink the periodic event active range
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+		exception, found := tables.eventExceptions[id]
+		if !found {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+		txSt.addUndo(tables.deleteEventException(id))
+
+		return txSt.updatePeriodicEventActiveRange(exception.PeriodicEventId)
+	})
+}
@@ -0,0 +1,243 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s EventsStorage) GetSingleEvent(ctx context.Context, id int64) (retObj *schema.SingleEvent, retErr error) {
+	s.read(func() {
+		dbObj, found := s.data.tables.singleEvents[id]
+		if !found || dbObj.DeletedAt != nil {
+			return
+		}
+
+		obj := dbObj.ToSchema()
+		retObj = &obj
+	})
+
+	return
+}
+
+// GetSingleEventsWithinRange scans all the charge point events if includeDeleted is set (soft-deleted ones are not indexed).
+func (s EventsStorage) GetSingleEventsWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) (retObjs []schema.SingleEvent, retErr error) {
+	retObjs = make([]schema.SingleEvent, 0)
+	s.read(func() {
+		tables := s.data.tables
+		if includeDeleted {
+			for _, dbObj := range tables.singleEvents {
+				if dbObj.ChargePointId != chargePointId || dbObj.StartDateTime.Before(rangeStart) || dbObj.StartDateTime.After(rangeEnd) {
+					continue
+				}
+				retObjs = append(retObjs, dbObj.ToSchema())
+			}
+			sort.Slice(retObjs, func(i, j int) bool { return retObjs[i].Id < retObjs[j].Id })
+
+			return
+		}
+
+		tree := tables.singleEventsIdx[chargePointId]
+		if tree == nil {
+			return
+		}
+
+		tree.StartingWithin(rangeStart, rangeEnd, func(id int64) {
+			retObjs = append(retObjs, tables.singleEvents[id].ToSchema())
+		})
+	})
+
+	return
+}
+
+// GetSingleEventsIntersectingRange scans all the charge point soft-deleted events if includeDeleted is set (those are not indexed).
+func (s EventsStorage) GetSingleEventsIntersectingRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) (retObjs []schema.SingleEvent, retErr error) {
+	retObjs = make([]schema.SingleEvent, 0)
+	s.read(func() {
+		tables := s.data.tables
+		if tree := tables.singleEventsIdx[chargePointId]; tree != nil {
+			tree.Overlapping(rangeStart, rangeEnd, false, func(id int64) {
+				retObjs = append(retObjs, tables.singleEvents[id].ToSchema())
+			})
+		}
+		if !includeDeleted {
+			return
+		}
+
+		for _, dbObj := range tables.singleEvents {
+			if dbObj.DeletedAt == nil || dbObj.ChargePointId != chargePointId || !dbObj.StartDateTime.Before(rangeEnd) || !dbObj.EndDateTime().After(rangeStart) {
+				continue
+			}
+			retObjs = append(retObjs, dbObj.ToSchema())
+		}
+		sort.Slice(retObjs, func(i, j int) bool {
+			if !retObjs[i].StartDateTime.Equal(retObjs[j].StartDateTime) {
+				return retObjs[i].StartDateTime.Before(retObjs[j].StartDateTime)
+			}
+			return retObjs[i].Id < retObjs[j].Id
+		})
+	})
+
+	return
+}
+
+func (s EventsStorage) GetPeriodicEvent(ctx context.Context, id int64) (retObj *schema.PeriodicEvent, retErr error) {
+	s.read(func() {
+		dbObj, found := s.data.tables.periodicEvents[id]
+		if !found || dbObj.DeletedAt != nil {
+			return
+		}
+
+		obj, err := s.unmarshalPeriodicEvent(dbObj)
+		if err != nil {
+			retErr = fmt.Errorf("obj unmarshal: %w", err)
+			return
+		}
+		retObj = &obj
+	})
+
+	return
+}
+
+func (s EventsStorage) GetAllPeriodicEvents(ctx context.Context, chargePointId int64, includeDeleted bool) (retObjs []schema.PeriodicEvent, retErr error) {
+	s.read(func() {
+		var ids []int64
+		for _, dbObj := range s.data.tables.periodicEvents {
+			if dbObj.ChargePointId == chargePointId && (includeDeleted || dbObj.DeletedAt == nil) {
+				ids = append(ids, dbObj.Id)
+			}
+		}
+
+		retObjs, retErr = s.unmarshalPeriodicEvents(ids)
+	})
+
+	return
+}
+
+// GetSingleEventsDeletedBefore scans all the events (soft-deleted ones are not indexed by the deletion time).
+func (s EventsStorage) GetSingleEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) (retObjs []schema.SingleEvent, retErr error) {
+	retObjs = make([]schema.SingleEvent, 0)
+	s.read(func() {
+		for _, dbObj := range s.data.tables.singleEvents {
+			if dbObj.DeletedAt == nil || !dbObj.DeletedAt.Before(deletedBefore) {
+				continue
+			}
+			retObjs = append(retObjs, dbObj.ToSchema())
+		}
+		sort.Slice(retObjs, func(i, j int) bool { return retObjs[i].Id < retObjs[j].Id })
+	})
+
+	return
+}
+
+// GetPeriodicEventsDeletedBefore scans all the events (soft-deleted ones are not indexed by the deletion time).
+func (s EventsStorage) GetPeriodicEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) (retObjs []schema.PeriodicEvent, retErr error) {
+	s.read(func() {
+		var ids []int64
+		for _, dbObj := range s.data.tables.periodicEvents {
+			if dbObj.DeletedAt != nil && dbObj.DeletedAt.Before(deletedBefore) {
+				ids = append(ids, dbObj.Id)
+			}
+		}
+
+		retObjs, retErr = s.unmarshalPeriodicEvents(ids)
+	})
+
+	return
+}
+
+func (s EventsStorage) GetPeriodicEventsActiveWithin(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (retObjs []schema.PeriodicEvent, retErr error) {
+	s.read(func() {
+		var ids []int64
+		if tree := s.data.tables.periodicEventsIdx[chargePointId]; tree != nil {
+			tree.Overlapping(rangeStart, rangeEnd, true, func(id int64) {
+				ids = append(ids, id)
+			})
+		}
+
+		retObjs, retErr = s.unmarshalPeriodicEvents(ids)
+	})
+
+	return
+}
+
+func (s EventsStorage) GetPeriodicEventException(ctx context.Context, id int64) (retObj *schema.PeriodicEventException, retErr error) {
+	s.read(func() {
+		dbObj, found := s.data.tables.eventExceptions[id]
+		if !found {
+			return
+		}
+
+		obj := dbObj.ToSchema()
+		retObj = &obj
+	})
+
+	return
+}
+
+func (s EventsStorage) GetEventHistory(ctx context.Context, eventId int64, periodic bool) (retObjs []schema.EventHistoryEntry, retErr error) {
+	retObjs = make([]schema.EventHistoryEntry, 0)
+	s.read(func() {
+		tables := s.data.tables
+		for _, pos := range tables.eventHistoryIdx[eventHistoryKey{periodic: periodic, eventId: eventId}] {
+			retObjs = append(retObjs, tables.eventHistory[pos].ToSchema())
+		}
+	})
+
+	return
+}
+
+// GetEventHistoryWithinRange scans the whole history (entries are not indexed by the creation time).
+func (s EventsStorage) GetEventHistoryWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (retObjs []schema.EventHistoryEntry, retErr error) {
+	retObjs = make([]schema.EventHistoryEntry, 0)
+	s.read(func() {
+		for _, dbObj := range s.data.tables.eventHistory {
+			if dbObj.ChargePointId != chargePointId || dbObj.CreatedAt.Before(rangeStart) || dbObj.CreatedAt.After(rangeEnd) {
+				continue
+			}
+			retObjs = append(retObjs, dbObj.ToSchema())
+		}
+	})
+
+	return
+}
+
+// unmarshalPeriodicEvent converts the periodic event attaching its exceptions (sorted by occurrence start).
+// Must be called holding the lock.
+func (s EventsStorage) unmarshalPeriodicEvent(dbObj periodicEvent) (schema.PeriodicEvent, error) {
+	obj, err := dbObj.ToSchema()
+	if err != nil {
+		return schema.PeriodicEvent{}, err
+	}
+
+	tables := s.data.tables
+	for exceptionId := range tables.periodicEventExceptionsIdx[obj.Id] {
+		obj.Exceptions = append(obj.Exceptions, tables.eventExceptions[exceptionId].ToSchema())
+	}
+	sort.Slice(obj.Exceptions, func(i, j int) bool {
+		return obj.Exceptions[i].OccurrenceStart.Before(obj.Exceptions[j].OccurrenceStart)
+	})
+
+	return obj, nil
+}
+
+// unmarshalPeriodicEvents converts periodic events by IDs (sorted by ID).
+// Must be called holding the lock.
+func (s EventsStorage) unmarshalPeriodicEvents(ids []int64) (retObjs []schema.PeriodicEvent, retErr error) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	retObjs = make([]schema.PeriodicEvent, 0, len(ids))
+	for i, id := range ids {
+		obj, err := s.unmarshalPeriodicEvent(s.data.tables.periodicEvents[id])
+		if err != nil {
+			retErr = fmt.Errorf("dbObj[%d] unmarshal: %w", i, err)
+			return
+		}
+		retObjs = append(retObjs, obj)
+	}
+
+	return
+}
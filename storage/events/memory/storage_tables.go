@@ -0,0 +1,161 @@
+package memory
+
+import "time"
+
+// Table operations return the undo operation restoring the previous state.
+
+// nextId increments the last ID counter returning the new ID.
+func (t *eventsTables) nextId(lastId *int64) (retId int64, retUndo func()) {
+	prevId := *lastId
+	*lastId++
+
+	return *lastId, func() {
+		*lastId = prevId
+	}
+}
+
+// putSingleEvent inserts / replaces a single event (soft-deleted events are not indexed).
+func (t *eventsTables) putSingleEvent(obj singleEvent) func() {
+	prevObj, found := t.singleEvents[obj.Id]
+	if found && prevObj.DeletedAt == nil {
+		t.unindex(t.singleEventsIdx, prevObj.ChargePointId, prevObj.Id, prevObj.StartDateTime)
+	}
+	t.singleEvents[obj.Id] = obj
+	if obj.DeletedAt == nil {
+		t.index(t.singleEventsIdx, obj.ChargePointId, obj.Id, obj.StartDateTime, obj.EndDateTime())
+	}
+
+	return func() {
+		if obj.DeletedAt == nil {
+			t.unindex(t.singleEventsIdx, obj.ChargePointId, obj.Id, obj.StartDateTime)
+		}
+		delete(t.singleEvents, obj.Id)
+		if found {
+			t.putSingleEvent(prevObj)
+		}
+	}
+}
+
+// deleteSingleEvent removes a single event (if exists).
+func (t *eventsTables) deleteSingleEvent(id int64) func() {
+	prevObj, found := t.singleEvents[id]
+	if !found {
+		return func() {}
+	}
+	if prevObj.DeletedAt == nil {
+		t.unindex(t.singleEventsIdx, prevObj.ChargePointId, prevObj.Id, prevObj.StartDateTime)
+	}
+	delete(t.singleEvents, id)
+
+	return func() {
+		t.putSingleEvent(prevObj)
+	}
+}
+
+// putPeriodicEvent inserts / replaces a periodic event (soft-deleted events are not indexed).
+func (t *eventsTables) putPeriodicEvent(obj periodicEvent) func() {
+	prevObj, found := t.periodicEvents[obj.Id]
+	if found && prevObj.DeletedAt == nil {
+		t.unindex(t.periodicEventsIdx, prevObj.ChargePointId, prevObj.Id, prevObj.ActiveStart)
+	}
+	t.periodicEvents[obj.Id] = obj
+	if obj.DeletedAt == nil {
+		t.index(t.periodicEventsIdx, obj.ChargePointId, obj.Id, obj.ActiveStart, obj.ActiveEnd)
+	}
+
+	return func() {
+		if obj.DeletedAt == nil {
+			t.unindex(t.periodicEventsIdx, obj.ChargePointId, obj.Id, obj.ActiveStart)
+		}
+		delete(t.periodicEvents, obj.Id)
+		if found {
+			t.putPeriodicEvent(prevObj)
+		}
+	}
+}
+
+// deletePeriodicEvent removes a periodic event (if exists), its exceptions are kept.
+func (t *eventsTables) deletePeriodicEvent(id int64) func() {
+	prevObj, found := t.periodicEvents[id]
+	if !found {
+		return func() {}
+	}
+	if prevObj.DeletedAt == nil {
+		t.unindex(t.periodicEventsIdx, prevObj.ChargePointId, prevObj.Id, prevObj.ActiveStart)
+	}
+	delete(t.periodicEvents, id)
+
+	return func() {
+		t.putPeriodicEvent(prevObj)
+	}
+}
+
+// putEventException inserts a periodic event exception (existing ones are not modified).
+func (t *eventsTables) putEventException(obj periodicEventException) func() {
+	t.eventExceptions[obj.Id] = obj
+	exceptionIds := t.periodicEventExceptionsIdx[obj.PeriodicEventId]
+	if exceptionIds == nil {
+		exceptionIds = make(map[int64]struct{})
+		t.periodicEventExceptionsIdx[obj.PeriodicEventId] = exceptionIds
+	}
+	exceptionIds[obj.Id] = struct{}{}
+
+	return func() {
+		t.deleteEventException(obj.Id)
+	}
+}
+
+// deleteEventException removes a periodic event exception (if exists).
+func (t *eventsTables) deleteEventException(id int64) func() {
+	prevObj, found := t.eventExceptions[id]
+	if !found {
+		return func() {}
+	}
+	delete(t.eventExceptions, id)
+	exceptionIds := t.periodicEventExceptionsIdx[prevObj.PeriodicEventId]
+	delete(exceptionIds, id)
+	if len(exceptionIds) == 0 {
+		delete(t.periodicEventExceptionsIdx, prevObj.PeriodicEventId)
+	}
+
+	return func() {
+		t.putEventException(prevObj)
+	}
+}
+
+func (t *eventsTables) index(idx map[int64]*intervalTree, chargePointId, id int64, start, end time.Time) {
+	tree := idx[chargePointId]
+	if tree == nil {
+		tree = newIntervalTree()
+		idx[chargePointId] = tree
+	}
+	tree.Insert(id, start, end)
+}
+
+func (t *eventsTables) unindex(idx map[int64]*intervalTree, chargePointId, id int64, start time.Time) {
+	tree := idx[chargePointId]
+	if tree == nil {
+		return
+	}
+	tree.Delete(id, start)
+	if tree.Len() == 0 {
+		delete(idx, chargePointId)
+	}
+}
+
+// appendEventHistoryEntry appends an event history entry.
+func (t *eventsTables) appendEventHistoryEntry(obj eventHistoryEntry) func() {
+	key := eventHistoryKey{periodic: obj.Periodic, eventId: obj.EventId}
+	t.eventHistory = append(t.eventHistory, obj)
+	t.eventHistoryIdx[key] = append(t.eventHistoryIdx[key], len(t.eventHistory)-1)
+
+	return func() {
+		t.eventHistory = t.eventHistory[:len(t.eventHistory)-1]
+		positions := t.eventHistoryIdx[key][:len(t.eventHistoryIdx[key])-1]
+		if len(positions) == 0 {
+			delete(t.eventHistoryIdx, key)
+			return
+		}
+		t.eventHistoryIdx[key] = positions
+	}
+}
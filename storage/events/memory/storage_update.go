@@ -0,0 +1,83 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s EventsStorage) UpdateSingleEvent(ctx context.Context, obj schema.SingleEvent) error {
+	dbObj, err := newSingleEvent(obj)
+	if err != nil {
+		return fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+	}
+
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		prevObj, found := tables.singleEvents[obj.Id]
+		if !found || prevObj.DeletedAt != nil {
+			return fmt.Errorf("%s (%d): %w", "id", obj.Id, common.ErrNotFound)
+		}
+		if prevObj.Version != obj.Version {
+			return fmt.Errorf("%s (%d): %w", "version", obj.Id, common.ErrVersionConflict)
+		}
+
+		// Charge point, owner and creation time are not updated
+		dbObj.ChargePointId, dbObj.OwnerRef, dbObj.CreatedAt = prevObj.ChargePointId, prevObj.OwnerRef, prevObj.CreatedAt
+		dbObj.Version = prevObj.Version + 1
+		txSt.addUndo(tables.putSingleEvent(dbObj))
+
+		return nil
+	})
+}
+
+func (s EventsStorage) UpdatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) error {
+	dbObj, err := newPeriodicEvent(obj)
+	if err != nil {
+		return fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+	}
+	dbObj.Id = obj.Id
+
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		tables := txSt.data.tables
+
+		prevObj, found := tables.periodicEvents[obj.Id]
+		if !found || prevObj.DeletedAt != nil {
+			return fmt.Errorf("%s (%d): %w", "id", obj.Id, common.ErrNotFound)
+		}
+		if prevObj.Version != obj.Version {
+			return fmt.Errorf("%s (%d): %w", "version", obj.Id, common.ErrVersionConflict)
+		}
+
+		// Charge point and creation time are not updated
+		dbObj.ChargePointId, dbObj.CreatedAt = prevObj.ChargePointId, prevObj.CreatedAt
+		dbObj.Version = prevObj.Version + 1
+		txSt.addUndo(tables.putPeriodicEvent(dbObj))
+
+		return nil
+	})
+}
+
+// updatePeriodicEventActiveRange recalculates the periodic event active range (after its exceptions change).
+// Non-existing (soft-deleted) event is skipped. Must be called within a transaction.
+func (s EventsStorage) updatePeriodicEventActiveRange(id int64) error {
+	tables := s.data.tables
+
+	dbObj, found := tables.periodicEvents[id]
+	if !found || dbObj.DeletedAt != nil {
+		return nil
+	}
+
+	obj, err := s.unmarshalPeriodicEvent(dbObj)
+	if err != nil {
+		return fmt.Errorf("obj unmarshal: %w", err)
+	}
+
+	dbObj.ActiveStart, dbObj.ActiveEnd = newPeriodicEventActiveRange(obj)
+	s.addUndo(tables.putPeriodicEvent(dbObj))
+
+	return nil
+}
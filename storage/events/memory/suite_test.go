@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/storage/events/testutil"
+)
+
+func TestSuite_EventsStorage(t *testing.T) {
+	suite.Run(t, testutil.NewEventsStorageTestSuite(func(t *testing.T) (*testutil.EventsStorageTestResource, error) {
+		return NewTestResource()
+	}))
+}
@@ -0,0 +1,11 @@
+package memory
+
+import (
+	"github.com/itiky/charge_scheduler/storage/events/testutil"
+)
+
+func NewTestResource() (*testutil.EventsStorageTestResource, error) {
+	return &testutil.EventsStorageTestResource{
+		Storage: NewEventsStorage(),
+	}, nil
+}
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+// Timestamps are read in the session time zone, so they are converted to UTC (as the SQLite storage returns them).
+type singleEvent struct {
+	Id              int64      `db:"id"`
+	ChargePointId   int64      `db:"charge_point_id"`
+	Type            string     `db:"type"`
+	StartDateTime   time.Time  `db:"start_date_time"`
+	EndDateTime     time.Time  `db:"end_date_time"`
+	DurationSeconds int64      `db:"duration_seconds"`
+	OwnerRef        string     `db:"owner_ref"`
+	CreatedAt       time.Time  `db:"created_at"`
+	Version         int64      `db:"version"`
+	DeletedAt       *time.Time `db:"deleted_at"`
+}
+
+func (e singleEvent) ToSchema() (schema.SingleEvent, error) {
+	eType := schema.SingleEventType(e.Type)
+	if !eType.IsValid() {
+		return schema.SingleEvent{}, fmt.Errorf("%s: invalid", "type")
+	}
+
+	return schema.SingleEvent{
+		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
+		Type:          eType,
+		StartDateTime: e.StartDateTime.UTC(),
+		Duration:      time.Duration(e.DurationSeconds) * time.Second,
+		OwnerRef:      e.OwnerRef,
+		CreatedAt:     e.CreatedAt.UTC(),
+		Version:       e.Version,
+		DeletedAt:     utcTimePtr(e.DeletedAt),
+	}, nil
+}
+
+func newSingleEvent(obj schema.SingleEvent) (singleEvent, error) {
+	return singleEvent{
+		ChargePointId:   obj.ChargePointId,
+		Type:            obj.Type.String(),
+		StartDateTime:   obj.StartDateTime.UTC(),
+		EndDateTime:     obj.EndDateTime().UTC(),
+		DurationSeconds: int64(obj.Duration / time.Second),
+		OwnerRef:        obj.OwnerRef,
+		CreatedAt:       obj.CreatedAt.UTC(),
+		Version:         obj.Version,
+	}, nil
+}
+
+// Active range bounds are NULL if unknown and the end is NULL for endless rules.
+type periodicEvent struct {
+	Id              int64      `db:"id"`
+	ChargePointId   int64      `db:"charge_point_id"`
+	Type            string     `db:"type"`
+	Rrule           string     `db:"rrule"`
+	DurationSeconds int64      `db:"duration_seconds"`
+	ActiveStart     *time.Time `db:"active_start"`
+	ActiveEnd       *time.Time `db:"active_end"`
+	CreatedAt       time.Time  `db:"created_at"`
+	Version         int64      `db:"version"`
+	DeletedAt       *time.Time `db:"deleted_at"`
+}
+
+func (e periodicEvent) ToSchema() (schema.PeriodicEvent, error) {
+	eType := schema.SingleEventType(e.Type)
+	if !eType.IsValid() {
+		return schema.PeriodicEvent{}, fmt.Errorf("%s: invalid", "type")
+	}
+
+	obj := schema.PeriodicEvent{
+		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
+		Type:          eType,
+		Duration:      time.Duration(e.DurationSeconds) * time.Second,
+		CreatedAt:     e.CreatedAt.UTC(),
+		Version:       e.Version,
+		DeletedAt:     utcTimePtr(e.DeletedAt),
+	}
+
+	r, err := rrule.StrToRRule(e.Rrule)
+	if err != nil {
+		return schema.PeriodicEvent{}, fmt.Errorf("creating rrule (%s): %w", e.Rrule, err)
+	}
+	obj.Rrule = *r
+
+	return obj, nil
+}
+
+func newPeriodicEvent(obj schema.PeriodicEvent) (periodicEvent, error) {
+	dbObj := periodicEvent{
+		ChargePointId:   obj.ChargePointId,
+		Type:            obj.Type.String(),
+		Rrule:           obj.Rrule.String(),
+		DurationSeconds: int64(obj.Duration / time.Second),
+		CreatedAt:       obj.CreatedAt.UTC(),
+		Version:         obj.Version,
+	}
+	dbObj.ActiveStart, dbObj.ActiveEnd = newPeriodicEventActiveRange(obj)
+
+	return dbObj, nil
+}
+
+// newPeriodicEventActiveRange returns the periodic event active range DB bounds.
+func newPeriodicEventActiveRange(obj schema.PeriodicEvent) (retStart, retEnd *time.Time) {
+	activeStart, activeEnd := obj.ActiveRange()
+
+	activeStart = activeStart.UTC()
+	retStart = &activeStart
+	if !activeEnd.IsZero() {
+		activeEnd = activeEnd.UTC()
+		retEnd = &activeEnd
+	}
+
+	return
+}
+
+type periodicEventException struct {
+	Id                      int64      `db:"id"`
+	PeriodicEventId         int64      `db:"periodic_event_id"`
+	OccurrenceStart         time.Time  `db:"occurrence_start"`
+	OverrideStart           *time.Time `db:"override_start"`
+	OverrideDurationSeconds int64      `db:"override_duration_seconds"`
+	CreatedAt               time.Time  `db:"created_at"`
+}
+
+func (e periodicEventException) ToSchema() schema.PeriodicEventException {
+	obj := schema.PeriodicEventException{
+		Id:               e.Id,
+		PeriodicEventId:  e.PeriodicEventId,
+		OccurrenceStart:  e.OccurrenceStart.UTC(),
+		OverrideDuration: time.Duration(e.OverrideDurationSeconds) * time.Second,
+		CreatedAt:        e.CreatedAt.UTC(),
+	}
+	if e.OverrideStart != nil {
+		overrideStart := e.OverrideStart.UTC()
+		obj.OverrideStart = &overrideStart
+	}
+
+	return obj
+}
+
+func newPeriodicEventException(obj schema.PeriodicEventException) periodicEventException {
+	dbObj := periodicEventException{
+		PeriodicEventId:         obj.PeriodicEventId,
+		OccurrenceStart:         obj.OccurrenceStart.UTC(),
+		OverrideDurationSeconds: int64(obj.OverrideDuration / time.Second),
+		CreatedAt:               obj.CreatedAt.UTC(),
+	}
+	if obj.OverrideStart != nil {
+		overrideStart := obj.OverrideStart.UTC()
+		dbObj.OverrideStart = &overrideStart
+	}
+
+	return dbObj
+}
+
+// Snapshots are NULL if absent (the create / delete operations).
+type eventHistoryEntry struct {
+	Id            int64     `db:"id"`
+	EventId       int64     `db:"event_id"`
+	Periodic      bool      `db:"periodic"`
+	ChargePointId int64     `db:"charge_point_id"`
+	Operation     string    `db:"operation"`
+	Actor         string    `db:"actor"`
+	BeforeJSON    *string   `db:"before_json"`
+	AfterJSON     *string   `db:"after_json"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (e eventHistoryEntry) ToSchema() (schema.EventHistoryEntry, error) {
+	operation := schema.EventOperation(e.Operation)
+	if !operation.IsValid() {
+		return schema.EventHistoryEntry{}, fmt.Errorf("%s: invalid", "operation")
+	}
+
+	obj := schema.EventHistoryEntry{
+		Id:            e.Id,
+		EventId:       e.EventId,
+		Periodic:      e.Periodic,
+		ChargePointId: e.ChargePointId,
+		Operation:     operation,
+		Actor:         e.Actor,
+		CreatedAt:     e.CreatedAt.UTC(),
+	}
+	if e.BeforeJSON != nil {
+		obj.Before = json.RawMessage(*e.BeforeJSON)
+	}
+	if e.AfterJSON != nil {
+		obj.After = json.RawMessage(*e.AfterJSON)
+	}
+
+	return obj, nil
+}
+
+func newEventHistoryEntry(obj schema.EventHistoryEntry) (eventHistoryEntry, error) {
+	if !obj.Operation.IsValid() {
+		return eventHistoryEntry{}, fmt.Errorf("%s: invalid", "operation")
+	}
+
+	dbObj := eventHistoryEntry{
+		EventId:       obj.EventId,
+		Periodic:      obj.Periodic,
+		ChargePointId: obj.ChargePointId,
+		Operation:     obj.Operation.String(),
+		Actor:         obj.Actor,
+		CreatedAt:     obj.CreatedAt.UTC(),
+	}
+	if obj.Before != nil {
+		before := string(obj.Before)
+		dbObj.BeforeJSON = &before
+	}
+	if obj.After != nil {
+		after := string(obj.After)
+		dbObj.AfterJSON = &after
+	}
+
+	return dbObj, nil
+}
+
+// utcTimePtr returns the UTC copy of an optional timestamp.
+func utcTimePtr(ts *time.Time) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	utcTs := ts.UTC()
+
+	return &utcTs
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/storage/events"
+	"github.com/itiky/charge_scheduler/storage/postgres_base"
+)
+
+var _ events.EventsStorage = (*EventsStorage)(nil)
+
+// pqExclusionViolation is the exclusion constraint violation error code (same type single events overlap).
+const pqExclusionViolation = "23P01"
+
+// dbExecutor defines sqlx.DB / sqlx.Tx common methods used by the storage.
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+type EventsStorage struct {
+	*postgres_base.PostgresBase
+	logger zerolog.Logger
+	// db is the base storage DB or an ongoing transaction (within RunInTx)
+	db   dbExecutor
+	inTx bool
+}
+
+func (s EventsStorage) RunInTx(ctx context.Context, fn func(txSt events.EventsStorage) error) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		return fn(txSt)
+	})
+}
+
+func (s EventsStorage) DropData(ctx context.Context) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		// Identities are restarted, so tests get deterministic IDs
+		if _, err := txSt.db.ExecContext(ctx, "TRUNCATE single_events, periodic_events, periodic_event_exceptions, event_history RESTART IDENTITY"); err != nil {
+			return fmt.Errorf("tx.Exec (truncate): %w", err)
+		}
+
+		return nil
+	})
+}
+
+// runInTx executes fn within a new transaction (or within the ongoing one for nested calls).
+// Transaction takes the postgres_base.TxLockKey advisory lock upfront serializing storage transactions.
+// fn error is returned "as is" to preserve its type.
+// nolint:errcheck
+func (s EventsStorage) runInTx(ctx context.Context, fn func(txSt EventsStorage) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	tx, err := s.Db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("s.Db.BeginTxx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", postgres_base.TxLockKey); err != nil {
+		return fmt.Errorf("tx.Exec (lock): %w", err)
+	}
+
+	txSt := s
+	txSt.db, txSt.inTx = tx, true
+	if err := fn(txSt); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+
+	return nil
+}
+
+// namedGet executes a named query (INSERT ... RETURNING) scanning a single row result into dest.
+func (s EventsStorage) namedGet(ctx context.Context, dest interface{}, query string, arg interface{}) error {
+	boundQuery, args, err := sqlx.BindNamed(sqlx.DOLLAR, query, arg)
+	if err != nil {
+		return fmt.Errorf("sqlx.BindNamed: %w", err)
+	}
+
+	return s.db.GetContext(ctx, dest, boundQuery, args...)
+}
+
+// checkOverlapErr wraps the exclusion constraint violation with common.ErrInvalidInput.
+func checkOverlapErr(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == pqExclusionViolation {
+		return fmt.Errorf("event overlaps with an existing event (%s): %w", pqErr.Constraint, common.ErrInvalidInput)
+	}
+
+	return err
+}
+
+func NewEventsStorage(base *postgres_base.PostgresBase) (*EventsStorage, error) {
+	if base == nil {
+		return nil, fmt.Errorf("%s: nil", "base")
+	}
+
+	storage := &EventsStorage{
+		PostgresBase: base,
+		logger:       base.Logger.With().Str("repository", "events").Logger(),
+		db:           base.Db,
+	}
+
+	return storage, nil
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s EventsStorage) CreateSingleEvent(ctx context.Context, obj schema.SingleEvent) (retId int64, retErr error) {
+	dbObj, err := newSingleEvent(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	err = s.namedGet(ctx, &retId, "INSERT INTO single_events (charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version) VALUES (:charge_point_id, :type, :start_date_time, :end_date_time, :duration_seconds, :owner_ref, :created_at, :version) RETURNING id", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("s.namedGet: %w", checkOverlapErr(err))
+		return
+	}
+
+	return
+}
+
+func (s EventsStorage) CreatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) (retId int64, retErr error) {
+	dbObj, err := newPeriodicEvent(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	err = s.namedGet(ctx, &retId, "INSERT INTO periodic_events (charge_point_id, type, rrule, duration_seconds, active_start, active_end, created_at, version) VALUES (:charge_point_id, :type, :rrule, :duration_seconds, :active_start, :active_end, :created_at, :version) RETURNING id", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("s.namedGet: %w", err)
+		return
+	}
+
+	return
+}
+
+func (s EventsStorage) CreatePeriodicEventException(ctx context.Context, obj schema.PeriodicEventException) (retId int64, retErr error) {
+	dbObj := newPeriodicEventException(obj)
+
+	// Moved occurrence might extend the periodic event active range
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		err := txSt.namedGet(ctx, &retId, "INSERT INTO periodic_event_exceptions (periodic_event_id, occurrence_start, override_start, override_duration_seconds, created_at) VALUES (:periodic_event_id, :occurrence_start, :override_start, :override_duration_seconds, :created_at) RETURNING id", dbObj)
+		if err != nil {
+			return fmt.Errorf("tx.namedGet (periodic_event_exceptions): %w", err)
+		}
+
+		return txSt.updatePeriodicEventActiveRange(ctx, obj.PeriodicEventId)
+	})
+
+	return
+}
+
+func (s EventsStorage) CreateEventHistoryEntry(ctx context.Context, obj schema.EventHistoryEntry) (retId int64, retErr error) {
+	dbObj, err := newEventHistoryEntry(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	err = s.namedGet(ctx, &retId, "INSERT INTO event_history (event_id, periodic, charge_point_id, operation, actor, before_json, after_json, created_at) VALUES (:event_id, :periodic, :charge_point_id, :operation, :actor, :before_json, :after_json, :created_at) RETURNING id", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("s.namedGet: %w", err)
+		return
+	}
+
+	return
+}
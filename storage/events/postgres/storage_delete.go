@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/itiky/charge_scheduler/common"
+)
+
+func (s EventsStorage) DeleteSingleEvent(ctx context.Context, id int64, deletedAt time.Time) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE single_events SET deleted_at=$1 WHERE id=$2 AND deleted_at IS NULL", deletedAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", err)
+	}
+
+	return checkRowAffected(res, id)
+}
+
+func (s EventsStorage) DeletePeriodicEvent(ctx context.Context, id int64, deletedAt time.Time) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE periodic_events SET deleted_at=$1 WHERE id=$2 AND deleted_at IS NULL", deletedAt.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", err)
+	}
+
+	return checkRowAffected(res, id)
+}
+
+func (s EventsStorage) RestoreSingleEvent(ctx context.Context, id int64) error {
+	// Restored event is checked for overlaps by the constraint
+	res, err := s.db.ExecContext(ctx, "UPDATE single_events SET deleted_at=NULL WHERE id=$1 AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", checkOverlapErr(err))
+	}
+
+	return checkRowAffected(res, id)
+}
+
+func (s EventsStorage) RestorePeriodicEvent(ctx context.Context, id int64) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE periodic_events SET deleted_at=NULL WHERE id=$1 AND deleted_at IS NOT NULL", id)
+	if err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", err)
+	}
+
+	return checkRowAffected(res, id)
+}
+
+func (s EventsStorage) PurgeDeletedEvents(ctx context.Context, deletedBefore time.Time) (retCnt int64, retErr error) {
+	deletedBefore = deletedBefore.UTC()
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM periodic_event_exceptions WHERE periodic_event_id IN (SELECT id FROM periodic_events WHERE deleted_at < $1)", deletedBefore); err != nil {
+			return fmt.Errorf("tx.Exec (periodic_event_exceptions): %w", err)
+		}
+
+		for _, table := range []string{"single_events", "periodic_events"} {
+			res, err := txSt.db.ExecContext(ctx, "DELETE FROM "+table+" WHERE deleted_at < $1", deletedBefore)
+			if err != nil {
+				return fmt.Errorf("tx.Exec (%s): %w", table, err)
+			}
+
+			cnt, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("res.RowsAffected() (%s): %w", table, err)
+			}
+			retCnt += cnt
+		}
+
+		return nil
+	})
+	if retErr != nil {
+		retCnt = 0
+	}
+
+	return
+}
+
+func (s EventsStorage) DeletePeriodicEventException(ctx context.Context, id int64) error {
+	// Restored occurrence might shrink the periodic event active range
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		exception, err := txSt.GetPeriodicEventException(ctx, id)
+		if err != nil {
+			return fmt.Errorf("txSt.GetPeriodicEventException: %w", err)
+		}
+		if exception == nil {
+			return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+		}
+
+		res, err := txSt.db.ExecContext(ctx, "DELETE FROM periodic_event_exceptions WHERE id=$1", id)
+		if err != nil {
+			return fmt.Errorf("tx.Exec (periodic_event_exceptions): %w", err)
+		}
+		if err := checkRowAffected(res, id); err != nil {
+			return err
+		}
+
+		return txSt.updatePeriodicEventActiveRange(ctx, exception.PeriodicEventId)
+	})
+}
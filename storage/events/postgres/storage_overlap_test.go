@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func TestEventsStorage_SingleEventsOverlap(t *testing.T) {
+	r, err := setupTestResource(t)
+	require.NoError(t, err)
+	ctx := context.TODO()
+	targetSt := r.Storage
+	require.NoError(t, targetSt.DropData(ctx))
+
+	// Init fixtures
+	start := time.Date(2014, 8, 11, 10, 0, 0, 0, time.UTC)
+	event := schema.SingleEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeOccupied,
+		StartDateTime: start,
+		Duration:      time.Hour,
+		CreatedAt:     start,
+	}
+	id, err := targetSt.CreateSingleEvent(ctx, event)
+	require.NoError(t, err)
+
+	// fail: same type overlap
+	{
+		overlapping := event
+		overlapping.StartDateTime = start.Add(30 * time.Minute)
+		_, err := targetSt.CreateSingleEvent(ctx, overlapping)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: touching (adjacent bookings) and other type
+	{
+		touching := event
+		touching.StartDateTime = start.Add(time.Hour)
+		touchingId, err := targetSt.CreateSingleEvent(ctx, touching)
+		require.NoError(t, err)
+
+		other := event
+		other.Type = schema.SingleEventTypeAvailable
+		_, err = targetSt.CreateSingleEvent(ctx, other)
+		require.NoError(t, err)
+
+		// fail: update moving into the overlap
+		touching.Id = touchingId
+		touching.StartDateTime = start.Add(59 * time.Minute)
+		err = targetSt.UpdateSingleEvent(ctx, touching)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: update of the event itself (no self overlap)
+	{
+		event.Id = id
+		event.Duration = 30 * time.Minute
+		require.NoError(t, targetSt.UpdateSingleEvent(ctx, event))
+	}
+
+	// ok: soft-deleted events are not checked, fail: restore into the overlap
+	{
+		require.NoError(t, targetSt.DeleteSingleEvent(ctx, id, start))
+
+		replacement := event
+		replacement.Duration = time.Hour
+		_, err := targetSt.CreateSingleEvent(ctx, replacement)
+		require.NoError(t, err)
+
+		err = targetSt.RestoreSingleEvent(ctx, id)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s EventsStorage) UpdateSingleEvent(ctx context.Context, obj schema.SingleEvent) error {
+	dbObj, err := newSingleEvent(obj)
+	if err != nil {
+		return fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+	}
+	dbObj.Id = obj.Id
+
+	res, err := s.db.NamedExecContext(ctx, "UPDATE single_events SET type=:type, start_date_time=:start_date_time, end_date_time=:end_date_time, duration_seconds=:duration_seconds, version=version+1 WHERE id=:id AND version=:version AND deleted_at IS NULL", dbObj)
+	if err != nil {
+		return fmt.Errorf("s.db.NamedExecContext: %w", checkOverlapErr(err))
+	}
+
+	return s.checkEventUpdated(ctx, res, "single_events", obj.Id)
+}
+
+func (s EventsStorage) UpdatePeriodicEvent(ctx context.Context, obj schema.PeriodicEvent) error {
+	dbObj, err := newPeriodicEvent(obj)
+	if err != nil {
+		return fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+	}
+	dbObj.Id = obj.Id
+
+	res, err := s.db.NamedExecContext(ctx, "UPDATE periodic_events SET type=:type, rrule=:rrule, duration_seconds=:duration_seconds, active_start=:active_start, active_end=:active_end, version=version+1 WHERE id=:id AND version=:version AND deleted_at IS NULL", dbObj)
+	if err != nil {
+		return fmt.Errorf("s.db.NamedExecContext: %w", err)
+	}
+
+	return s.checkEventUpdated(ctx, res, "periodic_events", obj.Id)
+}
+
+// updatePeriodicEventActiveRange recalculates the periodic event active range (after its exceptions change).
+// Non-existing event is skipped.
+func (s EventsStorage) updatePeriodicEventActiveRange(ctx context.Context, id int64) error {
+	obj, err := s.GetPeriodicEvent(ctx, id)
+	if err != nil {
+		return fmt.Errorf("s.GetPeriodicEvent: %w", err)
+	}
+	if obj == nil {
+		return nil
+	}
+
+	activeStart, activeEnd := newPeriodicEventActiveRange(*obj)
+	if _, err := s.db.ExecContext(ctx, "UPDATE periodic_events SET active_start=$1, active_end=$2 WHERE id=$3", activeStart, activeEnd, id); err != nil {
+		return fmt.Errorf("s.db.ExecContext: %w", err)
+	}
+
+	return nil
+}
+
+// checkRowAffected checks that a single row modifying query found the target row.
+func checkRowAffected(res sql.Result, id int64) error {
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected(): %w", err)
+	}
+	if cnt == 0 {
+		return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+	}
+
+	return nil
+}
+
+// checkEventUpdated checks that the versioned event update found the target row:
+// common.ErrVersionConflict if the event exists with another version, common.ErrNotFound otherwise.
+func (s EventsStorage) checkEventUpdated(ctx context.Context, res sql.Result, table string, id int64) error {
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("res.RowsAffected(): %w", err)
+	}
+	if cnt > 0 {
+		return nil
+	}
+
+	var exists bool
+	if err := s.db.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM "+table+" WHERE id=$1 AND deleted_at IS NULL)", id); err != nil {
+		return fmt.Errorf("s.db.GetContext: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("%s (%d): %w", "version", id, common.ErrVersionConflict)
+	}
+
+	return fmt.Errorf("%s (%d): %w", "id", id, common.ErrNotFound)
+}
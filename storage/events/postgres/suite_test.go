@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/itiky/charge_scheduler/storage/events/testutil"
+	"github.com/itiky/charge_scheduler/storage/postgres_base"
+)
+
+func TestSuite_EventsStorage(t *testing.T) {
+	suite.Run(t, testutil.NewEventsStorageTestSuite(setupTestResource))
+}
+
+// setupTestResource connects to the postgres_base.TestDSNEnv database skipping the test if it isn't configured.
+// nolint:errcheck
+func setupTestResource(t *testing.T) (*testutil.EventsStorageTestResource, error) {
+	dsn := postgres_base.TestDSN()
+	if dsn == "" {
+		t.Skipf("%s is not set", postgres_base.TestDSNEnv)
+	}
+
+	baseSt, err := postgres_base.SetupTestPostgresBase(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("base storage init: %w", err)
+	}
+	t.Cleanup(func() { baseSt.Close() })
+
+	return NewTestResource(baseSt)
+}
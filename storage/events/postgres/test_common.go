@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/itiky/charge_scheduler/storage/events/testutil"
+	"github.com/itiky/charge_scheduler/storage/postgres_base"
+)
+
+func NewTestResource(baseSt *postgres_base.PostgresBase) (*testutil.EventsStorageTestResource, error) {
+	st, err := NewEventsStorage(baseSt)
+	if err != nil {
+		return nil, fmt.Errorf("NewEventsStorage: %w", err)
+	}
+
+	return &testutil.EventsStorageTestResource{
+		Storage: st,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -9,13 +10,18 @@ import (
 	"github.com/itiky/charge_scheduler/schema"
 )
 
+// Timestamps are stored in UTC as SQLite compares them as strings.
 type singleEvent struct {
-	Id            int64     `db:"rowid"`
-	Type          string    `db:"type"`
-	StartDateTime time.Time `db:"start_date_time"`
-	EndHours      uint      `db:"end_hours"`
-	EndMinutes    uint      `db:"end_minutes"`
-	CreatedAt     time.Time `db:"created_at"`
+	Id              int64      `db:"rowid"`
+	ChargePointId   int64      `db:"charge_point_id"`
+	Type            string     `db:"type"`
+	StartDateTime   time.Time  `db:"start_date_time"`
+	EndDateTime     time.Time  `db:"end_date_time"`
+	DurationSeconds int64      `db:"duration_seconds"`
+	OwnerRef        string     `db:"owner_ref"`
+	CreatedAt       time.Time  `db:"created_at"`
+	Version         int64      `db:"version"`
+	DeletedAt       *time.Time `db:"deleted_at"`
 }
 
 func (e singleEvent) ToSchema() (schema.SingleEvent, error) {
@@ -26,31 +32,42 @@ func (e singleEvent) ToSchema() (schema.SingleEvent, error) {
 
 	return schema.SingleEvent{
 		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
 		Type:          eType,
 		StartDateTime: e.StartDateTime,
-		EndHours:      e.EndHours,
-		EndMinutes:    e.EndMinutes,
+		Duration:      time.Duration(e.DurationSeconds) * time.Second,
+		OwnerRef:      e.OwnerRef,
 		CreatedAt:     e.CreatedAt,
+		Version:       e.Version,
+		DeletedAt:     e.DeletedAt,
 	}, nil
 }
 
 func newSingleEvent(obj schema.SingleEvent) (singleEvent, error) {
 	return singleEvent{
-		Type:          obj.Type.String(),
-		StartDateTime: obj.StartDateTime,
-		EndHours:      obj.EndHours,
-		EndMinutes:    obj.EndMinutes,
-		CreatedAt:     obj.CreatedAt,
+		ChargePointId:   obj.ChargePointId,
+		Type:            obj.Type.String(),
+		StartDateTime:   obj.StartDateTime.UTC(),
+		EndDateTime:     obj.EndDateTime().UTC(),
+		DurationSeconds: int64(obj.Duration / time.Second),
+		OwnerRef:        obj.OwnerRef,
+		CreatedAt:       obj.CreatedAt.UTC(),
+		Version:         obj.Version,
 	}, nil
 }
 
+// Active range bounds are NULL if unknown (events created before) and the end is NULL for endless rules.
 type periodicEvent struct {
-	Id         int64     `db:"rowid"`
-	Type       string    `db:"type"`
-	Rrule      string    `db:"rrule"`
-	EndHours   uint      `db:"end_hours"`
-	EndMinutes uint      `db:"end_minutes"`
-	CreatedAt  time.Time `db:"created_at"`
+	Id              int64      `db:"rowid"`
+	ChargePointId   int64      `db:"charge_point_id"`
+	Type            string     `db:"type"`
+	Rrule           string     `db:"rrule"`
+	DurationSeconds int64      `db:"duration_seconds"`
+	ActiveStart     *time.Time `db:"active_start"`
+	ActiveEnd       *time.Time `db:"active_end"`
+	CreatedAt       time.Time  `db:"created_at"`
+	Version         int64      `db:"version"`
+	DeletedAt       *time.Time `db:"deleted_at"`
 }
 
 func (e periodicEvent) ToSchema() (schema.PeriodicEvent, error) {
@@ -60,11 +77,13 @@ func (e periodicEvent) ToSchema() (schema.PeriodicEvent, error) {
 	}
 
 	obj := schema.PeriodicEvent{
-		Id:         e.Id,
-		Type:       eType,
-		EndHours:   e.EndHours,
-		EndMinutes: e.EndMinutes,
-		CreatedAt:  e.CreatedAt,
+		Id:            e.Id,
+		ChargePointId: e.ChargePointId,
+		Type:          eType,
+		Duration:      time.Duration(e.DurationSeconds) * time.Second,
+		CreatedAt:     e.CreatedAt,
+		Version:       e.Version,
+		DeletedAt:     e.DeletedAt,
 	}
 
 	r, err := rrule.StrToRRule(e.Rrule)
@@ -77,11 +96,127 @@ func (e periodicEvent) ToSchema() (schema.PeriodicEvent, error) {
 }
 
 func newPeriodicEvent(obj schema.PeriodicEvent) (periodicEvent, error) {
-	return periodicEvent{
-		Type:       obj.Type.String(),
-		Rrule:      obj.Rrule.String(),
-		EndHours:   obj.EndHours,
-		EndMinutes: obj.EndMinutes,
-		CreatedAt:  obj.CreatedAt,
-	}, nil
+	dbObj := periodicEvent{
+		ChargePointId:   obj.ChargePointId,
+		Type:            obj.Type.String(),
+		Rrule:           obj.Rrule.String(),
+		DurationSeconds: int64(obj.Duration / time.Second),
+		CreatedAt:       obj.CreatedAt.UTC(),
+		Version:         obj.Version,
+	}
+	dbObj.ActiveStart, dbObj.ActiveEnd = newPeriodicEventActiveRange(obj)
+
+	return dbObj, nil
+}
+
+// newPeriodicEventActiveRange returns the periodic event active range DB bounds.
+func newPeriodicEventActiveRange(obj schema.PeriodicEvent) (retStart, retEnd *time.Time) {
+	activeStart, activeEnd := obj.ActiveRange()
+
+	activeStart = activeStart.UTC()
+	retStart = &activeStart
+	if !activeEnd.IsZero() {
+		activeEnd = activeEnd.UTC()
+		retEnd = &activeEnd
+	}
+
+	return
+}
+
+type periodicEventException struct {
+	Id                      int64      `db:"rowid"`
+	PeriodicEventId         int64      `db:"periodic_event_id"`
+	OccurrenceStart         time.Time  `db:"occurrence_start"`
+	OverrideStart           *time.Time `db:"override_start"`
+	OverrideDurationSeconds int64      `db:"override_duration_seconds"`
+	CreatedAt               time.Time  `db:"created_at"`
+}
+
+func (e periodicEventException) ToSchema() schema.PeriodicEventException {
+	return schema.PeriodicEventException{
+		Id:               e.Id,
+		PeriodicEventId:  e.PeriodicEventId,
+		OccurrenceStart:  e.OccurrenceStart,
+		OverrideStart:    e.OverrideStart,
+		OverrideDuration: time.Duration(e.OverrideDurationSeconds) * time.Second,
+		CreatedAt:        e.CreatedAt,
+	}
+}
+
+func newPeriodicEventException(obj schema.PeriodicEventException) periodicEventException {
+	dbObj := periodicEventException{
+		PeriodicEventId:         obj.PeriodicEventId,
+		OccurrenceStart:         obj.OccurrenceStart.UTC(),
+		OverrideDurationSeconds: int64(obj.OverrideDuration / time.Second),
+		CreatedAt:               obj.CreatedAt.UTC(),
+	}
+	if obj.OverrideStart != nil {
+		overrideStart := obj.OverrideStart.UTC()
+		dbObj.OverrideStart = &overrideStart
+	}
+
+	return dbObj
+}
+
+// Snapshots are NULL if absent (the create / delete operations).
+type eventHistoryEntry struct {
+	Id            int64     `db:"rowid"`
+	EventId       int64     `db:"event_id"`
+	Periodic      bool      `db:"periodic"`
+	ChargePointId int64     `db:"charge_point_id"`
+	Operation     string    `db:"operation"`
+	Actor         string    `db:"actor"`
+	BeforeJSON    *string   `db:"before_json"`
+	AfterJSON     *string   `db:"after_json"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+func (e eventHistoryEntry) ToSchema() (schema.EventHistoryEntry, error) {
+	operation := schema.EventOperation(e.Operation)
+	if !operation.IsValid() {
+		return schema.EventHistoryEntry{}, fmt.Errorf("%s: invalid", "operation")
+	}
+
+	obj := schema.EventHistoryEntry{
+		Id:            e.Id,
+		EventId:       e.EventId,
+		Periodic:      e.Periodic,
+		ChargePointId: e.ChargePointId,
+		Operation:     operation,
+		Actor:         e.Actor,
+		CreatedAt:     e.CreatedAt,
+	}
+	if e.BeforeJSON != nil {
+		obj.Before = json.RawMessage(*e.BeforeJSON)
+	}
+	if e.AfterJSON != nil {
+		obj.After = json.RawMessage(*e.AfterJSON)
+	}
+
+	return obj, nil
+}
+
+func newEventHistoryEntry(obj schema.EventHistoryEntry) (eventHistoryEntry, error) {
+	if !obj.Operation.IsValid() {
+		return eventHistoryEntry{}, fmt.Errorf("%s: invalid", "operation")
+	}
+
+	dbObj := eventHistoryEntry{
+		EventId:       obj.EventId,
+		Periodic:      obj.Periodic,
+		ChargePointId: obj.ChargePointId,
+		Operation:     obj.Operation.String(),
+		Actor:         obj.Actor,
+		CreatedAt:     obj.CreatedAt.UTC(),
+	}
+	if obj.Before != nil {
+		before := string(obj.Before)
+		dbObj.BeforeJSON = &before
+	}
+	if obj.After != nil {
+		after := string(obj.After)
+		dbObj.AfterJSON = &after
+	}
+
+	return dbObj, nil
 }
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/rs/zerolog"
@@ -12,24 +13,69 @@ import (
 
 var _ events.EventsStorage = (*EventsStorage)(nil)
 
+// dbExecutor defines sqlx.DB / sqlx.Tx common methods used by the storage.
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
 type EventsStorage struct {
 	*sqlite_base.SQLiteBase
 	logger zerolog.Logger
+	// db is the base storage DB or an ongoing transaction (within RunInTx)
+	db   dbExecutor
+	inTx bool
+}
+
+func (s EventsStorage) RunInTx(ctx context.Context, fn func(txSt events.EventsStorage) error) error {
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		return fn(txSt)
+	})
 }
 
-// nolint:errcheck
 func (s EventsStorage) DropData(ctx context.Context) error {
-	tx, err := s.Db.BeginTx(ctx, nil)
+	return s.runInTx(ctx, func(txSt EventsStorage) error {
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM single_events"); err != nil {
+			return fmt.Errorf("tx.Exec (single_events): %w", err)
+		}
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM periodic_events"); err != nil {
+			return fmt.Errorf("tx.Exec (periodic_events): %w", err)
+		}
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM periodic_event_exceptions"); err != nil {
+			return fmt.Errorf("tx.Exec (periodic_event_exceptions): %w", err)
+		}
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM event_history"); err != nil {
+			return fmt.Errorf("tx.Exec (event_history): %w", err)
+		}
+		// IDs are reused only along with the history drop
+		if _, err := txSt.db.ExecContext(ctx, "DELETE FROM sqlite_sequence WHERE name IN ('single_events', 'periodic_events')"); err != nil {
+			return fmt.Errorf("tx.Exec (sqlite_sequence): %w", err)
+		}
+
+		return nil
+	})
+}
+
+// runInTx executes fn within a new transaction (or within the ongoing one for nested calls).
+// fn error is returned "as is" to preserve its type.
+// nolint:errcheck
+func (s EventsStorage) runInTx(ctx context.Context, fn func(txSt EventsStorage) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	tx, err := s.Db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("s.Db.BeginTx: %w", err)
+		return fmt.Errorf("s.Db.BeginTxx: %w", err)
 	}
 	defer tx.Rollback()
 
-	if _, err := tx.Exec("DELETE FROM single_events"); err != nil {
-		return fmt.Errorf("tx.Exec (single_events): %w", err)
-	}
-	if _, err := tx.Exec("DELETE FROM periodic_events"); err != nil {
-		return fmt.Errorf("tx.Exec (periodic_events): %w", err)
+	txSt := s
+	txSt.db, txSt.inTx = tx, true
+	if err := fn(txSt); err != nil {
+		return err
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -47,6 +93,16 @@ func NewEventsStorage(base *sqlite_base.SQLiteBase) (*EventsStorage, error) {
 	storage := &EventsStorage{
 		SQLiteBase: base,
 		logger:     base.Logger.With().Str("repository", "events").Logger(),
+		db:         base.Db,
+	}
+
+	// Events created before the active range was stored are matched by any range otherwise
+	cnt, err := storage.backfillPeriodicEventsActiveRange(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("periodic events active range backfill: %w", err)
+	}
+	if cnt > 0 {
+		storage.logger.Info().Int64("count", cnt).Msg("Periodic events active range backfilled")
 	}
 
 	return storage, nil
@@ -15,9 +15,9 @@ func (s EventsStorage) CreateSingleEvent(ctx context.Context, obj schema.SingleE
 		return
 	}
 
-	res, err := s.Db.NamedExecContext(ctx, "INSERT INTO single_events (type, start_date_time, end_hours, end_minutes, created_at) VALUES (:type, :start_date_time, :end_hours, :end_minutes, :created_at)", dbObj)
+	res, err := s.db.NamedExecContext(ctx, "INSERT INTO single_events (charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version) VALUES (:charge_point_id, :type, :start_date_time, :end_date_time, :duration_seconds, :owner_ref, :created_at, :version)", dbObj)
 	if err != nil {
-		retErr = fmt.Errorf("s.Db.NamedExecContext: %w", err)
+		retErr = fmt.Errorf("s.db.NamedExecContext: %w", err)
 		return
 	}
 
@@ -38,9 +38,54 @@ func (s EventsStorage) CreatePeriodicEvent(ctx context.Context, obj schema.Perio
 		return
 	}
 
-	res, err := s.Db.NamedExecContext(ctx, "INSERT INTO periodic_events (type, rrule, end_hours, end_minutes, created_at) VALUES (:type, :rrule, :end_hours, :end_minutes, :created_at)", dbObj)
+	res, err := s.db.NamedExecContext(ctx, "INSERT INTO periodic_events (charge_point_id, type, rrule, duration_seconds, active_start, active_end, created_at, version) VALUES (:charge_point_id, :type, :rrule, :duration_seconds, :active_start, :active_end, :created_at, :version)", dbObj)
 	if err != nil {
-		retErr = fmt.Errorf("s.Db.NamedExecContext: %w", err)
+		retErr = fmt.Errorf("s.db.NamedExecContext: %w", err)
+		return
+	}
+
+	resId, err := res.LastInsertId()
+	if err != nil {
+		retErr = fmt.Errorf("res.LastInsertId(): %w", err)
+		return
+	}
+	retId = resId
+
+	return
+}
+
+func (s EventsStorage) CreatePeriodicEventException(ctx context.Context, obj schema.PeriodicEventException) (retId int64, retErr error) {
+	dbObj := newPeriodicEventException(obj)
+
+	// Moved occurrence might extend the periodic event active range
+	retErr = s.runInTx(ctx, func(txSt EventsStorage) error {
+		res, err := txSt.db.NamedExecContext(ctx, "INSERT INTO periodic_event_exceptions (periodic_event_id, occurrence_start, override_start, override_duration_seconds, created_at) VALUES (:periodic_event_id, :occurrence_start, :override_start, :override_duration_seconds, :created_at)", dbObj)
+		if err != nil {
+			return fmt.Errorf("tx.NamedExec (periodic_event_exceptions): %w", err)
+		}
+
+		resId, err := res.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("res.LastInsertId(): %w", err)
+		}
+		retId = resId
+
+		return txSt.updatePeriodicEventActiveRange(ctx, obj.PeriodicEventId)
+	})
+
+	return
+}
+
+func (s EventsStorage) CreateEventHistoryEntry(ctx context.Context, obj schema.EventHistoryEntry) (retId int64, retErr error) {
+	dbObj, err := newEventHistoryEntry(obj)
+	if err != nil {
+		retErr = fmt.Errorf("obj marshal: %v: %w", err, common.ErrInvalidInput)
+		return
+	}
+
+	res, err := s.db.NamedExecContext(ctx, "INSERT INTO event_history (event_id, periodic, charge_point_id, operation, actor, before_json, after_json, created_at) VALUES (:event_id, :periodic, :charge_point_id, :operation, :actor, :before_json, :after_json, :created_at)", dbObj)
+	if err != nil {
+		retErr = fmt.Errorf("s.db.NamedExecContext: %w", err)
 		return
 	}
 
@@ -12,12 +12,12 @@ import (
 
 func (s EventsStorage) GetSingleEvent(ctx context.Context, id int64) (retObj *schema.SingleEvent, retErr error) {
 	dbObj := singleEvent{}
-	err := s.Db.GetContext(ctx, &dbObj, "SELECT rowid, type, start_date_time, end_hours, end_minutes, created_at FROM single_events WHERE rowid=?", id)
+	err := s.db.GetContext(ctx, &dbObj, "SELECT rowid, charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version, deleted_at FROM single_events WHERE rowid=? AND deleted_at IS NULL", id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return
 		}
-		retErr = fmt.Errorf("s.Db.GetContext: %w", err)
+		retErr = fmt.Errorf("s.db.GetContext: %w", err)
 		return
 	}
 
@@ -31,14 +31,34 @@ func (s EventsStorage) GetSingleEvent(ctx context.Context, id int64) (retObj *sc
 	return
 }
 
-func (s EventsStorage) GetSingleEventsWithinRange(ctx context.Context, rangeStart, rangeEnd time.Time) (retObjs []schema.SingleEvent, retErr error) {
+func (s EventsStorage) GetSingleEventsWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) (retObjs []schema.SingleEvent, retErr error) {
 	var dbObjs []singleEvent
-	err := s.Db.SelectContext(ctx, &dbObjs, "SELECT rowid, type, start_date_time, end_hours, end_minutes, created_at FROM single_events WHERE start_date_time >= ? AND start_date_time <= ?", rangeStart, rangeEnd)
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version, deleted_at FROM single_events WHERE charge_point_id = ? AND start_date_time >= ? AND start_date_time <= ? AND (? OR deleted_at IS NULL)", chargePointId, rangeStart.UTC(), rangeEnd.UTC(), includeDeleted)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return
 		}
-		retErr = fmt.Errorf("s.Db.SelectContext: %w", err)
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	objs, err := s.unmarshalSingleEvents(dbObjs)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return objs, nil
+}
+
+func (s EventsStorage) GetSingleEventsIntersectingRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time, includeDeleted bool) (retObjs []schema.SingleEvent, retErr error) {
+	var dbObjs []singleEvent
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version, deleted_at FROM single_events WHERE charge_point_id = ? AND start_date_time < ? AND end_date_time > ? AND (? OR deleted_at IS NULL) ORDER BY start_date_time", chargePointId, rangeEnd.UTC(), rangeStart.UTC(), includeDeleted)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
 		return
 	}
 
@@ -53,12 +73,12 @@ func (s EventsStorage) GetSingleEventsWithinRange(ctx context.Context, rangeStar
 
 func (s EventsStorage) GetPeriodicEvent(ctx context.Context, id int64) (retObj *schema.PeriodicEvent, retErr error) {
 	dbObj := periodicEvent{}
-	err := s.Db.GetContext(ctx, &dbObj, "SELECT rowid, type, rrule, end_hours, end_minutes, created_at FROM periodic_events WHERE rowid=?", id)
+	err := s.db.GetContext(ctx, &dbObj, "SELECT rowid, charge_point_id, type, rrule, duration_seconds, created_at, version, deleted_at FROM periodic_events WHERE rowid=? AND deleted_at IS NULL", id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return
 		}
-		retErr = fmt.Errorf("s.Db.GetContext: %w", err)
+		retErr = fmt.Errorf("s.db.GetContext: %w", err)
 		return
 	}
 
@@ -67,19 +87,104 @@ func (s EventsStorage) GetPeriodicEvent(ctx context.Context, id int64) (retObj *
 		retErr = fmt.Errorf("obj unmarshal: %w", err)
 		return
 	}
+
+	exceptions, err := s.getPeriodicEventExceptions(ctx, "periodic_event_id = ?", id)
+	if err != nil {
+		retErr = err
+		return
+	}
+	obj.Exceptions = exceptions[obj.Id]
 	retObj = &obj
 
 	return
 }
 
-func (s EventsStorage) GetAllPeriodicEvents(ctx context.Context) (retObjs []schema.PeriodicEvent, retErr error) {
+func (s EventsStorage) GetAllPeriodicEvents(ctx context.Context, chargePointId int64, includeDeleted bool) (retObjs []schema.PeriodicEvent, retErr error) {
+	var dbObjs []periodicEvent
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, rrule, duration_seconds, created_at, version, deleted_at FROM periodic_events WHERE charge_point_id = ? AND (? OR deleted_at IS NULL)", chargePointId, includeDeleted)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	objs, err := s.unmarshalPeriodicEvents(dbObjs)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	exceptions, err := s.getPeriodicEventExceptions(ctx, "periodic_event_id IN (SELECT rowid FROM periodic_events WHERE charge_point_id = ? AND (? OR deleted_at IS NULL))", chargePointId, includeDeleted)
+	if err != nil {
+		retErr = err
+		return
+	}
+	for i := range objs {
+		objs[i].Exceptions = exceptions[objs[i].Id]
+	}
+
+	return objs, nil
+}
+
+func (s EventsStorage) GetSingleEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) (retObjs []schema.SingleEvent, retErr error) {
+	var dbObjs []singleEvent
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, start_date_time, end_date_time, duration_seconds, owner_ref, created_at, version, deleted_at FROM single_events WHERE deleted_at < ? ORDER BY rowid", deletedBefore.UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	objs, err := s.unmarshalSingleEvents(dbObjs)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return objs, nil
+}
+
+func (s EventsStorage) GetPeriodicEventsDeletedBefore(ctx context.Context, deletedBefore time.Time) (retObjs []schema.PeriodicEvent, retErr error) {
+	var dbObjs []periodicEvent
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, rrule, duration_seconds, created_at, version, deleted_at FROM periodic_events WHERE deleted_at < ? ORDER BY rowid", deletedBefore.UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	objs, err := s.unmarshalPeriodicEvents(dbObjs)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	exceptions, err := s.getPeriodicEventExceptions(ctx, "periodic_event_id IN (SELECT rowid FROM periodic_events WHERE deleted_at < ?)", deletedBefore.UTC())
+	if err != nil {
+		retErr = err
+		return
+	}
+	for i := range objs {
+		objs[i].Exceptions = exceptions[objs[i].Id]
+	}
+
+	return objs, nil
+}
+
+func (s EventsStorage) GetPeriodicEventsActiveWithin(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (retObjs []schema.PeriodicEvent, retErr error) {
 	var dbObjs []periodicEvent
-	err := s.Db.SelectContext(ctx, &dbObjs, "SELECT rowid, type, rrule, end_hours, end_minutes, created_at FROM periodic_events")
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, charge_point_id, type, rrule, duration_seconds, created_at, version, deleted_at FROM periodic_events WHERE charge_point_id = ? AND (active_end IS NULL OR active_end >= ?) AND (active_start IS NULL OR active_start <= ?) AND deleted_at IS NULL", chargePointId, rangeStart.UTC(), rangeEnd.UTC())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return
 		}
-		retErr = fmt.Errorf("s.Db.SelectContext: %w", err)
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
 		return
 	}
 
@@ -89,9 +194,79 @@ func (s EventsStorage) GetAllPeriodicEvents(ctx context.Context) (retObjs []sche
 		return
 	}
 
+	exceptions, err := s.getPeriodicEventExceptions(ctx, "periodic_event_id IN (SELECT rowid FROM periodic_events WHERE charge_point_id = ? AND (active_end IS NULL OR active_end >= ?) AND (active_start IS NULL OR active_start <= ?) AND deleted_at IS NULL)", chargePointId, rangeStart.UTC(), rangeEnd.UTC())
+	if err != nil {
+		retErr = err
+		return
+	}
+	for i := range objs {
+		objs[i].Exceptions = exceptions[objs[i].Id]
+	}
+
+	return objs, nil
+}
+
+func (s EventsStorage) GetPeriodicEventException(ctx context.Context, id int64) (retObj *schema.PeriodicEventException, retErr error) {
+	dbObj := periodicEventException{}
+	err := s.db.GetContext(ctx, &dbObj, "SELECT rowid, periodic_event_id, occurrence_start, override_start, override_duration_seconds, created_at FROM periodic_event_exceptions WHERE rowid=?", id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.GetContext: %w", err)
+		return
+	}
+
+	obj := dbObj.ToSchema()
+	retObj = &obj
+
+	return
+}
+
+// getPeriodicEventExceptions returns exceptions filtered by the where condition grouped by the periodic event ID (sorted by occurrence start).
+func (s EventsStorage) getPeriodicEventExceptions(ctx context.Context, where string, args ...interface{}) (map[int64][]schema.PeriodicEventException, error) {
+	var dbObjs []periodicEventException
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, periodic_event_id, occurrence_start, override_start, override_duration_seconds, created_at FROM periodic_event_exceptions WHERE "+where+" ORDER BY occurrence_start", args...)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("s.db.SelectContext (periodic_event_exceptions): %w", err)
+	}
+
+	objs := make(map[int64][]schema.PeriodicEventException)
+	for _, dbObj := range dbObjs {
+		objs[dbObj.PeriodicEventId] = append(objs[dbObj.PeriodicEventId], dbObj.ToSchema())
+	}
+
 	return objs, nil
 }
 
+func (s EventsStorage) GetEventHistory(ctx context.Context, eventId int64, periodic bool) (retObjs []schema.EventHistoryEntry, retErr error) {
+	var dbObjs []eventHistoryEntry
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, event_id, periodic, charge_point_id, operation, actor, before_json, after_json, created_at FROM event_history WHERE periodic = ? AND event_id = ? ORDER BY rowid", periodic, eventId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	return s.unmarshalEventHistoryEntries(dbObjs)
+}
+
+func (s EventsStorage) GetEventHistoryWithinRange(ctx context.Context, chargePointId int64, rangeStart, rangeEnd time.Time) (retObjs []schema.EventHistoryEntry, retErr error) {
+	var dbObjs []eventHistoryEntry
+	err := s.db.SelectContext(ctx, &dbObjs, "SELECT rowid, event_id, periodic, charge_point_id, operation, actor, before_json, after_json, created_at FROM event_history WHERE charge_point_id = ? AND created_at >= ? AND created_at <= ? ORDER BY rowid", chargePointId, rangeStart.UTC(), rangeEnd.UTC())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return
+		}
+		retErr = fmt.Errorf("s.db.SelectContext: %w", err)
+		return
+	}
+
+	return s.unmarshalEventHistoryEntries(dbObjs)
+}
+
 func (s EventsStorage) unmarshalSingleEvents(dbObjs []singleEvent) (retObjs []schema.SingleEvent, retErr error) {
 	retObjs = make([]schema.SingleEvent, 0, len(dbObjs))
 	for i, dbObj := range dbObjs {
@@ -119,3 +294,17 @@ func (s EventsStorage) unmarshalPeriodicEvents(dbObjs []periodicEvent) (retObjs
 
 	return
 }
+
+func (s EventsStorage) unmarshalEventHistoryEntries(dbObjs []eventHistoryEntry) (retObjs []schema.EventHistoryEntry, retErr error) {
+	retObjs = make([]schema.EventHistoryEntry, 0, len(dbObjs))
+	for i, dbObj := range dbObjs {
+		obj, err := dbObj.ToSchema()
+		if err != nil {
+			retErr = fmt.Errorf("dbObj[%d] unmarshal: %w", i, err)
+			return
+		}
+		retObjs = append(retObjs, obj)
+	}
+
+	return
+}
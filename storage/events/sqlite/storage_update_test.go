@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/sqlite_base"
+)
+
+// TestEventsStorage_PeriodicEventsActiveRangeBackfill checks the active range of events created before it was stored.
+func TestEventsStorage_PeriodicEventsActiveRangeBackfill(t *testing.T) {
+	baseSt, err := sqlite_base.SetupTempSQLiteBase(t.TempDir())
+	require.NoError(t, err)
+	defer baseSt.Close()
+	ctx := context.TODO()
+
+	targetSt, err := NewEventsStorage(baseSt)
+	require.NoError(t, err)
+
+	// Init fixtures
+	// 01.01.2020 09:00 - 15:00 daily x10
+	// 05.01.2020 12:30 - 14:30 weekly (endless)
+	// Active ranges are dropped (events created before 09_periodic_event_active_range)
+	{
+		limitedRule, err := rrule.NewRRule(rrule.ROption{
+			Freq:    rrule.DAILY,
+			Count:   10,
+			Dtstart: time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		endlessRule, err := rrule.NewRRule(rrule.ROption{
+			Freq:    rrule.WEEKLY,
+			Dtstart: time.Date(2020, 1, 5, 12, 30, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		_, err = targetSt.CreatePeriodicEvent(ctx, schema.PeriodicEvent{ChargePointId: schema.DefaultChargePointId, Type: schema.SingleEventTypeAvailable, Rrule: *limitedRule, Duration: 6 * time.Hour, Version: 1})
+		require.NoError(t, err)
+		_, err = targetSt.CreatePeriodicEvent(ctx, schema.PeriodicEvent{ChargePointId: schema.DefaultChargePointId, Type: schema.SingleEventTypeAvailable, Rrule: *endlessRule, Duration: 2 * time.Hour, Version: 1})
+		require.NoError(t, err)
+
+		baseSt.Db.MustExec("UPDATE periodic_events SET active_start=NULL, active_end=NULL")
+
+		events, err := targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+	}
+
+	// ok: backfilled on the storage init
+	{
+		targetSt, err := NewEventsStorage(baseSt)
+		require.NoError(t, err)
+
+		var nullStartsCnt int
+		require.NoError(t, baseSt.Db.Get(&nullStartsCnt, "SELECT count(*) FROM periodic_events WHERE active_start IS NULL"))
+		require.Zero(t, nullStartsCnt)
+
+		events, err := targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2021, 1, 8, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, 2*time.Hour, events[0].Duration)
+
+		events, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, 6*time.Hour, events[0].Duration)
+	}
+}
@@ -0,0 +1,47 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// EventsStorageTestSuite is the events.EventsStorage conformance test suite shared by the storage implementations.
+type EventsStorageTestSuite struct {
+	suite.Suite
+	ctx         context.Context
+	r           *EventsStorageTestResource
+	newResource func(t *testing.T) (*EventsStorageTestResource, error)
+}
+
+func (s *EventsStorageTestSuite) SetupSuite() {
+	r, err := s.newResource(s.T())
+	if err != nil {
+		panic(fmt.Errorf("resource init: %w", err))
+	}
+
+	s.ctx = context.TODO()
+	s.r = r
+}
+
+func (s *EventsStorageTestSuite) SetupTest() {
+	if err := s.r.Storage.DropData(s.ctx); err != nil {
+		panic(fmt.Errorf("storage cleanup: %w", err))
+	}
+}
+
+// NewEventsStorageTestSuite creates the suite for the storage built by newResource (called once per suite run,
+// the storage should be closed with t.Cleanup).
+func NewEventsStorageTestSuite(newResource func(t *testing.T) (*EventsStorageTestResource, error)) *EventsStorageTestSuite {
+	return &EventsStorageTestSuite{
+		newResource: newResource,
+	}
+}
+
+// testNow returns the current UTC time truncated to the microseconds (PostgreSQL timestamps precision).
+func testNow() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
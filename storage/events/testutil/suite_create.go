@@ -0,0 +1,207 @@
+package testutil
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *EventsStorageTestSuite) Test_SingleEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	events := []schema.SingleEvent{
+		{
+			Id:            1,
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeAvailable,
+			StartDateTime: now,
+			Duration:      150 * time.Minute,
+			CreatedAt:     now,
+		},
+		{
+			Id:            2,
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeOccupied,
+			StartDateTime: now.Add(1 * time.Minute),
+			Duration:      time.Hour,
+			OwnerRef:      "driver-42",
+			CreatedAt:     now,
+		},
+	}
+
+	// ok: GetSingleEvent: non-existing
+	{
+		res, err := targetSt.GetSingleEvent(ctx, 1)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+
+	// ok: CreateSingleEvent / GetSingleEvent
+	{
+		for _, event := range events {
+			id, err := targetSt.CreateSingleEvent(ctx, event)
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+
+			res, err := targetSt.GetSingleEvent(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, event, *res)
+		}
+	}
+
+	// ok: GetSingleEventsWithinRange: empty
+	{
+		res, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, now.Add(5*time.Minute), now.Add(10*time.Minute), false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: GetSingleEventsWithinRange: other charge point
+	{
+		res, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId+1, now, now.Add(10*time.Minute), false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: GetSingleEventsWithinRange: filtered
+	{
+		res, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, now, now.Add(30*time.Second), false)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Equal(t, events[0:1], res)
+	}
+
+	// ok: GetSingleEventsIntersectingRange: started before the range
+	{
+		res, err := targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(2*time.Hour), now.Add(3*time.Hour), false)
+		require.NoError(t, err)
+		require.Equal(t, events[0:1], res)
+
+		res, err = targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(30*time.Minute), now.Add(31*time.Minute), false)
+		require.NoError(t, err)
+		require.Equal(t, events, res)
+	}
+
+	// ok: GetSingleEventsIntersectingRange: touching events are not included
+	{
+		res, err := targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(150*time.Minute), now.Add(3*time.Hour), false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		res, err = targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(-time.Hour), now.Add(time.Minute), false)
+		require.NoError(t, err)
+		require.Equal(t, events[0:1], res)
+	}
+}
+
+func (s *EventsStorageTestSuite) Test_PeriodicEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+
+	rule1, err := rrule.NewRRule(rrule.ROption{
+		Freq:    rrule.DAILY,
+		Count:   10,
+		Dtstart: time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	rule2, err := rrule.NewRRule(rrule.ROption{
+		Freq:    rrule.WEEKLY,
+		Count:   10,
+		Dtstart: time.Date(2020, 1, 5, 12, 30, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	events := []schema.PeriodicEvent{
+		{
+			Id:            1,
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeAvailable,
+			Rrule:         *rule1,
+			Duration:      6 * time.Hour,
+			CreatedAt:     now,
+		},
+		{
+			Id:            2,
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeOccupied,
+			Rrule:         *rule2,
+			Duration:      26 * time.Hour,
+			CreatedAt:     now,
+		},
+	}
+
+	// ok: GetPeriodicEvent: non-existing
+	{
+		res, err := targetSt.GetPeriodicEvent(ctx, 1)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+
+	// ok: CreatePeriodicEvent / GetPeriodicEvent
+	{
+		for _, event := range events {
+			id, err := targetSt.CreatePeriodicEvent(ctx, event)
+			require.NoError(t, err)
+			require.NotEmpty(t, id)
+
+			res, err := targetSt.GetPeriodicEvent(ctx, id)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, event, *res)
+		}
+	}
+
+	// ok: GetAllPeriodicEvents
+	{
+		res, err := targetSt.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.ElementsMatch(t, events, res)
+	}
+
+	// ok: GetAllPeriodicEvents: other charge point
+	{
+		res, err := targetSt.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId+1, false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: GetPeriodicEventsActiveWithin
+	{
+		// Before the first occurrence
+		res, err := targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2019, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		// After the rule1 last occurrence (10.01.2020 09:00 - 15:00)
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2020, 1, 11, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Equal(t, events[1:2], res)
+
+		// Touching the rule2 last occurrence end (09.03.2020 14:30)
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2020, 3, 9, 14, 30, 0, 0, time.UTC), time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Equal(t, events[1:2], res)
+
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, time.Date(2020, 3, 9, 14, 31, 0, 0, time.UTC), time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId+1, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+}
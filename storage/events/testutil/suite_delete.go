@@ -0,0 +1,349 @@
+package testutil
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *EventsStorageTestSuite) Test_SoftDeleteRestoreSingleEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	rangeStart, rangeEnd := now.Add(-time.Hour), now.Add(time.Hour)
+
+	event := schema.SingleEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeOccupied,
+		StartDateTime: now,
+		Duration:      30 * time.Minute,
+		CreatedAt:     now,
+	}
+	id, err := targetSt.CreateSingleEvent(ctx, event)
+	require.NoError(t, err)
+	event.Id = id
+
+	deletedAt := now.Add(time.Minute)
+	deletedEvent := event
+	deletedEvent.DeletedAt = &deletedAt
+
+	// ok: DeleteSingleEvent
+	{
+		require.NoError(t, targetSt.DeleteSingleEvent(ctx, id, deletedAt))
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.Nil(t, res)
+
+		resList, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, false)
+		require.NoError(t, err)
+		require.Empty(t, resList)
+
+		resList, err = targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, false)
+		require.NoError(t, err)
+		require.Empty(t, resList)
+	}
+
+	// ok: GetSingleEventsWithinRange / GetSingleEventsIntersectingRange: includeDeleted
+	{
+		resList, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, true)
+		require.NoError(t, err)
+		require.Equal(t, []schema.SingleEvent{deletedEvent}, resList)
+
+		// started before the range
+		resList, err = targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(10*time.Minute), rangeEnd, true)
+		require.NoError(t, err)
+		require.Equal(t, []schema.SingleEvent{deletedEvent}, resList)
+
+		// touching
+		resList, err = targetSt.GetSingleEventsIntersectingRange(ctx, schema.DefaultChargePointId, now.Add(30*time.Minute), rangeEnd, true)
+		require.NoError(t, err)
+		require.Empty(t, resList)
+	}
+
+	// fail: soft-deleted event update / delete
+	{
+		err := targetSt.UpdateSingleEvent(ctx, event)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSt.DeleteSingleEvent(ctx, id, deletedAt)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: RestoreSingleEvent
+	{
+		require.NoError(t, targetSt.RestoreSingleEvent(ctx, id))
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+	}
+
+	// fail: RestoreSingleEvent: not deleted / non-existing
+	{
+		err := targetSt.RestoreSingleEvent(ctx, id)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSt.RestoreSingleEvent(ctx, id+1)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
+
+func (s *EventsStorageTestSuite) Test_SoftDeleteRestorePeriodicEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	dtStart := time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)
+	rule, err := rrule.NewRRule(rrule.ROption{Freq: rrule.WEEKLY, Dtstart: dtStart})
+	require.NoError(t, err)
+
+	event := schema.PeriodicEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeAvailable,
+		Rrule:         *rule,
+		Duration:      4 * time.Hour,
+		CreatedAt:     now,
+	}
+	id, err := targetSt.CreatePeriodicEvent(ctx, event)
+	require.NoError(t, err)
+	event.Id = id
+
+	deletedAt := now.Add(time.Minute)
+
+	// ok: DeletePeriodicEvent
+	{
+		require.NoError(t, targetSt.DeletePeriodicEvent(ctx, id, deletedAt))
+
+		res, err := targetSt.GetPeriodicEvent(ctx, id)
+		require.NoError(t, err)
+		require.Nil(t, res)
+
+		resList, err := targetSt.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Empty(t, resList)
+
+		resList, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, dtStart, dtStart.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, resList)
+
+		err = targetSt.UpdatePeriodicEvent(ctx, event)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: GetAllPeriodicEvents: includeDeleted
+	{
+		resList, err := targetSt.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, true)
+		require.NoError(t, err)
+		require.Len(t, resList, 1)
+		require.Equal(t, id, resList[0].Id)
+		require.NotNil(t, resList[0].DeletedAt)
+		require.True(t, deletedAt.Equal(*resList[0].DeletedAt))
+	}
+
+	// ok: RestorePeriodicEvent
+	{
+		require.NoError(t, targetSt.RestorePeriodicEvent(ctx, id))
+
+		res, err := targetSt.GetPeriodicEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+
+		err = targetSt.RestorePeriodicEvent(ctx, id)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
+
+func (s *EventsStorageTestSuite) Test_PurgeDeletedEvents() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	rangeStart, rangeEnd := now.Add(-time.Hour), now.Add(time.Hour)
+
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		id, err := targetSt.CreateSingleEvent(ctx, schema.SingleEvent{
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeOccupied,
+			StartDateTime: now.Add(time.Duration(i) * 10 * time.Minute),
+			Duration:      5 * time.Minute,
+			CreatedAt:     now,
+		})
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	// Deleted 2 and 1 days ago, the last one is kept
+	require.NoError(t, targetSt.DeleteSingleEvent(ctx, ids[0], now.Add(-48*time.Hour)))
+	require.NoError(t, targetSt.DeleteSingleEvent(ctx, ids[1], now.Add(-24*time.Hour)))
+
+	// ok: nothing to purge
+	{
+		cnt, err := targetSt.PurgeDeletedEvents(ctx, now.Add(-72*time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, cnt)
+	}
+
+	// ok: only events deleted before are purged
+	{
+		cnt, err := targetSt.PurgeDeletedEvents(ctx, now.Add(-36*time.Hour))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, cnt)
+
+		resList, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, true)
+		require.NoError(t, err)
+		require.Len(t, resList, 2)
+		require.Equal(t, ids[1], resList[0].Id)
+		require.Equal(t, ids[2], resList[1].Id)
+
+		err = targetSt.RestoreSingleEvent(ctx, ids[0])
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
+
+func (s *EventsStorageTestSuite) Test_GetEventsDeletedBefore() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	// Single events deleted 2 and 1 days ago, the last one is kept
+	// Periodic event (with an exception) deleted 2 days ago, the other one is kept
+	now := testNow()
+
+	var singleIds []int64
+	for i := 0; i < 3; i++ {
+		id, err := targetSt.CreateSingleEvent(ctx, schema.SingleEvent{
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeOccupied,
+			StartDateTime: now.Add(time.Duration(i) * 10 * time.Minute),
+			Duration:      5 * time.Minute,
+			CreatedAt:     now,
+		})
+		require.NoError(t, err)
+		singleIds = append(singleIds, id)
+	}
+	require.NoError(t, targetSt.DeleteSingleEvent(ctx, singleIds[0], now.Add(-48*time.Hour)))
+	require.NoError(t, targetSt.DeleteSingleEvent(ctx, singleIds[1], now.Add(-24*time.Hour)))
+
+	var periodicIds []int64
+	for i := 0; i < 2; i++ {
+		rule, err := rrule.NewRRule(rrule.ROption{Freq: rrule.WEEKLY, Dtstart: now.Add(time.Duration(i) * 24 * time.Hour)})
+		require.NoError(t, err)
+
+		id, err := targetSt.CreatePeriodicEvent(ctx, schema.PeriodicEvent{
+			ChargePointId: schema.DefaultChargePointId,
+			Type:          schema.SingleEventTypeAvailable,
+			Rrule:         *rule,
+			Duration:      time.Hour,
+			CreatedAt:     now,
+		})
+		require.NoError(t, err)
+		periodicIds = append(periodicIds, id)
+	}
+	_, err := targetSt.CreatePeriodicEventException(ctx, schema.PeriodicEventException{
+		PeriodicEventId: periodicIds[0],
+		OccurrenceStart: now.Add(7 * 24 * time.Hour),
+		CreatedAt:       now,
+	})
+	require.NoError(t, err)
+	require.NoError(t, targetSt.DeletePeriodicEvent(ctx, periodicIds[0], now.Add(-48*time.Hour)))
+
+	// ok: nothing deleted before
+	{
+		sEvents, err := targetSt.GetSingleEventsDeletedBefore(ctx, now.Add(-72*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, sEvents)
+
+		pEvents, err := targetSt.GetPeriodicEventsDeletedBefore(ctx, now.Add(-72*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, pEvents)
+	}
+
+	// ok: only events deleted before are listed
+	{
+		sEvents, err := targetSt.GetSingleEventsDeletedBefore(ctx, now.Add(-12*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, sEvents, 2)
+		require.Equal(t, singleIds[0], sEvents[0].Id)
+		require.Equal(t, singleIds[1], sEvents[1].Id)
+		require.NotNil(t, sEvents[0].DeletedAt)
+
+		pEvents, err := targetSt.GetPeriodicEventsDeletedBefore(ctx, now.Add(-12*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, pEvents, 1)
+		require.Equal(t, periodicIds[0], pEvents[0].Id)
+		require.NotNil(t, pEvents[0].DeletedAt)
+		require.Len(t, pEvents[0].Exceptions, 1)
+	}
+}
+
+// Test_PurgedEventIdsNotReused checks that IDs of purged events are not given to new ones (the history is keyed by them).
+func (s *EventsStorageTestSuite) Test_PurgedEventIdsNotReused() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+
+	rule, err := rrule.NewRRule(rrule.ROption{
+		Freq:    rrule.WEEKLY,
+		Dtstart: now,
+	})
+	require.NoError(t, err)
+
+	singleEvent := schema.SingleEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeOccupied,
+		StartDateTime: now,
+		Duration:      time.Hour,
+		CreatedAt:     now,
+		Version:       1,
+	}
+	periodicEvent := schema.PeriodicEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeAvailable,
+		Rrule:         *rule,
+		Duration:      time.Hour,
+		CreatedAt:     now,
+		Version:       1,
+	}
+
+	// The top IDs are purged
+	purgedSingleId, err := targetSt.CreateSingleEvent(ctx, singleEvent)
+	require.NoError(t, err)
+	purgedPeriodicId, err := targetSt.CreatePeriodicEvent(ctx, periodicEvent)
+	require.NoError(t, err)
+
+	require.NoError(t, targetSt.DeleteSingleEvent(ctx, purgedSingleId, now))
+	require.NoError(t, targetSt.DeletePeriodicEvent(ctx, purgedPeriodicId, now))
+	cnt, err := targetSt.PurgeDeletedEvents(ctx, now.Add(time.Second))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, cnt)
+
+	// ok: new events get new IDs
+	{
+		singleId, err := targetSt.CreateSingleEvent(ctx, singleEvent)
+		require.NoError(t, err)
+		require.Greater(t, singleId, purgedSingleId)
+
+		periodicId, err := targetSt.CreatePeriodicEvent(ctx, periodicEvent)
+		require.NoError(t, err)
+		require.Greater(t, periodicId, purgedPeriodicId)
+	}
+}
@@ -0,0 +1,151 @@
+package testutil
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *EventsStorageTestSuite) Test_PeriodicEventExceptions() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	dtStart := time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)
+	rule, err := rrule.NewRRule(rrule.ROption{Freq: rrule.WEEKLY, Dtstart: dtStart})
+	require.NoError(t, err)
+
+	eventId, err := targetSt.CreatePeriodicEvent(ctx, schema.PeriodicEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeAvailable,
+		Rrule:         *rule,
+		Duration:      4 * time.Hour,
+		CreatedAt:     testNow(),
+	})
+	require.NoError(t, err)
+
+	overrideStart := dtStart.Add(8 * 24 * time.Hour)
+	skipException := schema.PeriodicEventException{
+		PeriodicEventId: eventId,
+		OccurrenceStart: dtStart.Add(14 * 24 * time.Hour),
+		CreatedAt:       testNow(),
+	}
+	overrideException := schema.PeriodicEventException{
+		PeriodicEventId:  eventId,
+		OccurrenceStart:  dtStart.Add(7 * 24 * time.Hour),
+		OverrideStart:    &overrideStart,
+		OverrideDuration: 3 * time.Hour,
+		CreatedAt:        testNow(),
+	}
+
+	// ok: CreatePeriodicEventException
+	{
+		id, err := targetSt.CreatePeriodicEventException(ctx, skipException)
+		require.NoError(t, err)
+		skipException.Id = id
+
+		id, err = targetSt.CreatePeriodicEventException(ctx, overrideException)
+		require.NoError(t, err)
+		overrideException.Id = id
+	}
+
+	// fail: CreatePeriodicEventException: duplicated occurrence
+	{
+		_, err := targetSt.CreatePeriodicEventException(ctx, skipException)
+		require.Error(t, err)
+	}
+
+	// ok: GetPeriodicEventException
+	{
+		res, err := targetSt.GetPeriodicEventException(ctx, overrideException.Id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, overrideException, *res)
+
+		res, err = targetSt.GetPeriodicEventException(ctx, overrideException.Id+10)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+
+	// ok: exceptions are read with periodic events (sorted by occurrence)
+	{
+		res, err := targetSt.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, []schema.PeriodicEventException{overrideException, skipException}, res.Exceptions)
+
+		resList, err := targetSt.GetAllPeriodicEvents(ctx, schema.DefaultChargePointId, false)
+		require.NoError(t, err)
+		require.Len(t, resList, 1)
+		require.Equal(t, []schema.PeriodicEventException{overrideException, skipException}, resList[0].Exceptions)
+	}
+
+	// ok: DeletePeriodicEventException
+	{
+		require.NoError(t, targetSt.DeletePeriodicEventException(ctx, skipException.Id))
+
+		err := targetSt.DeletePeriodicEventException(ctx, skipException.Id)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		res, err := targetSt.GetPeriodicEvent(ctx, eventId)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, []schema.PeriodicEventException{overrideException}, res.Exceptions)
+	}
+
+	// ok: moved occurrence extends the active range
+	{
+		earlyStart := dtStart.Add(-2 * 24 * time.Hour)
+		earlyException := schema.PeriodicEventException{
+			PeriodicEventId:  eventId,
+			OccurrenceStart:  dtStart.Add(21 * 24 * time.Hour),
+			OverrideStart:    &earlyStart,
+			OverrideDuration: time.Hour,
+			CreatedAt:        testNow(),
+		}
+		rangeStart, rangeEnd := dtStart.Add(-3*24*time.Hour), dtStart.Add(-24*time.Hour)
+
+		res, err := targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Empty(t, res)
+
+		id, err := targetSt.CreatePeriodicEventException(ctx, earlyException)
+		require.NoError(t, err)
+
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.Len(t, res[0].Exceptions, 2)
+
+		require.NoError(t, targetSt.DeletePeriodicEventException(ctx, id))
+
+		res, err = targetSt.GetPeriodicEventsActiveWithin(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: DeletePeriodicEvent keeps its exceptions (for the restore), PurgeDeletedEvents removes them
+	{
+		deletedAt := testNow()
+		require.NoError(t, targetSt.DeletePeriodicEvent(ctx, eventId, deletedAt))
+
+		res, err := targetSt.GetPeriodicEventException(ctx, overrideException.Id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		cnt, err := targetSt.PurgeDeletedEvents(ctx, deletedAt.Add(time.Second))
+		require.NoError(t, err)
+		require.EqualValues(t, 1, cnt)
+
+		res, err = targetSt.GetPeriodicEventException(ctx, overrideException.Id)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+}
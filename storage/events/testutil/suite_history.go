@@ -0,0 +1,138 @@
+package testutil
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/events"
+)
+
+func (s *EventsStorageTestSuite) Test_EventHistory() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	event := schema.SingleEvent{
+		Id:            1,
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeOccupied,
+		StartDateTime: time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC),
+		Duration:      time.Hour,
+		CreatedAt:     now,
+	}
+	eventBefore, err := json.Marshal(event)
+	require.NoError(t, err)
+	event.Duration = 2 * time.Hour
+	eventAfter, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	entries := []schema.EventHistoryEntry{
+		{
+			EventId:       1,
+			ChargePointId: schema.DefaultChargePointId,
+			Operation:     schema.EventOperationCreate,
+			Actor:         "alice",
+			After:         eventBefore,
+			CreatedAt:     now,
+		},
+		{
+			EventId:       1,
+			Periodic:      true,
+			ChargePointId: schema.DefaultChargePointId,
+			Operation:     schema.EventOperationDelete,
+			Actor:         common.ActorUnknown,
+			Before:        json.RawMessage(`{"id":1}`),
+			CreatedAt:     now.Add(time.Minute),
+		},
+		{
+			EventId:       1,
+			ChargePointId: schema.DefaultChargePointId,
+			Operation:     schema.EventOperationUpdate,
+			Actor:         "bob",
+			Before:        eventBefore,
+			After:         eventAfter,
+			CreatedAt:     now.Add(2 * time.Minute),
+		},
+		{
+			EventId:       1,
+			ChargePointId: 2,
+			Operation:     schema.EventOperationDelete,
+			Actor:         "bob",
+			Before:        eventAfter,
+			CreatedAt:     now.Add(2 * time.Minute),
+		},
+	}
+
+	// ok: CreateEventHistoryEntry
+	{
+		for i := range entries {
+			id, err := targetSt.CreateEventHistoryEntry(ctx, entries[i])
+			require.NoError(t, err)
+			require.EqualValues(t, i+1, id)
+			entries[i].Id = id
+		}
+	}
+
+	// fail: CreateEventHistoryEntry: invalid operation
+	{
+		entry := entries[0]
+		entry.Operation = "move"
+		_, err := targetSt.CreateEventHistoryEntry(ctx, entry)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// ok: GetEventHistory (single / periodic events have separate IDs)
+	{
+		res, err := targetSt.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Equal(t, []schema.EventHistoryEntry{entries[0], entries[2], entries[3]}, res)
+
+		res, err = targetSt.GetEventHistory(ctx, 1, true)
+		require.NoError(t, err)
+		require.Equal(t, []schema.EventHistoryEntry{entries[1]}, res)
+
+		res, err = targetSt.GetEventHistory(ctx, 2, false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: GetEventHistoryWithinRange (inclusive bounds)
+	{
+		res, err := targetSt.GetEventHistoryWithinRange(ctx, schema.DefaultChargePointId, now.Add(time.Minute), now.Add(2*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, entries[1:3], res)
+
+		res, err = targetSt.GetEventHistoryWithinRange(ctx, 2, now, now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, entries[3:], res)
+
+		res, err = targetSt.GetEventHistoryWithinRange(ctx, schema.DefaultChargePointId, now.Add(time.Hour), now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: RunInTx: entries are rolled back with the transaction
+	{
+		txErr := errors.New("tx failed")
+		err := targetSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+			if _, err := txSt.CreateEventHistoryEntry(ctx, entries[0]); err != nil {
+				return err
+			}
+
+			return txErr
+		})
+		require.True(t, errors.Is(err, txErr))
+
+		res, err := targetSt.GetEventHistory(ctx, 1, false)
+		require.NoError(t, err)
+		require.Len(t, res, 3)
+	}
+}
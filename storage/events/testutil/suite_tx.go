@@ -0,0 +1,67 @@
+package testutil
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/charge_scheduler/schema"
+	"github.com/itiky/charge_scheduler/storage/events"
+)
+
+func (s *EventsStorageTestSuite) Test_RunInTx() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	event := schema.SingleEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeOccupied,
+		StartDateTime: now,
+		Duration:      48 * time.Hour,
+		CreatedAt:     now,
+	}
+	rangeStart, rangeEnd := now.Add(-time.Minute), now.Add(time.Minute)
+
+	// ok: rollback on error
+	{
+		fnErr := fmt.Errorf("fn error")
+		err := targetSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+			_, err := txSt.CreateSingleEvent(ctx, event)
+			require.NoError(t, err)
+
+			// Nested call shares the transaction
+			return txSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+				res, err := txSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, false)
+				require.NoError(t, err)
+				require.Len(t, res, 1)
+
+				return fnErr
+			})
+		})
+		require.Equal(t, fnErr, err)
+
+		res, err := targetSt.GetSingleEventsWithinRange(ctx, schema.DefaultChargePointId, rangeStart, rangeEnd, false)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// ok: commit
+	{
+		var id int64
+		err := targetSt.RunInTx(ctx, func(txSt events.EventsStorage) error {
+			resId, err := txSt.CreateSingleEvent(ctx, event)
+			id = resId
+
+			return err
+		})
+		require.NoError(t, err)
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	}
+}
@@ -0,0 +1,173 @@
+package testutil
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/teambition/rrule-go"
+
+	"github.com/itiky/charge_scheduler/common"
+	"github.com/itiky/charge_scheduler/schema"
+)
+
+func (s *EventsStorageTestSuite) Test_UpdateDeleteSingleEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+	event := schema.SingleEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeAvailable,
+		StartDateTime: now,
+		Duration:      150 * time.Minute,
+		CreatedAt:     now,
+		Version:       1,
+	}
+
+	id, err := targetSt.CreateSingleEvent(ctx, event)
+	require.NoError(t, err)
+	event.Id = id
+
+	// fail: UpdateSingleEvent / DeleteSingleEvent: non-existing
+	{
+		nonExisting := event
+		nonExisting.Id = id + 1
+
+		err := targetSt.UpdateSingleEvent(ctx, nonExisting)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSt.DeleteSingleEvent(ctx, nonExisting.Id, now)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: UpdateSingleEvent
+	{
+		event.Type = schema.SingleEventTypeOccupied
+		event.StartDateTime = now.Add(1 * time.Minute)
+		event.Duration = 90 * time.Minute
+		require.NoError(t, targetSt.UpdateSingleEvent(ctx, event))
+		event.Version++
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+	}
+
+	// fail: UpdateSingleEvent: stale version
+	{
+		staleEvent := event
+		staleEvent.Version--
+		staleEvent.Duration = 30 * time.Minute
+
+		err := targetSt.UpdateSingleEvent(ctx, staleEvent)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+	}
+
+	// ok: DeleteSingleEvent
+	{
+		require.NoError(t, targetSt.DeleteSingleEvent(ctx, id, now))
+
+		res, err := targetSt.GetSingleEvent(ctx, id)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+}
+
+func (s *EventsStorageTestSuite) Test_UpdateDeletePeriodicEvent() {
+	t := s.T()
+	ctx := s.ctx
+	targetSt := s.r.Storage
+
+	// Init fixtures
+	now := testNow()
+
+	rule, err := rrule.NewRRule(rrule.ROption{
+		Freq:    rrule.WEEKLY,
+		Dtstart: time.Date(2020, 1, 5, 12, 30, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	event := schema.PeriodicEvent{
+		ChargePointId: schema.DefaultChargePointId,
+		Type:          schema.SingleEventTypeAvailable,
+		Rrule:         *rule,
+		Duration:      6 * time.Hour,
+		CreatedAt:     now,
+		Version:       1,
+	}
+
+	id, err := targetSt.CreatePeriodicEvent(ctx, event)
+	require.NoError(t, err)
+	event.Id = id
+
+	// fail: UpdatePeriodicEvent / DeletePeriodicEvent: non-existing
+	{
+		nonExisting := event
+		nonExisting.Id = id + 1
+
+		err := targetSt.UpdatePeriodicEvent(ctx, nonExisting)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSt.DeletePeriodicEvent(ctx, nonExisting.Id, now)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// ok: UpdatePeriodicEvent
+	{
+		newRule, err := rrule.NewRRule(rrule.ROption{
+			Freq:    rrule.WEEKLY,
+			Dtstart: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+
+		event.Type = schema.SingleEventTypeOccupied
+		event.Rrule = *newRule
+		event.Duration = 30 * time.Hour
+		require.NoError(t, targetSt.UpdatePeriodicEvent(ctx, event))
+		event.Version++
+
+		res, err := targetSt.GetPeriodicEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+	}
+
+	// fail: UpdatePeriodicEvent: stale version
+	{
+		staleEvent := event
+		staleEvent.Version--
+		staleEvent.Duration = time.Hour
+
+		err := targetSt.UpdatePeriodicEvent(ctx, staleEvent)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrVersionConflict))
+
+		res, err := targetSt.GetPeriodicEvent(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.Equal(t, event, *res)
+	}
+
+	// ok: DeletePeriodicEvent
+	{
+		require.NoError(t, targetSt.DeletePeriodicEvent(ctx, id, now))
+
+		res, err := targetSt.GetPeriodicEvent(ctx, id)
+		require.NoError(t, err)
+		require.Nil(t, res)
+	}
+}
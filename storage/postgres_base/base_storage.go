@@ -0,0 +1,104 @@
+package postgres_base
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/rs/zerolog"
+
+	"github.com/itiky/charge_scheduler/storage/postgres_base/resources"
+)
+
+// TxLockKey is the transaction-level advisory lock key taken by every storage transaction.
+// Concurrent read-check-write transactions (even from different processes) are serialized the same way
+// SQLite "BEGIN IMMEDIATE" ones are.
+const TxLockKey = 0x63735f7478 // "cs_tx"
+
+type PostgresBase struct {
+	Db     *sqlx.DB
+	Logger zerolog.Logger
+}
+
+func (s PostgresBase) Close() error {
+	if err := s.Db.Close(); err != nil {
+		return fmt.Errorf("s.db.Close: %w", err)
+	}
+
+	return nil
+}
+
+func (s PostgresBase) Migrate() error {
+	dbDriver, err := postgres.WithInstance(s.Db.DB, &postgres.Config{})
+	if err != nil {
+		return fmt.Errorf("driver init: postgres.WithInstance: %w", err)
+	}
+
+	migrationsRes := bindata.Resource(resources.AssetNames(),
+		func(name string) ([]byte, error) {
+			return resources.Asset(name)
+		},
+	)
+
+	resDriver, err := bindata.WithInstance(migrationsRes)
+	if err != nil {
+		return fmt.Errorf("bindata.WithInstance: %w", err)
+	}
+
+	migrateManager, err := migrate.NewWithInstance("go-bindata", resDriver, "postgres", dbDriver)
+	if err != nil {
+		return fmt.Errorf("migration manager init: migrate.NewWithInstance: %w", err)
+	}
+
+	prevVersion, prevMigrationFailed, err := migrateManager.Version()
+	if err != nil {
+		if !errors.Is(err, migrate.ErrNilVersion) {
+			return fmt.Errorf("reading previous migration version: migrateManager.Version: %w", err)
+		}
+	}
+	if prevMigrationFailed {
+		return fmt.Errorf("previous migration (%d) failed, can't continue", prevVersion)
+	}
+
+	if err := migrateManager.Up(); err != nil {
+		if !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("migration failed: migrateManager.Up(): %w", err)
+		}
+	}
+
+	curVersion, _, err := migrateManager.Version()
+	if err != nil {
+		if !errors.Is(err, migrate.ErrNilVersion) {
+			return fmt.Errorf("reading current migration version: migrateManager.Version: %w", err)
+		}
+	}
+
+	if curVersion != prevVersion {
+		s.Logger.Info().Msgf("Migrated %d -> %d", prevVersion, curVersion)
+	} else {
+		s.Logger.Debug().Msg("Migration skipped")
+	}
+
+	return nil
+}
+
+// NewPostgresBase connects to the PostgreSQL database (dsn is a lib/pq connection string or URL).
+func NewPostgresBase(logger zerolog.Logger, dsn string) (*PostgresBase, error) {
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql.Open: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("ping failed: %w", err)
+	}
+
+	return &PostgresBase{
+		Db:     db,
+		Logger: logger.With().Str("component", "PostgreSQL storage").Logger(),
+	}, nil
+}
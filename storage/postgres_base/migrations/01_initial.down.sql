@@ -0,0 +1,4 @@
+DROP TABLE periodic_event_exceptions;
+DROP TABLE periodic_events;
+DROP TABLE single_events;
+DROP TABLE charge_points;
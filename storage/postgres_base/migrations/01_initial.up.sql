@@ -0,0 +1,66 @@
+-- Consolidated schema of the SQLite migrations (01 - 09), timestamps are stored as TIMESTAMPTZ
+-- btree_gist is required for the "=" operator within the exclusion constraints
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE charge_points
+(
+    id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    name             TEXT             NOT NULL,
+    site             TEXT             NOT NULL,
+    connectors_count INTEGER          NOT NULL,
+    time_zone        TEXT             NOT NULL DEFAULT 'UTC',
+    max_power_kw     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ      NOT NULL
+);
+
+INSERT INTO charge_points (name, site, connectors_count, created_at)
+VALUES ('default', '', 1, CURRENT_TIMESTAMP);
+
+-- Same type events of a charge point must not overlap: [start, end) ranges, so touching ones are allowed (the service intersection checks rule)
+CREATE TABLE single_events
+(
+    id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    charge_point_id  BIGINT      NOT NULL DEFAULT 1 REFERENCES charge_points (id),
+    type             TEXT        NOT NULL,
+    start_date_time  TIMESTAMPTZ NOT NULL,
+    end_date_time    TIMESTAMPTZ NOT NULL,
+    duration_seconds BIGINT      NOT NULL,
+    owner_ref        TEXT        NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ NOT NULL,
+    CONSTRAINT single_events_no_overlap EXCLUDE USING gist (
+        charge_point_id WITH =,
+        type WITH =,
+        tstzrange(start_date_time, end_date_time) WITH &&
+    )
+);
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
+CREATE INDEX single_events_charge_point_end_idx ON single_events (charge_point_id, end_date_time);
+
+-- Active range bounds are NULL if unknown and the end is NULL for endless rules
+CREATE TABLE periodic_events
+(
+    id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    charge_point_id  BIGINT      NOT NULL DEFAULT 1 REFERENCES charge_points (id),
+    type             TEXT        NOT NULL,
+    rrule            TEXT        NOT NULL,
+    duration_seconds BIGINT      NOT NULL,
+    active_start     TIMESTAMPTZ,
+    active_end       TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX periodic_events_charge_point_active_idx ON periodic_events (charge_point_id, active_end, active_start);
+
+CREATE TABLE periodic_event_exceptions
+(
+    id                        BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    periodic_event_id         BIGINT      NOT NULL REFERENCES periodic_events (id) ON DELETE CASCADE,
+    occurrence_start          TIMESTAMPTZ NOT NULL,
+    override_start            TIMESTAMPTZ,
+    override_duration_seconds BIGINT      NOT NULL DEFAULT 0,
+    created_at                TIMESTAMPTZ NOT NULL
+);
+
+CREATE UNIQUE INDEX periodic_event_exceptions_event_occurrence_idx ON periodic_event_exceptions (periodic_event_id, occurrence_start);
@@ -0,0 +1,3 @@
+DROP TRIGGER event_history_no_update ON event_history;
+DROP FUNCTION event_history_no_update();
+DROP TABLE event_history;
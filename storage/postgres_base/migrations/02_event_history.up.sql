@@ -0,0 +1,30 @@
+-- Append-only events change history (before / after are the event JSON snapshots, NULL for create / delete)
+
+CREATE TABLE event_history
+(
+    id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    event_id        BIGINT      NOT NULL,
+    periodic        BOOLEAN     NOT NULL,
+    charge_point_id BIGINT      NOT NULL,
+    operation       TEXT        NOT NULL,
+    actor           TEXT        NOT NULL,
+    before_json     JSON,
+    after_json      JSON,
+    created_at      TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX event_history_event_idx ON event_history (periodic, event_id);
+CREATE INDEX event_history_charge_point_created_idx ON event_history (charge_point_id, created_at);
+
+CREATE FUNCTION event_history_no_update() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'event_history is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER event_history_no_update
+    BEFORE UPDATE
+    ON event_history
+    FOR EACH ROW
+EXECUTE PROCEDURE event_history_no_update();
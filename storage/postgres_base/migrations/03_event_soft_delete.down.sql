@@ -0,0 +1,15 @@
+DELETE FROM periodic_events WHERE deleted_at IS NOT NULL;
+DELETE FROM single_events WHERE deleted_at IS NOT NULL;
+
+DROP INDEX periodic_events_deleted_idx;
+DROP INDEX single_events_deleted_idx;
+
+ALTER TABLE single_events DROP CONSTRAINT single_events_no_overlap;
+ALTER TABLE single_events ADD CONSTRAINT single_events_no_overlap EXCLUDE USING gist (
+    charge_point_id WITH =,
+    type WITH =,
+    tstzrange(start_date_time, end_date_time) WITH &&
+);
+
+ALTER TABLE periodic_events DROP COLUMN deleted_at;
+ALTER TABLE single_events DROP COLUMN deleted_at;
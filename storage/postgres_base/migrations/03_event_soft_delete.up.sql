@@ -0,0 +1,15 @@
+-- Soft-deleted (restorable) events have deleted_at set, those are hard-deleted by the purge
+-- Soft-deleted single events are not checked for overlaps (those are checked on restore)
+
+ALTER TABLE single_events ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE periodic_events ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE single_events DROP CONSTRAINT single_events_no_overlap;
+ALTER TABLE single_events ADD CONSTRAINT single_events_no_overlap EXCLUDE USING gist (
+    charge_point_id WITH =,
+    type WITH =,
+    tstzrange(start_date_time, end_date_time) WITH &&
+) WHERE (deleted_at IS NULL);
+
+CREATE INDEX single_events_deleted_idx ON single_events (deleted_at);
+CREATE INDEX periodic_events_deleted_idx ON periodic_events (deleted_at);
@@ -0,0 +1,2 @@
+ALTER TABLE periodic_events DROP COLUMN version;
+ALTER TABLE single_events DROP COLUMN version;
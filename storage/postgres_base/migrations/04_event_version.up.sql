@@ -0,0 +1,4 @@
+-- Optimistic concurrency version, incremented on every event update (existing events start with 1)
+
+ALTER TABLE single_events ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE periodic_events ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,435 @@
+// Code generated by go-bindata. DO NOT EDIT.
+// sources:
+// storage/postgres_base/migrations/01_initial.down.sql (118B)
+// storage/postgres_base/migrations/01_initial.up.sql (2.943kB)
+// storage/postgres_base/migrations/02_event_history.down.sql (122B)
+// storage/postgres_base/migrations/02_event_history.up.sql (985B)
+// storage/postgres_base/migrations/03_event_soft_delete.down.sql (554B)
+// storage/postgres_base/migrations/03_event_soft_delete.up.sql (738B)
+// storage/postgres_base/migrations/04_event_version.down.sql (96B)
+// storage/postgres_base/migrations/04_event_version.up.sql (247B)
+
+package resources
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes  []byte
+	info   os.FileInfo
+	digest [sha256.Size]byte
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var __01_initialDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x76\x00\x89\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x5f\x65\x76\x65\x6e\x74\x5f\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x73\x3b\x0a\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x5f\x65\x76\x65\x6e\x74\x73\x3b\x0a\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x73\x69\x6e\x67\x6c\x65\x5f\x65\x76\x65\x6e\x74\x73\x3b\x0a\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x63\x68\x61\x72\x67\x65\x5f\x70\x6f\x69\x6e\x74\x73\x3b\x0a\x03\x00\x82\x5c\x24\x92\x76\x00\x00\x00")
+
+func _01_initialDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__01_initialDownSql,
+		"01_initial.down.sql",
+	)
+}
+
+func _01_initialDownSql() (*asset, error) {
+	bytes, err := _01_initialDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "01_initial.down.sql", size: 118, mode: os.FileMode(0644), modTime: time.Unix(1613860110, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfa, 0x62, 0x7f, 0xe6, 0xd1, 0x40, 0xc9, 0xb, 0xd1, 0xfa, 0x11, 0xcb, 0xf4, 0xf, 0x2, 0x28, 0xea, 0x27, 0x3d, 0xf0, 0x5, 0x64, 0x5e, 0x24, 0xc, 0x3f, 0x69, 0xe3, 0xff, 0xf4, 0x18, 0xe9}}
+	return a, nil
+}
+
+var __01_initialUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x96\xcf\x73\xa3\x36\x14\xc7\xef\xfc\x15\x6f\xf6\xb0\x36\x33\x78\x66\x73\x6c\x33\x7b\x20\x58\x49\x99\x3a\x38\x0b\xa2\x4d\xda\x03\xa3\x45\xcf\xb6\x26\xb6\xe4\x4a\xc2\x4e\xf7\xaf\xef\x08\x88\x03\x98\xec\x26\x9d\x9e\x1a\x2e\x04\xbe\xef\xf7\xe7\x09\xcf\x66\x10\x29\x69\xd4\x56\x70\x66\x91\x83\x29\x37\xb8\x63\xa0\x56\x60\x37\x08\xd9\x97\x85\xb0\x08\x3b\xb1\xd6\xcc\x0a\x25\x0d\x4c\x3f\x5d\xc0\x0c\x3e\xfd\xe4\x07\x60\xc5\x0e\x8d\x65\xbb\xbd\x01\xa6\x11\x8c\x55\x1a\x39\x30\x03\x34\xbe\x25\x19\x0d\x6f\xef\xe8\x1f\xde\x6c\x06\x5f\xad\x46\x2c\xd6\xc2\x58\x10\x06\x34\xfe\x55\x09\x27\x5c\x29\x5d\xc7\xf8\xf0\xf9\x03\xa8\x3d\x6a\x66\x95\x86\xa3\xb0\x1b\x21\xeb\xe7\xf8\x54\x6e\x2b\x23\x94\x84\x52\x49\x63\x35\x13\xd2\x1a\xcf\x8b\x52\x12\x52\x02\xe4\x9e\x92\x24\x8b\x97\x09\xc4\xd7\x90\x2c\x29\x90\xfb\x38\xa3\x59\x27\xd8\xe5\x49\x4b\xc3\xab\x05\x81\x72\xc3\xf4\x1a\x8b\xbd\xaa\xfd\x4c\x3d\x00\x00\xc1\xa1\xff\x77\x15\xdf\xc4\x09\x85\x1b\x92\x90\x34\xa4\x64\x0e\x57\x0f\x30\x27\xd7\x61\xbe\xa0\x10\x66\x10\xcf\x49\x42\x63\xfa\x00\x77\x69\x7c\x1b\xa6\x0f\xf0\x2b\x79\x08\x6a\x4f\x92\xed\xf0\xd9\x49\x7d\x51\x72\x4f\x9f\xef\xeb\xcb\x25\x99\xe4\x8b\x45\xa3\x37\xae\xaf\xef\xd0\x97\x4a\x4a\x2c\xad\xd2\xa6\x28\x55\x25\x2d\xc4\x09\x25\x37\x24\x7d\x4d\xef\x86\x53\x7c\x53\x12\x7f\xe8\xff\x54\xdf\x24\xa7\xd1\xa4\xb1\xde\xb1\xa7\x62\xaf\x8e\xa8\x8b\xc7\xa3\xfb\x1f\xe6\xcb\xdc\xf5\xf0\x2e\x25\x51\x5c\x77\xfd\xcc\xfa\x53\x9b\xa7\x46\xc7\x51\xc1\x6c\x1b\xa6\x03\x43\x3f\xae\xe7\x5f\x7a\x5e\x9c\x64\x24\xa5\xae\x98\x65\x7f\x40\x30\x75\x1d\x0d\xea\x3e\x05\x67\xd5\x07\x9d\x38\xbe\xf7\x5b\xb8\xc8\x49\x06\xd3\x09\xc7\x15\xab\xb6\x76\x12\xc0\x64\x12\xc0\x45\x00\x51\x9e\xa6\x24\xa1\xc5\x29\x09\x17\x73\x36\x83\xcc\x4d\xcb\xfe\xbd\x47\xc0\x03\x4a\x6b\x40\xad\x80\xb5\x09\x40\x9d\x00\xec\x2a\x63\x41\x2a\x0b\xea\x80\x7a\xcb\xf6\x3f\xc3\x9f\xc6\x32\x6d\x03\x40\xc9\x7d\xd0\x4c\xae\xd1\x04\x60\x14\x58\x55\x95\x1b\x21\xd7\xa0\x24\x36\xab\xc0\xb6\x5b\x75\x44\x0e\x53\xc7\xb1\x41\x7d\x10\x25\x82\x90\x16\xb5\xc1\xd2\xed\x11\x94\x1b\x2c\x1f\x0d\xe8\x6a\x8b\x7e\x1f\x53\x23\xe4\x7a\x8b\x45\x93\xd7\x7f\x8e\x69\xb7\xc7\x85\xe0\x27\x4f\xe3\x44\x5c\x40\x4a\xae\x49\x4a\x92\x88\x64\x3d\x53\x03\x53\xc1\xfd\x96\x34\xd7\xc6\xd7\x48\x1e\x40\xef\x1a\x58\xb8\x83\xa6\x70\x7c\xf6\xe1\xe8\x4b\x51\xf2\xae\xf0\x7b\x52\x5e\x35\x87\x53\x61\xb0\x54\x92\x9b\xd1\x9a\x1a\xa9\x3a\x4a\xd4\x85\xc6\xd5\xf7\x72\x3d\x95\x3f\x69\xb7\xe1\x85\xb5\x56\xf9\x7a\x2e\xd1\x32\xc9\x68\x1a\xba\xf0\xbd\x41\x16\x52\x15\x2d\x48\x40\xee\xa3\x45\x3e\x27\x90\x67\x71\x72\x03\xf5\xb9\xd8\x8c\x79\x6c\x40\xbf\xc7\xf4\x17\xf8\x1c\x9c\xde\xd7\xd0\x9e\x3d\x34\xf6\x5b\x0d\xe4\x74\xd0\xe1\xa0\xdf\x47\xbf\xb1\xfc\xf8\xb1\x76\xe7\x7b\xfe\xcb\x19\x19\x27\x73\x72\x3f\xc8\xb9\x97\x4b\xe3\x59\xf0\x27\x58\x26\x7d\x1d\x4c\x07\x49\x07\xc3\x41\xfb\x97\x6f\x0e\xe3\xf2\x7d\x63\x90\x7e\x69\xcd\x62\x87\xa5\x15\x07\x6c\xb6\x13\xbe\xaa\xca\xe1\xe0\x36\xd2\x4d\x08\xc4\x0a\x2a\xf9\x28\xd5\x51\x02\x93\xbc\xf9\xc8\x48\xee\x3e\x4a\xf5\x6b\xf7\x41\x42\xc9\xb7\x68\x9a\xc5\x34\xcf\x49\x37\x8b\xb9\x47\x2d\x14\x17\xe5\xff\x73\x35\xb5\xab\xf8\x6d\xd2\x77\xec\x1b\xab\xc7\x51\xd4\x38\x0c\x37\xa7\xa7\x40\xc9\xcf\x77\xeb\x1d\xdb\x77\x8e\xf2\x60\x5c\x7d\xca\xda\xa8\x2d\x68\x03\xe9\x08\x6a\x2f\x59\x9e\xee\xeb\x9a\x3a\x51\xc7\x20\x29\xf0\xa9\xc4\xbd\xeb\xd5\xab\xb8\x9c\xae\x7f\xcd\xcd\x20\xa4\xe0\x43\x97\xbd\xb9\x74\xb1\xe9\x5b\x36\x67\xba\xdb\xee\x39\x59\x10\x4a\x20\x0a\xb3\x28\x9c\x93\xf6\xe4\x2c\xcb\x4a\x6b\x94\x65\x5b\xf9\x73\x8c\xf1\x71\xb4\x36\x07\xd4\x5a\xf0\x33\x8b\x9e\xcd\x40\xfa\x26\xb8\x7e\xf8\xa3\x63\x2c\xd0\x28\x2b\x79\x12\x7f\xc9\xc7\x91\xe9\x0c\xaf\x7d\xd0\xe9\xc1\x28\x39\x1d\x0b\x98\x0e\x5e\x09\x1e\x9c\xf5\xd0\xbf\xf4\xfe\x19\x00\x62\x46\x18\x5c\x7f\x0b\x00\x00")
+
+func _01_initialUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__01_initialUpSql,
+		"01_initial.up.sql",
+	)
+}
+
+func _01_initialUpSql() (*asset, error) {
+	bytes, err := _01_initialUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "01_initial.up.sql", size: 2943, mode: os.FileMode(0644), modTime: time.Unix(1613874251, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5a, 0xae, 0xc3, 0xf, 0xa5, 0xae, 0xe9, 0x37, 0x4b, 0x8a, 0xca, 0x20, 0xf7, 0x64, 0x8d, 0xd9, 0x75, 0xc4, 0xd8, 0x53, 0x87, 0xd4, 0x7, 0xd1, 0x8b, 0xd0, 0xc5, 0x6f, 0xe4, 0x70, 0x23, 0x5c}}
+	return a, nil
+}
+
+var __02_event_historyDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x7a\x00\x85\xff\x44\x52\x4f\x50\x20\x54\x52\x49\x47\x47\x45\x52\x20\x65\x76\x65\x6e\x74\x5f\x68\x69\x73\x74\x6f\x72\x79\x5f\x6e\x6f\x5f\x75\x70\x64\x61\x74\x65\x20\x4f\x4e\x20\x65\x76\x65\x6e\x74\x5f\x68\x69\x73\x74\x6f\x72\x79\x3b\x0a\x44\x52\x4f\x50\x20\x46\x55\x4e\x43\x54\x49\x4f\x4e\x20\x65\x76\x65\x6e\x74\x5f\x68\x69\x73\x74\x6f\x72\x79\x5f\x6e\x6f\x5f\x75\x70\x64\x61\x74\x65\x28\x29\x3b\x0a\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x65\x76\x65\x6e\x74\x5f\x68\x69\x73\x74\x6f\x72\x79\x3b\x0a\x03\x00\x65\xa0\x23\x32\x7a\x00\x00\x00")
+
+func _02_event_historyDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__02_event_historyDownSql,
+		"02_event_history.down.sql",
+	)
+}
+
+func _02_event_historyDownSql() (*asset, error) {
+	bytes, err := _02_event_historyDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "02_event_history.down.sql", size: 122, mode: os.FileMode(0644), modTime: time.Unix(1792298485, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x64, 0x98, 0xc0, 0xab, 0xb5, 0x86, 0x5c, 0x92, 0xb8, 0x15, 0xd2, 0xd9, 0x8f, 0xab, 0xad, 0xa2, 0x1c, 0xb3, 0x81, 0x70, 0x4a, 0x8, 0x1d, 0xfc, 0x6, 0x5e, 0x41, 0x51, 0xd2, 0x6e, 0x23, 0x33}}
+	return a, nil
+}
+
+var __02_event_historyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\x41\x8f\xda\x30\x10\x85\xef\xfe\x15\xef\x80\xb4\x20\x81\xfa\x03\x38\x99\x64\x48\xdd\x06\x07\x39\x8e\x0a\xbd\x44\x2e\x31\x90\x0a\xc5\x69\xe2\x56\xdd\x7f\xbf\x22\x09\xb0\xa0\x65\x73\x8a\x3c\x6f\xbe\x79\x7e\x93\xcc\x66\xe0\x75\x6d\xab\x62\xe6\xaa\xd3\x2b\xec\x3f\x5b\xf9\x16\xbb\xa3\xa9\x0e\x16\xc7\xb2\xf5\xae\x79\xc5\xf8\x97\xdd\xbb\xc6\xe2\x0b\xcc\xde\xdb\x06\xa6\xb1\xf0\x47\xdb\xab\xf1\x2d\x4d\x24\xda\xca\xd4\xed\xd1\xf9\x76\x0a\x99\xc5\x31\xf6\xae\xc1\xae\xb1\xc6\x9f\xbb\x0a\x7b\xb2\xde\x4e\x18\x0b\x14\x71\x4d\xd0\x7c\x11\x53\xdf\x9d\x0f\x33\xd8\x98\x01\x40\x59\xe0\xee\x59\x88\x48\x48\x8d\x88\x24\x29\xae\x29\xc4\x62\x8b\x90\x96\x3c\x8b\x35\x78\x0a\x11\x92\xd4\x42\x6f\xb1\x56\x62\xc5\xd5\x16\xdf\x69\x3b\xed\x40\x3d\xbc\x2c\x1e\x40\xe7\x57\xc8\x44\x77\x26\x7b\x65\x6d\x9b\xd2\x15\xe5\xee\xaa\x4c\x92\x98\xb8\xfc\x40\xb9\x3b\x9a\xe6\x60\xf3\xda\x95\x3d\xfa\x39\xd3\xd5\xb6\x31\xbe\x74\xd5\xc0\xd4\xb4\x19\x74\x8f\x4a\xb3\xf3\xae\xb9\x94\x3e\x55\xf6\x3b\xc8\x7f\xb7\x03\xf5\x1c\xfb\xc0\x38\x2f\xe5\x56\x78\x57\xe9\x37\x50\xe4\xc6\x0f\x74\xb1\xa2\x54\xf3\xd5\x5a\xff\xbc\xd2\xd9\x64\x7e\x5d\x8c\x90\x21\x6d\xee\x17\x93\x5f\x92\xfc\x8f\x44\xde\x97\x30\xbe\x64\x37\x1d\x0a\x65\x31\x99\x7f\xc6\xba\x4b\xf0\x62\xee\x63\xf4\x43\xd8\xd3\xe1\x6b\x3a\xdf\xe5\x9d\xe1\x65\x26\x03\x2d\x1e\xbb\xf3\xca\xe5\x7f\xeb\xc2\x78\x3b\x9e\x40\x91\xce\x94\x4c\xa1\x95\x88\x22\x52\xe0\x29\x1b\x8d\xd8\x82\x22\x21\xbb\x8c\x14\x17\x29\x81\x36\x01\xad\x3b\xd2\xcb\xbd\x91\xb2\x85\xb9\xfd\x20\x2f\x73\x46\x32\x9c\xb3\xd1\x08\x31\x97\x51\xc6\x23\x42\x7d\xaa\x0f\xed\x9f\xd3\xcd\xd4\x65\xd2\x13\x4f\xdd\xd4\x05\x2d\x13\x45\xc8\xd6\x21\xd7\xd4\x9d\x3c\x5e\xa2\x3b\x5c\x26\x0a\xc4\x83\xaf\x50\xc9\x0f\x46\x1b\x0a\x32\x4d\x58\xab\x24\xa0\x30\x53\xf4\x6c\xc2\x78\x32\x67\x6f\x03\x00\xcf\x9e\x62\xf1\xd9\x03\x00\x00")
+
+func _02_event_historyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__02_event_historyUpSql,
+		"02_event_history.up.sql",
+	)
+}
+
+func _02_event_historyUpSql() (*asset, error) {
+	bytes, err := _02_event_historyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "02_event_history.up.sql", size: 985, mode: os.FileMode(0644), modTime: time.Unix(1792298485, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x95, 0x3f, 0x3f, 0xe, 0x46, 0x84, 0x27, 0xc3, 0x4f, 0x21, 0x5f, 0xc9, 0x24, 0x23, 0x7e, 0xc9, 0xf1, 0x51, 0x95, 0xd9, 0x74, 0x9f, 0x13, 0x7f, 0xa, 0xd3, 0x23, 0x24, 0xb8, 0x30, 0xff, 0xce}}
+	return a, nil
+}
+
+var __03_event_soft_deleteDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xff\xd5\xd8\x60\x6f\x50\xbc\xa8\x4b\x74\x85\x2c\x95\x36\x65\xbb\x0b\x61\x39\xd4\x40\x4d\x4b\x7b\x18\xea\xd3\x0b\x53\xb1\xad\xa0\xbb\x4c\x72\xbe\x2f\x87\xff\x17\x52\x49\x23\xf1\x50\x16\x07\xf4\x34\x84\xce\x87\xb3\xa5\x0b\x45\x1e\x71\xdc\xcb\x52\xc2\x53\x4b\x4c\xde\x3a\x46\x5e\x41\x17\x06\xba\x56\x2a\x4d\xa6\xe4\x18\x62\xd3\xd2\x6d\x5c\x22\xca\xe2\x09\xb9\x16\xf2\xb4\xfc\xd1\x7e\x33\xc1\xbf\xa6\xd3\xb9\x99\x7f\x3e\x95\x64\xca\xc8\x12\x26\xbb\x57\x72\xb1\xc7\x55\xb0\x2b\x74\x65\xca\x2c\xd7\x66\x61\x89\x9d\xed\x2e\x34\xb4\xae\x4f\xff\x70\x64\x42\xdc\xa2\x80\x3c\xed\x54\x2d\x24\xea\x2a\xd7\x8f\x68\xc2\xc8\x58\x27\x00\x70\x7e\x76\x43\x43\xb6\xef\x42\x64\x1b\x3c\x8e\xb9\xd9\xe3\x6e\x7b\x7d\xe3\xb7\x9e\xe6\x17\x23\xbf\x0f\x2e\x36\xb4\x1e\xd9\x0d\x6c\xbd\x63\xb2\x1c\x5e\x68\x0b\x8a\xfe\xe7\xb8\xf9\xa4\x56\xab\x64\xb3\x48\x60\xd9\xe1\x57\x06\xaa\x3e\xe8\x49\x23\xe9\xff\xa9\xfd\x22\x3e\x06\x00\x92\x9a\x35\x31\x2a\x02\x00\x00")
+
+func _03_event_soft_deleteDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__03_event_soft_deleteDownSql,
+		"03_event_soft_delete.down.sql",
+	)
+}
+
+func _03_event_soft_deleteDownSql() (*asset, error) {
+	bytes, err := _03_event_soft_deleteDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "03_event_soft_delete.down.sql", size: 554, mode: os.FileMode(0644), modTime: time.Unix(1792299301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3, 0xbb, 0x48, 0x58, 0xb4, 0x66, 0xbf, 0xb4, 0x2, 0xf3, 0xb0, 0x61, 0x7b, 0x32, 0xc, 0x41, 0x7f, 0x5b, 0x2b, 0x6, 0xa0, 0xca, 0x75, 0xf6, 0x18, 0xf1, 0x49, 0x9c, 0x81, 0x5e, 0x82, 0xf8}}
+	return a, nil
+}
+
+var __03_event_soft_deleteUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6f\x9b\x30\x18\x86\xef\xfc\x8a\xf7\x54\x81\x94\xfc\x82\x68\x07\x16\xac\x15\x89\x90\x0a\x1c\xb5\xda\xc5\x72\xf1\x57\xb0\xc6\x6c\x64\x7f\x8b\xd6\xfd\xfa\x69\x0b\x59\x03\x87\x4e\x3d\xda\xaf\x9f\xc7\xaf\xfd\x6d\xb7\x68\xfd\x0b\x6f\x0d\x8d\xc4\x64\x90\x06\x8a\xec\x83\x7e\x1e\x29\x03\x9d\xc9\x71\xc4\xa0\xcf\x84\xf9\x80\xd2\x8c\x48\xbc\x01\x0f\x3e\x12\x74\x20\x0c\x3a\x98\x7f\xfc\xf3\x2b\x78\x20\x4c\x3f\x42\x4f\xc9\x5a\x1e\xad\xeb\x47\xba\x6a\xff\xb0\xce\x33\xba\x81\xba\x6f\x64\xf0\xe2\x03\xfc\x99\xc2\xa8\xa7\x88\xf4\xcd\x7f\xcd\xbd\xc3\xa5\x1c\x65\x49\x92\x57\x52\x34\x90\xf9\xe7\x4a\xcc\x5a\x35\x6b\xf3\xa2\xc0\xfe\x58\x9d\x0e\xf5\x6d\x67\x59\x1e\x44\x2b\xf3\xc3\x83\xfc\xba\x5b\xc0\x13\x05\xeb\x8d\xed\x3e\x80\xbf\x73\x79\xd1\x1c\x1f\xb0\x3f\xd6\xad\x6c\xf2\xb2\x96\xcb\x54\x39\xaf\xe6\x07\xee\xde\x71\x5c\x1a\xfc\x57\x01\xf1\xb4\xaf\x4e\x85\xc0\xa9\x2d\xeb\x2f\xe8\x6d\x64\xa4\x09\x00\x74\x83\x0e\x3d\xa9\xc9\x5b\xc7\xca\x1a\x3c\x96\xf2\x1e\x9f\x36\x7f\x33\x7e\x9d\x68\xb9\x11\xf9\x57\xd0\xae\xa7\x34\xb2\x0e\xac\x8c\x66\x52\x6c\xbf\xd3\x06\xe4\xcc\xdb\x32\xbb\x50\x77\x77\x49\x86\xc7\x7b\xd1\x08\xa4\x37\xff\x53\xb6\xa8\x4f\x55\x95\xed\x92\x64\xdf\x88\x5c\x0a\x94\x75\x21\x9e\x56\xe5\xaf\x80\x35\x3f\x71\xac\x97\xe1\xad\x2e\xdb\x2d\x2d\xab\x19\xad\x3d\xeb\x11\x2e\x4d\xbf\x07\x00\x25\x98\xc8\x13\xe2\x02\x00\x00")
+
+func _03_event_soft_deleteUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__03_event_soft_deleteUpSql,
+		"03_event_soft_delete.up.sql",
+	)
+}
+
+func _03_event_soft_deleteUpSql() (*asset, error) {
+	bytes, err := _03_event_soft_deleteUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "03_event_soft_delete.up.sql", size: 738, mode: os.FileMode(0644), modTime: time.Unix(1792299301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x51, 0xd9, 0x7b, 0xc0, 0x57, 0xa6, 0xe3, 0x57, 0x21, 0x5e, 0x47, 0xaf, 0xa8, 0x40, 0x76, 0xa5, 0xd6, 0x9c, 0xe, 0x7, 0x22, 0xd5, 0xf7, 0xe1, 0xbf, 0x6e, 0x75, 0xee, 0xdb, 0xbb, 0x86, 0x93}}
+	return a, nil
+}
+
+var __04_event_versionDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x60\x00\x9f\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x5f\x65\x76\x65\x6e\x74\x73\x20\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x76\x65\x72\x73\x69\x6f\x6e\x3b\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x69\x6e\x67\x6c\x65\x5f\x65\x76\x65\x6e\x74\x73\x20\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x76\x65\x72\x73\x69\x6f\x6e\x3b\x0a\x03\x00\x5d\x00\x80\x6b\x60\x00\x00\x00")
+
+func _04_event_versionDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__04_event_versionDownSql,
+		"04_event_version.down.sql",
+	)
+}
+
+func _04_event_versionDownSql() (*asset, error) {
+	bytes, err := _04_event_versionDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "04_event_version.down.sql", size: 96, mode: os.FileMode(0644), modTime: time.Unix(1792299762, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf2, 0x8a, 0x9d, 0x21, 0x18, 0x70, 0xb5, 0x60, 0xfc, 0x99, 0xa, 0xa1, 0x5a, 0xff, 0x96, 0x4a, 0xdf, 0x5, 0xdd, 0x6c, 0x5a, 0xcf, 0x43, 0x6e, 0x7b, 0x41, 0x93, 0x57, 0x63, 0x48, 0xb3, 0x1b}}
+	return a, nil
+}
+
+var __04_event_versionUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8d\x31\x6b\x84\x40\x14\x84\x7b\x7f\xc5\x94\x09\xc4\xc2\x3a\xd5\x1a\x4d\x10\x36\x2b\x84\xb5\x0e\xb2\x3e\xcc\x83\xf8\x56\x76\x9f\x26\xfe\xfb\x20\xb9\x2b\xae\xbd\x66\x8a\x61\xe6\xfb\xca\x12\xfd\xaa\xbc\x70\x56\x0e\x08\x51\xc2\x96\x12\x49\x38\xb0\x53\xca\x1c\xe5\x09\x2c\x21\xd1\x42\xa2\x34\x21\x0a\x68\xa7\x74\x9c\x29\x8a\x6d\x9d\x46\x25\x3c\xd0\xef\xf9\x97\xf9\xbf\xce\xc8\x3a\x26\xc5\x0f\xeb\x17\xaa\xc7\xa2\x30\xd6\xb7\x1f\xf0\xa6\xb6\x2d\x32\xcb\xfc\x4d\x9f\x97\xa1\x69\x1a\xbc\xf4\x76\x78\x77\x57\x1f\xea\xee\xad\x73\x1e\xae\xf7\x70\x83\xb5\x68\xda\x57\x33\x58\x8f\xea\xf9\x86\xb3\x52\xe2\x38\x71\xb8\x8f\xf4\x37\x00\x74\x63\xbf\x88\xf7\x00\x00\x00")
+
+func _04_event_versionUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__04_event_versionUpSql,
+		"04_event_version.up.sql",
+	)
+}
+
+func _04_event_versionUpSql() (*asset, error) {
+	bytes, err := _04_event_versionUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "04_event_version.up.sql", size: 247, mode: os.FileMode(0644), modTime: time.Unix(1792299762, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0x1a, 0x3e, 0x87, 0x9f, 0xa5, 0xfb, 0xb9, 0x40, 0xdd, 0x3c, 0xfb, 0xae, 0x5a, 0xdb, 0x8c, 0x82, 0x50, 0x8b, 0x77, 0x1e, 0xf, 0x52, 0x9f, 0x39, 0x2f, 0x3e, 0xa5, 0xfc, 0x4d, 0xc0, 0x9e}}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// AssetString returns the asset contents as a string (instead of a []byte).
+func AssetString(name string) (string, error) {
+	data, err := Asset(name)
+	return string(data), err
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// MustAssetString is like AssetString but panics when Asset would return an
+// error. It simplifies safe initialization of global variables.
+func MustAssetString(name string) string {
+	return string(MustAsset(name))
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetDigest returns the digest of the file with the given name. It returns an
+// error if the asset could not be found or the digest could not be loaded.
+func AssetDigest(name string) ([sha256.Size]byte, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
+		}
+		return a.digest, nil
+	}
+	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
+}
+
+// Digests returns a map of all known files and their checksums.
+func Digests() (map[string][sha256.Size]byte, error) {
+	mp := make(map[string][sha256.Size]byte, len(_bindata))
+	for name := range _bindata {
+		a, err := _bindata[name]()
+		if err != nil {
+			return nil, err
+		}
+		mp[name] = a.digest
+	}
+	return mp, nil
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"01_initial.down.sql":           _01_initialDownSql,
+	"01_initial.up.sql":             _01_initialUpSql,
+	"02_event_history.down.sql":     _02_event_historyDownSql,
+	"02_event_history.up.sql":       _02_event_historyUpSql,
+	"03_event_soft_delete.down.sql": _03_event_soft_deleteDownSql,
+	"03_event_soft_delete.up.sql":   _03_event_soft_deleteUpSql,
+	"04_event_version.down.sql":     _04_event_versionDownSql,
+	"04_event_version.up.sql":       _04_event_versionUpSql,
+}
+
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"},
+// AssetDir("data/img") would return []string{"a.png", "b.png"},
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		canonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(canonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"01_initial.down.sql": {_01_initialDownSql, map[string]*bintree{}},
+	"01_initial.up.sql": {_01_initialUpSql, map[string]*bintree{}},
+	"02_event_history.down.sql": {_02_event_historyDownSql, map[string]*bintree{}},
+	"02_event_history.up.sql": {_02_event_historyUpSql, map[string]*bintree{}},
+	"03_event_soft_delete.down.sql": {_03_event_soft_deleteDownSql, map[string]*bintree{}},
+	"03_event_soft_delete.up.sql": {_03_event_soft_deleteUpSql, map[string]*bintree{}},
+	"04_event_version.down.sql": {_04_event_versionDownSql, map[string]*bintree{}},
+	"04_event_version.up.sql": {_04_event_versionUpSql, map[string]*bintree{}},
+}}
+
+// RestoreAsset restores an asset under the given directory.
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+}
+
+// RestoreAssets restores an asset under the given directory recursively.
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
+}
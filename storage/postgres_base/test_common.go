@@ -0,0 +1,33 @@
+package postgres_base
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// TestDSNEnv is the env variable with the test PostgreSQL database DSN (tests are skipped if not set).
+// Test database data is removed by the tests.
+const TestDSNEnv = "CS_TEST_POSTGRES_DSN"
+
+// TestDSN returns the test database DSN (empty if not configured).
+func TestDSN() string {
+	return os.Getenv(TestDSNEnv)
+}
+
+func SetupTestPostgresBase(dsn string) (retStorage *PostgresBase, retErr error) {
+	storage, err := NewPostgresBase(zerolog.Nop(), dsn)
+	if err != nil {
+		retErr = fmt.Errorf("NewPostgresBase: %w", err)
+		return
+	}
+	retStorage = storage
+
+	if err := storage.Migrate(); err != nil {
+		retErr = fmt.Errorf("storage.Migrate: %w", err)
+		return
+	}
+
+	return
+}
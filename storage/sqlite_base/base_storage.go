@@ -3,8 +3,10 @@ package sqlite_base
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
 	"github.com/golang-migrate/migrate/v4/database/sqlite3"
 	//_ "github.com/golang-migrate/migrate/v4/source/file"
 	bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
@@ -17,6 +19,12 @@ import (
 
 const DefMigrationPath = "file://${GOPATH}/src/github.com/itiky/charge_scheduler/storage/sqlite_base/migrations"
 
+// dsnParams are appended to the DB file path.
+// Every transaction is started with "BEGIN IMMEDIATE" acquiring the write lock upfront, so concurrent
+// read-check-write transactions (even from different processes) are serialized instead of failing on commit.
+// Busy timeout (ms) makes a concurrent transaction wait for the lock instead of failing with SQLITE_BUSY.
+const dsnParams = "_txlock=immediate&_busy_timeout=10000"
+
 type SQLiteBase struct {
 	Db     *sqlx.DB
 	Logger zerolog.Logger
@@ -31,33 +39,11 @@ func (s SQLiteBase) Close() error {
 }
 
 func (s SQLiteBase) Migrate() error {
-	dbDriver, err := sqlite3.WithInstance(s.Db.DB, &sqlite3.Config{})
-	if err != nil {
-		return fmt.Errorf("driver init: sqlite3.WithInstance: %w", err)
-	}
-
-	migrationsRes := bindata.Resource(resources.AssetNames(),
-		func(name string) ([]byte, error) {
-			return resources.Asset(name)
-		},
-	)
-	resDriver, err := bindata.WithInstance(migrationsRes)
-	if err != nil {
-		return fmt.Errorf("bindata.WithInstance: %w", err)
-	}
-
-	migrateManager, err := migrate.NewWithInstance("go-bindata", resDriver, "sqlite3", dbDriver)
+	migrateManager, dbDriver, err := s.newMigrateManager()
 	if err != nil {
-		return fmt.Errorf("migration manager init: migrate.NewWithInstance: %w", err)
+		return err
 	}
 
-	// //Files source version
-	// //import _ "github.com/golang-migrate/migrate/v4/source/file"
-	//migrateManager, err := migrate.NewWithDatabaseInstance(migrationsPath, "sqlite3", driver)
-	//if err != nil {
-	//	return fmt.Errorf("migration manager init: migrate.NewWithDatabaseInstance(%s): %w", migrationsPath, err)
-	//}
-
 	prevVersion, prevMigrationFailed, err := migrateManager.Version()
 	if err != nil {
 		if !errors.Is(err, migrate.ErrNilVersion) {
@@ -68,6 +54,27 @@ func (s SQLiteBase) Migrate() error {
 		return fmt.Errorf("previous migration (%d) failed, can't continue", prevVersion)
 	}
 
+	// Go data migrations are applied right after their SQL ones
+	for _, postMigration := range postMigrations {
+		if prevVersion >= postMigration.Version {
+			continue
+		}
+
+		if err := migrateManager.Migrate(postMigration.Version); err != nil {
+			if !errors.Is(err, migrate.ErrNoChange) {
+				return fmt.Errorf("migration failed: migrateManager.Migrate(%d): %w", postMigration.Version, err)
+			}
+		}
+		if err := s.applyPostMigration(postMigration); err != nil {
+			// Marked as failed, so the half-migrated DB is not used on the next start
+			if err := dbDriver.SetVersion(int(postMigration.Version), true); err != nil {
+				s.Logger.Error().Err(err).Msg("Marking the migration as failed")
+			}
+
+			return fmt.Errorf("post migration (%d) failed: %w", postMigration.Version, err)
+		}
+	}
+
 	if err := migrateManager.Up(); err != nil {
 		if !errors.Is(err, migrate.ErrNoChange) {
 			return fmt.Errorf("migration failed: migrateManager.Up(): %w", err)
@@ -90,8 +97,45 @@ func (s SQLiteBase) Migrate() error {
 	return nil
 }
 
+// newMigrateManager returns the embedded migrations manager along with its DB driver.
+func (s SQLiteBase) newMigrateManager() (*migrate.Migrate, database.Driver, error) {
+	dbDriver, err := sqlite3.WithInstance(s.Db.DB, &sqlite3.Config{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("driver init: sqlite3.WithInstance: %w", err)
+	}
+
+	migrationsRes := bindata.Resource(resources.AssetNames(),
+		func(name string) ([]byte, error) {
+			return resources.Asset(name)
+		},
+	)
+	resDriver, err := bindata.WithInstance(migrationsRes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bindata.WithInstance: %w", err)
+	}
+
+	migrateManager, err := migrate.NewWithInstance("go-bindata", resDriver, "sqlite3", dbDriver)
+	if err != nil {
+		return nil, nil, fmt.Errorf("migration manager init: migrate.NewWithInstance: %w", err)
+	}
+
+	// //Files source version
+	// //import _ "github.com/golang-migrate/migrate/v4/source/file"
+	//migrateManager, err := migrate.NewWithDatabaseInstance(migrationsPath, "sqlite3", driver)
+	//if err != nil {
+	//	return fmt.Errorf("migration manager init: migrate.NewWithDatabaseInstance(%s): %w", migrationsPath, err)
+	//}
+
+	return migrateManager, dbDriver, nil
+}
+
 func NewSQLiteBase(logger zerolog.Logger, filePath string) (*SQLiteBase, error) {
-	db, err := sqlx.Open("sqlite3", filePath)
+	dsn := filePath + "?" + dsnParams
+	if strings.Contains(filePath, "?") {
+		dsn = filePath + "&" + dsnParams
+	}
+
+	db, err := sqlx.Open("sqlite3", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("sql.Open(%s): %w", filePath, err)
 	}
@@ -0,0 +1,76 @@
+package sqlite_base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestSQLiteBase_MigrateDown checks the down migrations rebuilding the events tables (no DROP COLUMN within SQLite 3.34).
+func TestSQLiteBase_MigrateDown(t *testing.T) {
+	baseSt, err := SetupTempSQLiteBase(t.TempDir())
+	require.NoError(t, err)
+	defer baseSt.Close()
+	db := baseSt.Db
+
+	// Init fixtures
+	// Single events 1 (kept), 2 (deleted), periodic event 1 (kept)
+	{
+		createdAt := time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC)
+		start := time.Date(2014, 8, 4, 9, 30, 0, 0, time.UTC)
+
+		insertSingleEvent := `INSERT INTO single_events (rowid, type, start_date_time, duration_seconds, created_at, end_date_time, deleted_at, version) VALUES (?, 'Available', ?, 3600, ?, ?, ?, 2)`
+		db.MustExec(insertSingleEvent, 1, start, createdAt, start.Add(time.Hour), nil)
+		db.MustExec(insertSingleEvent, 2, start.Add(2*time.Hour), createdAt, start.Add(3*time.Hour), createdAt)
+
+		db.MustExec(`INSERT INTO periodic_events (rowid, type, rrule, duration_seconds, created_at, active_start, version) VALUES (1, 'Available', ?, 3600, ?, ?, 3)`,
+			"DTSTART:20140804T093000Z\nRRULE:FREQ=WEEKLY", createdAt, start)
+	}
+
+	columnsCnt := func(table string, columns ...interface{}) int {
+		var cnt int
+		require.NoError(t, db.Get(&cnt, `SELECT count(*) FROM pragma_table_info(?) WHERE name IN (?, ?)`, append([]interface{}{table}, columns...)...))
+		return cnt
+	}
+
+	migrateManager, _, err := baseSt.newMigrateManager()
+	require.NoError(t, err)
+
+	// ok: versions and soft-deleted events are dropped, the rest is kept
+	{
+		require.NoError(t, migrateManager.Migrate(10))
+
+		require.Zero(t, columnsCnt("single_events", "version", "deleted_at"))
+		require.Zero(t, columnsCnt("periodic_events", "version", "deleted_at"))
+
+		var singleIds []int64
+		require.NoError(t, db.Select(&singleIds, `SELECT rowid FROM single_events ORDER BY rowid`))
+		require.Equal(t, []int64{1}, singleIds)
+
+		var periodicIds []int64
+		require.NoError(t, db.Select(&periodicIds, `SELECT rowid FROM periodic_events WHERE active_start IS NOT NULL ORDER BY rowid`))
+		require.Equal(t, []int64{1}, periodicIds)
+	}
+
+	// ok: migrated up again (versions start over)
+	{
+		require.NoError(t, baseSt.Migrate())
+
+		require.Equal(t, 2, columnsCnt("single_events", "version", "deleted_at"))
+		require.Equal(t, 2, columnsCnt("periodic_events", "version", "deleted_at"))
+
+		var versions []int64
+		require.NoError(t, db.Select(&versions, `SELECT version FROM single_events UNION ALL SELECT version FROM periodic_events`))
+		require.Equal(t, []int64{1, 1}, versions)
+	}
+
+	// ok: all down
+	{
+		require.NoError(t, migrateManager.Down())
+
+		var tablesCnt int
+		require.NoError(t, db.Get(&tablesCnt, `SELECT count(*) FROM sqlite_master WHERE name IN ('single_events', 'charge_points', 'event_history')`))
+		require.Zero(t, tablesCnt)
+	}
+}
@@ -0,0 +1,30 @@
+DROP INDEX IF EXISTS single_events_charge_point_start_idx;
+DROP INDEX IF EXISTS periodic_events_charge_point_idx;
+
+CREATE TABLE single_events_old
+(
+    type            TEXT      NOT NULL,
+    start_date_time TIMESTAMP NOT NULL,
+    end_hours       INTEGER   NOT NULL,
+    end_minutes     INTEGER   NOT NULL,
+    created_at      TIMESTAMP NOT NULL
+);
+INSERT INTO single_events_old (rowid, type, start_date_time, end_hours, end_minutes, created_at)
+SELECT rowid, type, start_date_time, end_hours, end_minutes, created_at FROM single_events;
+DROP TABLE single_events;
+ALTER TABLE single_events_old RENAME TO single_events;
+
+CREATE TABLE periodic_events_old
+(
+    type        TEXT      NOT NULL,
+    rrule       TEXT      NOT NULL,
+    end_hours   INTEGER   NOT NULL,
+    end_minutes INTEGER   NOT NULL,
+    created_at  TIMESTAMP NOT NULL
+);
+INSERT INTO periodic_events_old (rowid, type, rrule, end_hours, end_minutes, created_at)
+SELECT rowid, type, rrule, end_hours, end_minutes, created_at FROM periodic_events;
+DROP TABLE periodic_events;
+ALTER TABLE periodic_events_old RENAME TO periodic_events;
+
+DROP TABLE IF EXISTS charge_points;
@@ -0,0 +1,16 @@
+CREATE TABLE charge_points
+(
+    name             TEXT      NOT NULL,
+    site             TEXT      NOT NULL,
+    connectors_count INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL
+);
+
+INSERT INTO charge_points (rowid, name, site, connectors_count, created_at)
+VALUES (1, 'default', '', 1, CURRENT_TIMESTAMP);
+
+ALTER TABLE single_events ADD COLUMN charge_point_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE periodic_events ADD COLUMN charge_point_id INTEGER NOT NULL DEFAULT 1;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
+CREATE INDEX periodic_events_charge_point_idx ON periodic_events (charge_point_id);
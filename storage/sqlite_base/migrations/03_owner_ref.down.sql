@@ -0,0 +1,17 @@
+DROP INDEX IF EXISTS single_events_charge_point_start_idx;
+
+CREATE TABLE single_events_old
+(
+    type            TEXT      NOT NULL,
+    start_date_time TIMESTAMP NOT NULL,
+    end_hours       INTEGER   NOT NULL,
+    end_minutes     INTEGER   NOT NULL,
+    created_at      TIMESTAMP NOT NULL,
+    charge_point_id INTEGER   NOT NULL DEFAULT 1
+);
+INSERT INTO single_events_old (rowid, type, start_date_time, end_hours, end_minutes, created_at, charge_point_id)
+SELECT rowid, type, start_date_time, end_hours, end_minutes, created_at, charge_point_id FROM single_events;
+DROP TABLE single_events;
+ALTER TABLE single_events_old RENAME TO single_events;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
@@ -0,0 +1 @@
+ALTER TABLE single_events ADD COLUMN owner_ref TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP TABLE periodic_event_exceptions;
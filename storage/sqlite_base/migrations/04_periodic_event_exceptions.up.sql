@@ -0,0 +1,11 @@
+CREATE TABLE periodic_event_exceptions
+(
+    periodic_event_id    INTEGER   NOT NULL,
+    occurrence_start     TIMESTAMP NOT NULL,
+    override_start       TIMESTAMP,
+    override_end_hours   INTEGER   NOT NULL DEFAULT 0,
+    override_end_minutes INTEGER   NOT NULL DEFAULT 0,
+    created_at           TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX periodic_event_exceptions_event_occurrence_idx ON periodic_event_exceptions (periodic_event_id, occurrence_start);
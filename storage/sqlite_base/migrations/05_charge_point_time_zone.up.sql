@@ -0,0 +1 @@
+ALTER TABLE charge_points ADD COLUMN time_zone TEXT NOT NULL DEFAULT 'UTC';
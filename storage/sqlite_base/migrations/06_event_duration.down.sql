@@ -0,0 +1,68 @@
+-- Events ending on the next days are cut at the start day end (23:59)
+
+CREATE TABLE single_events_old
+(
+    type            TEXT      NOT NULL,
+    start_date_time TIMESTAMP NOT NULL,
+    end_hours       INTEGER   NOT NULL,
+    end_minutes     INTEGER   NOT NULL,
+    created_at      TIMESTAMP NOT NULL,
+    charge_point_id INTEGER   NOT NULL DEFAULT 1,
+    owner_ref       TEXT      NOT NULL DEFAULT ''
+);
+INSERT INTO single_events_old (rowid, type, start_date_time, end_hours, end_minutes, created_at, charge_point_id, owner_ref)
+SELECT rowid, type, start_date_time, end_day_minutes / 60, end_day_minutes % 60, created_at, charge_point_id, owner_ref
+FROM (
+    SELECT *, min(CAST(substr(start_date_time, 12, 2) AS INTEGER) * 60 + CAST(substr(start_date_time, 15, 2) AS INTEGER) + duration_seconds / 60, 23 * 60 + 59) AS end_day_minutes
+    FROM single_events
+);
+DROP TABLE single_events;
+ALTER TABLE single_events_old RENAME TO single_events;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
+
+CREATE TABLE periodic_events_old
+(
+    type            TEXT      NOT NULL,
+    rrule           TEXT      NOT NULL,
+    end_hours       INTEGER   NOT NULL,
+    end_minutes     INTEGER   NOT NULL,
+    created_at      TIMESTAMP NOT NULL,
+    charge_point_id INTEGER   NOT NULL DEFAULT 1
+);
+INSERT INTO periodic_events_old (rowid, type, rrule, end_hours, end_minutes, created_at, charge_point_id)
+SELECT rowid, type, rrule, end_day_minutes / 60, end_day_minutes % 60, created_at, charge_point_id
+FROM (
+    SELECT *, min(CAST(substr(rrule, start_time_pos, 2) AS INTEGER) * 60 + CAST(substr(rrule, start_time_pos + 2, 2) AS INTEGER) + duration_seconds / 60, 23 * 60 + 59) AS end_day_minutes
+    FROM (
+        SELECT *, instr(rrule, char(10)) - 6 - (substr(rrule, instr(rrule, char(10)) - 1, 1) = 'Z') AS start_time_pos
+        FROM periodic_events
+    )
+);
+DROP TABLE periodic_events;
+ALTER TABLE periodic_events_old RENAME TO periodic_events;
+
+CREATE INDEX periodic_events_charge_point_idx ON periodic_events (charge_point_id);
+
+CREATE TABLE periodic_event_exceptions_old
+(
+    periodic_event_id    INTEGER   NOT NULL,
+    occurrence_start     TIMESTAMP NOT NULL,
+    override_start       TIMESTAMP,
+    override_end_hours   INTEGER   NOT NULL DEFAULT 0,
+    override_end_minutes INTEGER   NOT NULL DEFAULT 0,
+    created_at           TIMESTAMP NOT NULL
+);
+INSERT INTO periodic_event_exceptions_old (rowid, periodic_event_id, occurrence_start, override_start, override_end_hours, override_end_minutes, created_at)
+SELECT rowid, periodic_event_id, occurrence_start, override_start, end_day_minutes / 60, end_day_minutes % 60, created_at
+FROM (
+    SELECT *, CASE
+                  WHEN override_start IS NULL THEN 0
+                  ELSE min(CAST(substr(override_start, 12, 2) AS INTEGER) * 60 + CAST(substr(override_start, 15, 2) AS INTEGER) + override_duration_seconds / 60, 23 * 60 + 59)
+              END AS end_day_minutes
+    FROM periodic_event_exceptions
+);
+DROP TABLE periodic_event_exceptions;
+ALTER TABLE periodic_event_exceptions_old RENAME TO periodic_event_exceptions;
+
+CREATE UNIQUE INDEX periodic_event_exceptions_event_occurrence_idx ON periodic_event_exceptions (periodic_event_id, occurrence_start);
@@ -0,0 +1,75 @@
+-- Event end HH:MM (same day as the start) is replaced with the duration (start seconds were kept for the end)
+-- End HH:MM is the charge point wall-clock time: SQLite has no time zones, so single events and moved occurrences
+-- durations are calculated from the UTC start here and fixed for non-UTC charge points by the Go post migration
+
+CREATE TABLE single_events_new
+(
+    type             TEXT      NOT NULL,
+    start_date_time  TIMESTAMP NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1,
+    owner_ref        TEXT      NOT NULL DEFAULT ''
+);
+INSERT INTO single_events_new (rowid, type, start_date_time, duration_seconds, created_at, charge_point_id, owner_ref)
+SELECT rowid,
+       type,
+       start_date_time,
+       (end_hours * 60 + end_minutes - CAST(substr(start_date_time, 12, 2) AS INTEGER) * 60 - CAST(substr(start_date_time, 15, 2) AS INTEGER)) * 60,
+       created_at,
+       charge_point_id,
+       owner_ref
+FROM single_events;
+DROP TABLE single_events;
+ALTER TABLE single_events_new RENAME TO single_events;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
+
+-- Start time is taken from the RRULE first (DTSTART) line: "...T093000Z" or ";TZID=...:...T093000"
+CREATE TABLE periodic_events_new
+(
+    type             TEXT      NOT NULL,
+    rrule            TEXT      NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1
+);
+INSERT INTO periodic_events_new (rowid, type, rrule, duration_seconds, created_at, charge_point_id)
+SELECT rowid,
+       type,
+       rrule,
+       (end_hours * 60 + end_minutes - CAST(substr(rrule, start_time_pos, 2) AS INTEGER) * 60 - CAST(substr(rrule, start_time_pos + 2, 2) AS INTEGER)) * 60,
+       created_at,
+       charge_point_id
+FROM (
+    SELECT *, instr(rrule, char(10)) - 6 - (substr(rrule, instr(rrule, char(10)) - 1, 1) = 'Z') AS start_time_pos
+    FROM periodic_events
+);
+DROP TABLE periodic_events;
+ALTER TABLE periodic_events_new RENAME TO periodic_events;
+
+CREATE INDEX periodic_events_charge_point_idx ON periodic_events (charge_point_id);
+
+CREATE TABLE periodic_event_exceptions_new
+(
+    periodic_event_id         INTEGER   NOT NULL,
+    occurrence_start          TIMESTAMP NOT NULL,
+    override_start            TIMESTAMP,
+    override_duration_seconds INTEGER   NOT NULL DEFAULT 0,
+    created_at                TIMESTAMP NOT NULL
+);
+INSERT INTO periodic_event_exceptions_new (rowid, periodic_event_id, occurrence_start, override_start, override_duration_seconds, created_at)
+SELECT rowid,
+       periodic_event_id,
+       occurrence_start,
+       override_start,
+       CASE
+           WHEN override_start IS NULL THEN 0
+           ELSE (override_end_hours * 60 + override_end_minutes - CAST(substr(override_start, 12, 2) AS INTEGER) * 60 - CAST(substr(override_start, 15, 2) AS INTEGER)) * 60
+       END,
+       created_at
+FROM periodic_event_exceptions;
+DROP TABLE periodic_event_exceptions;
+ALTER TABLE periodic_event_exceptions_new RENAME TO periodic_event_exceptions;
+
+CREATE UNIQUE INDEX periodic_event_exceptions_event_occurrence_idx ON periodic_event_exceptions (periodic_event_id, occurrence_start);
@@ -0,0 +1,12 @@
+CREATE TABLE charge_points_old
+(
+    name             TEXT      NOT NULL,
+    site             TEXT      NOT NULL,
+    connectors_count INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    time_zone        TEXT      NOT NULL DEFAULT 'UTC'
+);
+INSERT INTO charge_points_old (rowid, name, site, connectors_count, created_at, time_zone)
+SELECT rowid, name, site, connectors_count, created_at, time_zone FROM charge_points;
+DROP TABLE charge_points;
+ALTER TABLE charge_points_old RENAME TO charge_points;
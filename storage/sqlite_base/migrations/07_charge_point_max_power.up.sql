@@ -0,0 +1 @@
+ALTER TABLE charge_points ADD COLUMN max_power_kw REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+DROP INDEX single_events_charge_point_end_idx;
+
+CREATE TABLE single_events_old
+(
+    type             TEXT      NOT NULL,
+    start_date_time  TIMESTAMP NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1,
+    owner_ref        TEXT      NOT NULL DEFAULT ''
+);
+INSERT INTO single_events_old (rowid, type, start_date_time, duration_seconds, created_at, charge_point_id, owner_ref)
+SELECT rowid, type, start_date_time, duration_seconds, created_at, charge_point_id, owner_ref FROM single_events;
+DROP TABLE single_events;
+ALTER TABLE single_events_old RENAME TO single_events;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
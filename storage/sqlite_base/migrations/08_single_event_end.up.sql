@@ -0,0 +1,8 @@
+-- Event end is stored for the range overlap queries (go-sqlite3 "2006-01-02 15:04:05.999999999-07:00" UTC format, fraction is kept)
+
+ALTER TABLE single_events ADD COLUMN end_date_time TIMESTAMP NOT NULL DEFAULT '';
+UPDATE single_events
+SET end_date_time = strftime('%Y-%m-%d %H:%M:%S', substr(start_date_time, 1, 19), '+' || duration_seconds || ' seconds') ||
+                    substr(start_date_time, 20, length(start_date_time) - 25) || '+00:00';
+
+CREATE INDEX single_events_charge_point_end_idx ON single_events (charge_point_id, end_date_time);
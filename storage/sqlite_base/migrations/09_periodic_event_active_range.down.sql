@@ -0,0 +1,16 @@
+DROP INDEX periodic_events_charge_point_active_idx;
+
+CREATE TABLE periodic_events_old
+(
+    type             TEXT      NOT NULL,
+    rrule            TEXT      NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1
+);
+INSERT INTO periodic_events_old (rowid, type, rrule, duration_seconds, created_at, charge_point_id)
+SELECT rowid, type, rrule, duration_seconds, created_at, charge_point_id FROM periodic_events;
+DROP TABLE periodic_events;
+ALTER TABLE periodic_events_old RENAME TO periodic_events;
+
+CREATE INDEX periodic_events_charge_point_idx ON periodic_events (charge_point_id);
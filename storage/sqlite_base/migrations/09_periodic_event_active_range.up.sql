@@ -0,0 +1,7 @@
+-- Periodic event occurrences (moved ones included) active range, NULL bounds are unknown (existing events) / endless
+-- Existing events bounds are backfilled by the events storage on start (RRULE expansion is done in Go)
+
+ALTER TABLE periodic_events ADD COLUMN active_start TIMESTAMP;
+ALTER TABLE periodic_events ADD COLUMN active_end TIMESTAMP;
+
+CREATE INDEX periodic_events_charge_point_active_idx ON periodic_events (charge_point_id, active_end, active_start);
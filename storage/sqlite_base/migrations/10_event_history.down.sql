@@ -0,0 +1,4 @@
+DROP TRIGGER event_history_no_update;
+DROP INDEX event_history_charge_point_created_idx;
+DROP INDEX event_history_event_idx;
+DROP TABLE event_history;
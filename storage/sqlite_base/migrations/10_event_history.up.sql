@@ -0,0 +1,23 @@
+-- Append-only events change history (before / after are the event JSON snapshots, NULL for create / delete)
+
+CREATE TABLE event_history
+(
+    event_id        INTEGER   NOT NULL,
+    periodic        BOOLEAN   NOT NULL,
+    charge_point_id INTEGER   NOT NULL,
+    operation       TEXT      NOT NULL,
+    actor           TEXT      NOT NULL,
+    before_json     TEXT,
+    after_json      TEXT,
+    created_at      TIMESTAMP NOT NULL
+);
+
+CREATE INDEX event_history_event_idx ON event_history (periodic, event_id);
+CREATE INDEX event_history_charge_point_created_idx ON event_history (charge_point_id, created_at);
+
+CREATE TRIGGER event_history_no_update
+    BEFORE UPDATE
+    ON event_history
+BEGIN
+    SELECT RAISE(ABORT, 'event_history is append-only');
+END;
@@ -0,0 +1,7 @@
+-- Soft-deleted (restorable) events have deleted_at set, those are hard-deleted by the purge
+
+ALTER TABLE single_events ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE periodic_events ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX single_events_deleted_idx ON single_events (deleted_at);
+CREATE INDEX periodic_events_deleted_idx ON periodic_events (deleted_at);
@@ -0,0 +1,41 @@
+CREATE TABLE single_events_old
+(
+    type             TEXT      NOT NULL,
+    start_date_time  TIMESTAMP NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1,
+    owner_ref        TEXT      NOT NULL DEFAULT '',
+    end_date_time    TIMESTAMP NOT NULL DEFAULT '',
+    deleted_at       TIMESTAMP
+);
+INSERT INTO single_events_old (rowid, type, start_date_time, duration_seconds, created_at, charge_point_id, owner_ref, end_date_time, deleted_at)
+SELECT rowid, type, start_date_time, duration_seconds, created_at, charge_point_id, owner_ref, end_date_time, deleted_at
+FROM single_events;
+DROP TABLE single_events;
+ALTER TABLE single_events_old RENAME TO single_events;
+
+CREATE INDEX single_events_charge_point_start_idx ON single_events (charge_point_id, start_date_time);
+CREATE INDEX single_events_charge_point_end_idx ON single_events (charge_point_id, end_date_time);
+CREATE INDEX single_events_deleted_idx ON single_events (deleted_at);
+
+CREATE TABLE periodic_events_old
+(
+    type             TEXT      NOT NULL,
+    rrule            TEXT      NOT NULL,
+    duration_seconds INTEGER   NOT NULL,
+    created_at       TIMESTAMP NOT NULL,
+    charge_point_id  INTEGER   NOT NULL DEFAULT 1,
+    active_start     TIMESTAMP,
+    active_end       TIMESTAMP,
+    deleted_at       TIMESTAMP
+);
+INSERT INTO periodic_events_old (rowid, type, rrule, duration_seconds, created_at, charge_point_id, active_start, active_end, deleted_at)
+SELECT rowid, type, rrule, duration_seconds, created_at, charge_point_id, active_start, active_end, deleted_at
+FROM periodic_events;
+DROP TABLE periodic_events;
+ALTER TABLE periodic_events_old RENAME TO periodic_events;
+
+CREATE INDEX periodic_events_charge_point_idx ON periodic_events (charge_point_id);
+CREATE INDEX periodic_events_charge_point_active_idx ON periodic_events (charge_point_id, active_end, active_start);
+CREATE INDEX periodic_events_deleted_idx ON periodic_events (deleted_at);
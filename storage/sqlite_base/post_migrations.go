@@ -0,0 +1,103 @@
+package sqlite_base
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// postMigration is a Go data migration (which can't be expressed in SQL) applied right after the same version SQL migration.
+type postMigration struct {
+	Version uint
+	Name    string
+	Apply   func(tx *sqlx.Tx) (int64, error)
+}
+
+// postMigrations are sorted by version.
+var postMigrations = []postMigration{
+	{Version: 6, Name: "event durations within the charge point time zone", Apply: migrateEventDurationsToLocalTime},
+}
+
+// applyPostMigration applies the data migration within a single transaction.
+func (s SQLiteBase) applyPostMigration(m postMigration) error {
+	tx, err := s.Db.Beginx()
+	if err != nil {
+		return fmt.Errorf("s.Db.Beginx: %w", err)
+	}
+
+	cnt, err := m.Apply(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("%s: %w", m.Name, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("tx.Commit: %w", err)
+	}
+	s.Logger.Info().Uint("version", m.Version).Int64("rows", cnt).Msgf("Migrated: %s", m.Name)
+
+	return nil
+}
+
+// migrateEventDurationsToLocalTime fixes 06_event_duration durations of non-UTC charge points events (moved occurrences included).
+// End HH:MM was the charge point wall-clock time (the start day), while the SQL migration subtracts the UTC start HH:MM:
+// the end is restored (UTC start HH:MM + duration) and the duration is recalculated from the local start.
+// Periodic events are not affected (DTSTART is stored with the TZID).
+func migrateEventDurationsToLocalTime(tx *sqlx.Tx) (int64, error) {
+	type eventRow struct {
+		Id              int64     `db:"rowid"`
+		Start           time.Time `db:"start"`
+		DurationSeconds int64     `db:"duration_seconds"`
+		TimeZone        string    `db:"time_zone"`
+	}
+
+	targets := []struct {
+		selectQuery string
+		updateQuery string
+	}{
+		{
+			selectQuery: `
+				SELECT se.rowid, se.start_date_time AS start, se.duration_seconds, cp.time_zone
+				FROM single_events se
+				JOIN charge_points cp ON cp.rowid = se.charge_point_id
+				WHERE cp.time_zone != 'UTC'`,
+			updateQuery: `UPDATE single_events SET duration_seconds=? WHERE rowid=?`,
+		},
+		{
+			selectQuery: `
+				SELECT ex.rowid, ex.override_start AS start, ex.override_duration_seconds AS duration_seconds, cp.time_zone
+				FROM periodic_event_exceptions ex
+				JOIN periodic_events pe ON pe.rowid = ex.periodic_event_id
+				JOIN charge_points cp ON cp.rowid = pe.charge_point_id
+				WHERE ex.override_start IS NOT NULL AND cp.time_zone != 'UTC'`,
+			updateQuery: `UPDATE periodic_event_exceptions SET override_duration_seconds=? WHERE rowid=?`,
+		},
+	}
+
+	var cnt int64
+	for _, target := range targets {
+		var rows []eventRow
+		if err := tx.Select(&rows, target.selectQuery); err != nil {
+			return 0, fmt.Errorf("select: %w", err)
+		}
+
+		for _, row := range rows {
+			loc, err := time.LoadLocation(row.TimeZone)
+			if err != nil {
+				return 0, fmt.Errorf("rowid %d: time.LoadLocation(%s): %w", row.Id, row.TimeZone, err)
+			}
+
+			utcStart, localStart := row.Start.UTC(), row.Start.In(loc)
+			endDayMinutes := utcStart.Hour()*60 + utcStart.Minute() + int(row.DurationSeconds/60)
+			end := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), endDayMinutes/60, endDayMinutes%60, localStart.Second(), localStart.Nanosecond(), loc)
+
+			if _, err := tx.Exec(target.updateQuery, int64(end.Sub(localStart)/time.Second), row.Id); err != nil {
+				return 0, fmt.Errorf("rowid %d: update: %w", row.Id, err)
+			}
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
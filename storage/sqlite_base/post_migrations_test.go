@@ -0,0 +1,69 @@
+package sqlite_base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// Test_migrateEventDurationsToLocalTime checks 06_event_duration durations of events defined with the local end HH:MM.
+func Test_migrateEventDurationsToLocalTime(t *testing.T) {
+	baseSt, err := NewSQLiteBase(zerolog.Nop(), TempSQLiteBasePath(t.TempDir()))
+	require.NoError(t, err)
+	defer baseSt.Close()
+
+	migrateManager, _, err := baseSt.newMigrateManager()
+	require.NoError(t, err)
+	require.NoError(t, migrateManager.Migrate(5))
+
+	// Init fixtures
+	// Charge point 2: Europe/Berlin (CEST, UTC+2)
+	// Single event 1: 04.08.2014 09:30 - 13:30 CEST
+	// Single event 2: 05.08.2014 01:00 - 03:00 CEST (04.08.2014 23:00 UTC, negative within UTC)
+	// Single event 3: 04.08.2014 07:30 - 13:30 UTC (default UTC charge point)
+	// Periodic event 1: 04.08.2014 (MON) 09:30 - 13:30 CEST weekly, 11.08.2014 occurrence moved to 12.08.2014 12:00 - 16:00 CEST
+	{
+		createdAt := time.Date(2014, 8, 1, 0, 0, 0, 0, time.UTC)
+		db := baseSt.Db
+
+		db.MustExec(`INSERT INTO charge_points (rowid, name, site, connectors_count, created_at, time_zone) VALUES (2, 'berlin', '', 1, ?, 'Europe/Berlin')`, createdAt)
+
+		insertSingleEvent := `INSERT INTO single_events (rowid, type, start_date_time, end_hours, end_minutes, created_at, charge_point_id) VALUES (?, 'Available', ?, ?, ?, ?, ?)`
+		db.MustExec(insertSingleEvent, 1, time.Date(2014, 8, 4, 7, 30, 0, 0, time.UTC), 13, 30, createdAt, 2)
+		db.MustExec(insertSingleEvent, 2, time.Date(2014, 8, 4, 23, 0, 0, 0, time.UTC), 3, 0, createdAt, 2)
+		db.MustExec(insertSingleEvent, 3, time.Date(2014, 8, 4, 7, 30, 0, 0, time.UTC), 13, 30, createdAt, 1)
+
+		db.MustExec(`INSERT INTO periodic_events (rowid, type, rrule, end_hours, end_minutes, created_at, charge_point_id) VALUES (1, 'Available', ?, 13, 30, ?, 2)`,
+			"DTSTART;TZID=Europe/Berlin:20140804T093000\nRRULE:FREQ=WEEKLY", createdAt)
+		db.MustExec(`INSERT INTO periodic_event_exceptions (rowid, periodic_event_id, occurrence_start, override_start, override_end_hours, override_end_minutes, created_at) VALUES (1, 1, ?, ?, 16, 0, ?)`,
+			time.Date(2014, 8, 11, 7, 30, 0, 0, time.UTC), time.Date(2014, 8, 12, 10, 0, 0, 0, time.UTC), createdAt)
+	}
+
+	require.NoError(t, baseSt.Migrate())
+
+	// ok: durations are defined within the charge point time zone
+	{
+		var singleDurations []int64
+		require.NoError(t, baseSt.Db.Select(&singleDurations, `SELECT duration_seconds FROM single_events ORDER BY rowid`))
+		require.Equal(t, []int64{4 * 3600, 2 * 3600, 6 * 3600}, singleDurations)
+
+		var periodicDuration int64
+		require.NoError(t, baseSt.Db.Get(&periodicDuration, `SELECT duration_seconds FROM periodic_events WHERE rowid=1`))
+		require.EqualValues(t, 4*3600, periodicDuration)
+
+		var overrideDuration int64
+		require.NoError(t, baseSt.Db.Get(&overrideDuration, `SELECT override_duration_seconds FROM periodic_event_exceptions WHERE rowid=1`))
+		require.EqualValues(t, 4*3600, overrideDuration)
+	}
+
+	// ok: not reapplied
+	{
+		require.NoError(t, baseSt.Migrate())
+
+		var singleDurations []int64
+		require.NoError(t, baseSt.Db.Select(&singleDurations, `SELECT duration_seconds FROM single_events ORDER BY rowid`))
+		require.Equal(t, []int64{4 * 3600, 2 * 3600, 6 * 3600}, singleDurations)
+	}
+}
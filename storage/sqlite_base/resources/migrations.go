@@ -2,6 +2,30 @@
 // sources:
 // storage/sqlite_base/migrations/01_initial.down.sql (35B)
 // storage/sqlite_base/migrations/01_initial.up.sql (444B)
+// storage/sqlite_base/migrations/02_charge_points.down.sql (1.135kB)
+// storage/sqlite_base/migrations/02_charge_points.up.sql (674B)
+// storage/sqlite_base/migrations/03_owner_ref.down.sql (753B)
+// storage/sqlite_base/migrations/03_owner_ref.up.sql (73B)
+// storage/sqlite_base/migrations/04_periodic_event_exceptions.down.sql (38B)
+// storage/sqlite_base/migrations/04_periodic_event_exceptions.up.sql (460B)
+// storage/sqlite_base/migrations/05_charge_point_time_zone.down.sql (435B)
+// storage/sqlite_base/migrations/05_charge_point_time_zone.up.sql (76B)
+// storage/sqlite_base/migrations/06_event_duration.down.sql (3.267kB)
+// storage/sqlite_base/migrations/06_event_duration.up.sql (3.315kB)
+// storage/sqlite_base/migrations/07_charge_point_max_power.down.sql (512B)
+// storage/sqlite_base/migrations/07_charge_point_max_power.up.sql (75B)
+// storage/sqlite_base/migrations/08_single_event_end.down.sql (768B)
+// storage/sqlite_base/migrations/08_single_event_end.up.sql (552B)
+// storage/sqlite_base/migrations/09_periodic_event_active_range.down.sql (672B)
+// storage/sqlite_base/migrations/09_periodic_event_active_range.up.sql (465B)
+// storage/sqlite_base/migrations/10_event_history.down.sql (151B)
+// storage/sqlite_base/migrations/10_event_history.up.sql (757B)
+// storage/sqlite_base/migrations/11_event_soft_delete.down.sql (2.079kB)
+// storage/sqlite_base/migrations/11_event_soft_delete.up.sql (359B)
+// storage/sqlite_base/migrations/12_event_version.down.sql (2.010kB)
+// storage/sqlite_base/migrations/12_event_version.up.sql (249B)
+// storage/sqlite_base/migrations/13_event_autoincrement.down.sql (2.148kB)
+// storage/sqlite_base/migrations/13_event_autoincrement.up.sql (2.942kB)
 
 package resources
 
@@ -70,7 +94,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var __01_initialDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\x09\xf2\x0f\x50\x08\x71\x74\xf2\x71\x55\xf0\x74\x53\x70\x8d\xf0\x0c\x0e\x09\x56\x28\xce\xcc\x4b\xcf\x49\x8d\x4f\x2d\x4b\xcd\x2b\x29\xb6\x06\x04\x00\x00\xff\xff\xf6\x7c\xd1\x90\x23\x00\x00\x00")
+var __01_initialDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x23\x00\xdc\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x73\x69\x6e\x67\x6c\x65\x5f\x65\x76\x65\x6e\x74\x73\x3b\x03\x00\xf6\x7c\xd1\x90\x23\x00\x00\x00")
 
 func _01_initialDownSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -90,7 +114,7 @@ func _01_initialDownSql() (*asset, error) {
 	return a, nil
 }
 
-var __01_initialUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\xc1\x8a\xc2\x30\x10\xc6\xf1\x7b\x9e\x62\x8e\xbb\xb0\x6f\xb0\xa7\xee\x32\x48\xa1\xad\x52\x47\xf0\x16\x42\x33\x68\xa0\x4d\x4b\x32\x15\x7c\x7b\x51\x7b\xa8\xd6\x6a\x73\xca\xe1\xcf\x97\xfc\xfe\x4b\x4c\x08\x81\x92\xbf\x0c\x21\x3a\x7f\xa8\x59\xf3\x89\xbd\x44\xf5\xa5\x00\x00\xe4\xdc\x31\x8c\x0e\xe1\x9e\xee\xb7\x62\x4d\x50\xec\xb2\xec\xe7\xd6\x45\x31\x41\xb4\x35\xc2\x5a\x5c\xc3\x40\x69\x8e\x5b\x4a\xf2\xcd\x53\xc7\xde\xea\x63\xdb\x87\x38\xec\xa5\x05\xe1\x0a\xcb\xc9\xde\xb5\x6b\x9c\xef\x85\xe3\xdb\xae\x0a\x6c\x84\xad\x36\x32\xfc\x6f\xf2\xae\xfa\xfe\x55\xea\xc1\xd9\x71\x70\xad\x75\xd5\xbc\x74\x4e\x19\x42\x5f\xf3\x87\x66\x2c\x5c\xa2\x5b\x22\x7b\xa9\xba\x04\x00\x00\xff\xff\x02\x05\x83\xa5\xbc\x01\x00\x00")
+var __01_initialUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\x0a\x82\x40\x10\x86\xef\xfb\x14\x73\x4c\xe8\x0d\x3c\x59\x2c\x21\xa8\x85\x4d\xd0\x6d\x59\xdc\xa1\x16\x74\x95\xdd\x31\xe8\xed\x23\xf1\x60\x89\xe5\x7f\xfe\xf8\x67\xbe\x7f\x5f\xca\x04\x25\x60\xb2\xcb\x24\x04\xeb\x6e\x35\x29\x7a\x90\xe3\x20\x36\x02\x00\x80\x9f\x1d\xc1\x24\x28\xaf\x08\x43\x8a\x23\x42\x71\xc9\xb2\xed\xc0\x05\xd6\x9e\x95\xd1\x4c\x8a\x6d\x43\x80\x69\x2e\xcf\x98\xe4\xa7\x2f\x8e\x9c\x51\xf7\xb6\xf7\x61\xec\x4b\x0b\x94\x07\x59\xce\xfa\xde\x5c\x63\x5d\xcf\x14\x7e\x72\x95\x27\xcd\x64\x94\xe6\xf1\xbf\xd9\x5d\x11\xc5\x42\x7c\x78\x76\xe4\x6d\x6b\x6c\xb5\x6c\xba\x64\xe9\x7d\x5f\xd3\x1f\x66\x6a\xb8\xc6\x6e\x8d\xd9\x7c\x4d\x11\xc5\xaf\x01\x00\x02\x05\x83\xa5\xbc\x01\x00\x00")
 
 func _01_initialUpSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -110,6 +134,486 @@ func _01_initialUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __02_charge_pointsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xc1\x8e\xe2\x30\x10\x44\xef\xfe\x8a\x3e\x82\x94\x3f\xc8\x29\x0b\xcd\xca\x52\xe2\x20\xa7\x57\xe2\x66\x45\xb8\x05\x96\x20\x41\x8e\xd9\xdd\xf9\xfb\xd1\x04\x34\x04\x27\x0c\x68\xc6\x57\x57\x75\x57\xe9\x25\x5e\xea\x72\x0d\x52\x2d\x71\x03\x72\x05\xb8\x91\x15\x55\xd0\xb9\x66\x77\x60\xc3\x7f\xb9\x09\x9d\xd9\xee\x6b\xbf\x63\x73\x6a\x5d\x13\x4c\x17\x6a\x1f\x8c\xb3\xff\x53\x31\x69\x3d\xb1\x77\xad\x75\xdb\x49\x73\x6f\x13\x0b\x8d\x19\x21\x50\xf6\x2b\xc7\x68\x55\x7b\xb0\x62\x26\x00\x00\xc2\xdb\x89\x61\x70\x08\x37\x04\xfd\x51\x25\x81\xfa\x93\xe7\x49\xaf\xbb\xe4\xb1\x75\x60\x13\xdc\x91\x81\x64\x81\x15\x65\xc5\x3a\xd2\x71\x63\xcd\xbe\x3d\xfb\xee\x3a\x4f\x2a\xc2\xdf\xa8\x47\xf3\x3e\x74\x47\xd7\x9c\x03\x77\x5f\xea\xb6\x9e\xeb\xc0\xd6\xd4\xe1\x9a\x6f\xb4\x57\xcc\x53\x21\x55\x85\x9a\x40\x2a\x2a\xc7\x4d\x61\xe6\xdb\x7f\xce\x26\x7d\xd7\x24\x6e\x92\xdc\x22\x27\xc3\x54\xc9\x60\xf5\x5c\x54\x98\xe3\x82\xe0\xa7\x83\x60\xa5\xcb\xe2\x3e\xe1\x95\xef\x04\xa5\x54\x64\x39\xa1\x7e\x04\x10\x34\xaa\xac\x40\x88\x2b\xc7\xe4\xe3\x2f\x65\x9a\xfd\x23\xee\xde\x9f\x0f\xfc\x44\xf3\xd9\xfb\x45\xde\xaf\xb0\x7e\xce\x79\xa2\x57\x44\xba\xcf\xfe\x7d\xbe\x2f\xdb\x2f\x54\xa3\x3c\x77\x5c\x47\x77\x43\xb2\xd1\x65\xc4\x76\x64\x1d\xce\xbd\xbd\x07\xc3\xff\xbf\x4b\xc5\xfb\x00\x9b\x88\xae\xfe\x6f\x04\x00\x00")
+
+func _02_charge_pointsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__02_charge_pointsDownSql,
+		"02_charge_points.down.sql",
+	)
+}
+
+func _02_charge_pointsDownSql() (*asset, error) {
+	bytes, err := _02_charge_pointsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "02_charge_points.down.sql", size: 1135, mode: os.FileMode(0644), modTime: time.Unix(1792293019, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x53, 0x9, 0xb, 0xc0, 0x9c, 0xcc, 0x4, 0xdf, 0xb9, 0xb5, 0x7e, 0xcf, 0x94, 0x5e, 0x37, 0x2e, 0xa6, 0x4, 0x3e, 0x21, 0x98, 0xf4, 0x36, 0x3, 0xe7, 0x42, 0x3a, 0x47, 0xda, 0x16, 0x4e}}
+	return a, nil
+}
+
+var __02_charge_pointsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x91\xb1\x6e\x83\x30\x14\x45\x77\x7f\xc5\xdb\x00\xc9\x4b\x66\x26\x37\xbc\x56\x48\x8e\xa9\x88\xa9\xb2\x59\x16\xbc\xa6\x96\x12\x13\x81\xd3\xf6\xf3\xab\xd0\x04\xc5\x64\xa9\x54\x4f\x1e\xae\xce\xb9\xd7\x5e\xd7\x28\x34\x82\x16\x4f\x12\xa1\xfd\xb0\xc3\x9e\xcc\xa9\x77\x3e\x8c\x2c\x65\x00\x00\xde\x1e\x09\xee\x8f\xc6\x9d\x9e\x2e\xa0\x2a\x0d\xaa\x91\x92\x4f\xc1\xd1\x85\xbf\x05\xdb\xde\x7b\x6a\x43\x3f\x8c\xa6\xed\xcf\x3e\x40\xa9\x34\xbe\x60\xfd\x18\x1c\xc8\x06\xea\x8c\x0d\x37\x62\xb9\xc1\xad\x16\x9b\xd7\x39\xc8\xb2\x9c\xb1\x52\x6d\xb1\xd6\x17\x4c\x15\x4f\x80\x74\xe8\xbf\x5c\xc7\xa7\x11\x7c\x6a\xc8\x1f\xf4\xfc\xce\x93\xb1\x37\x21\x1b\xdc\x42\xba\xe2\x90\x74\xf4\x6e\xcf\x87\x90\x70\x48\x12\x0e\x2b\x0e\xeb\xa6\xae\x51\x69\x33\xf7\xb8\xd8\x85\xd4\x58\x5f\x1f\x70\x74\x7e\x7f\x20\x43\x9f\xe4\xc3\x08\xa2\x28\x60\x5d\xc9\x66\xa3\xa2\x5a\xc6\x75\xf3\xe4\xdb\x0e\x28\xf0\x59\x34\x52\xc3\x2a\x8f\x80\x27\x1a\x5c\xdf\xb9\xf6\x9f\x48\x76\xfd\xe6\x52\x15\xb8\x8b\x5b\x9a\x88\x33\x06\x3b\x5c\x68\xdf\x50\xa9\x38\x07\xe9\x42\xc8\xe1\x37\xdc\xd9\x40\x26\xb8\x23\x65\x79\xac\x59\x74\x8f\x45\x57\xc5\x72\xdf\x52\x92\xe5\xec\x67\x00\x60\x68\x2b\xd9\xa2\x02\x00\x00")
+
+func _02_charge_pointsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__02_charge_pointsUpSql,
+		"02_charge_points.up.sql",
+	)
+}
+
+func _02_charge_pointsUpSql() (*asset, error) {
+	bytes, err := _02_charge_pointsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "02_charge_points.up.sql", size: 674, mode: os.FileMode(0644), modTime: time.Unix(1792293019, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0xba, 0xa1, 0x19, 0xc0, 0xd6, 0x3, 0x84, 0x8d, 0x67, 0xef, 0x6, 0xf3, 0x89, 0xce, 0xfa, 0x41, 0x93, 0x73, 0x34, 0x4, 0x7a, 0x2f, 0xa6, 0x87, 0x76, 0x2, 0x86, 0xc7, 0x98, 0x4f, 0x5a}}
+	return a, nil
+}
+
+var __03_owner_refDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\x4f\x8b\xc2\x30\x14\xc4\xef\xf9\x14\xef\xa8\x90\xcb\x9e\x7b\xca\xea\x73\x09\xa4\xa9\xa4\x4f\xf0\x16\x8a\x09\x1a\xd0\x56\xda\xb8\x7f\xbe\xfd\x62\x15\xb4\x69\xdd\xd3\xe6\xfc\x7b\xd3\x99\xe9\x2c\x4d\xb1\x06\xa9\x97\xb8\x05\xb9\x02\xdc\xca\x92\x4a\xe8\x42\xbd\x3f\x7a\xeb\x3f\x7d\x1d\x3b\xbb\x3b\x54\xed\xde\xdb\x73\x13\xea\x68\xbb\x58\xb5\xd1\x06\xf7\x9d\x31\xb6\x30\x28\x08\x81\xc4\xbb\xc2\xe4\xa6\x39\x3a\x36\x63\x00\x00\xf1\xe7\xec\xe1\xe9\x11\x6e\x09\xfa\xa7\x0b\x02\xbd\x51\x8a\xf7\xdc\x4d\xd8\x55\xd1\xdb\x18\x4e\x1e\x48\xe6\x58\x92\xc8\xd7\x09\xe7\x6b\x67\x0f\xcd\xa5\xed\xee\x7a\x52\x13\x7e\xa0\x19\xe9\x5d\xb9\x53\xa8\x2f\xd1\x77\x7f\x72\xbb\xd6\x57\xd1\x3b\x5b\xc5\xbb\xbf\x17\xdf\x1d\xb4\x10\xdc\x84\x1e\x2c\x71\x25\x36\x8a\xe0\x8d\xcd\x33\x26\x75\x89\x86\xae\x58\x31\xee\x06\x66\x6d\xf3\x15\x1c\xef\xdb\xe1\x69\x76\xfe\x08\xc9\x9f\x73\xf0\x27\xb3\x3c\x35\x34\x67\x25\x2a\x5c\x10\xfc\xbb\x32\xac\x4c\x91\x0f\x33\x64\xac\xdf\xcd\xc4\x9f\xcf\x98\x50\x84\xe6\xd5\x28\xc0\xa0\x16\x39\x42\x5a\xca\x63\x4d\xb7\x2d\x0e\x0f\xa7\x17\x08\x85\x1e\x72\x30\x4b\x9c\x8f\xf2\xcf\x33\xf6\x3b\x00\xaf\xfb\x00\xd2\xf1\x02\x00\x00")
+
+func _03_owner_refDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__03_owner_refDownSql,
+		"03_owner_ref.down.sql",
+	)
+}
+
+func _03_owner_refDownSql() (*asset, error) {
+	bytes, err := _03_owner_refDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "03_owner_ref.down.sql", size: 753, mode: os.FileMode(0644), modTime: time.Unix(1792293313, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xea, 0x1f, 0xa4, 0x3, 0xbf, 0xb7, 0x47, 0x8e, 0xbf, 0x2, 0xc9, 0x73, 0x60, 0x68, 0xc3, 0x6f, 0xa5, 0x5, 0x41, 0x24, 0x5a, 0xd, 0x62, 0x25, 0x77, 0x60, 0xff, 0x6f, 0x26, 0xf6, 0x9c, 0x7}}
+	return a, nil
+}
+
+var __03_owner_refUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x49\x00\xb6\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x69\x6e\x67\x6c\x65\x5f\x65\x76\x65\x6e\x74\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x6f\x77\x6e\x65\x72\x5f\x72\x65\x66\x20\x54\x45\x58\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x27\x27\x3b\x0a\x03\x00\xd9\xcb\x96\xb7\x49\x00\x00\x00")
+
+func _03_owner_refUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__03_owner_refUpSql,
+		"03_owner_ref.up.sql",
+	)
+}
+
+func _03_owner_refUpSql() (*asset, error) {
+	bytes, err := _03_owner_refUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "03_owner_ref.up.sql", size: 73, mode: os.FileMode(0644), modTime: time.Unix(1792293313, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf7, 0xb2, 0xfd, 0xfa, 0x95, 0x0, 0xa7, 0xc2, 0x33, 0xc5, 0xd0, 0xcd, 0x54, 0xfd, 0x1, 0xd4, 0x35, 0x6b, 0x2a, 0x3a, 0xf5, 0x98, 0x6, 0xa0, 0x76, 0xc1, 0x6, 0xf7, 0x97, 0x3f, 0x71, 0x5e}}
+	return a, nil
+}
+
+var __04_periodic_event_exceptionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x26\x00\xd9\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x70\x65\x72\x69\x6f\x64\x69\x63\x5f\x65\x76\x65\x6e\x74\x5f\x65\x78\x63\x65\x70\x74\x69\x6f\x6e\x73\x3b\x0a\x03\x00\x6c\x10\xd1\xd1\x26\x00\x00\x00")
+
+func _04_periodic_event_exceptionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__04_periodic_event_exceptionsDownSql,
+		"04_periodic_event_exceptions.down.sql",
+	)
+}
+
+func _04_periodic_event_exceptionsDownSql() (*asset, error) {
+	bytes, err := _04_periodic_event_exceptionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "04_periodic_event_exceptions.down.sql", size: 38, mode: os.FileMode(0644), modTime: time.Unix(1792293829, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x76, 0xcf, 0x74, 0x4c, 0x56, 0x87, 0x88, 0x14, 0x51, 0x25, 0x1b, 0x36, 0x60, 0x43, 0xd5, 0x91, 0x53, 0xfe, 0xa0, 0x6e, 0xf7, 0x8e, 0xe7, 0x27, 0x2e, 0xa7, 0xa3, 0x66, 0x69, 0xe4, 0x5a, 0xf4}}
+	return a, nil
+}
+
+var __04_periodic_event_exceptionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x41\x4b\x03\x31\x10\x85\xef\xf9\x15\x73\x6c\xa1\x07\xef\x3d\xad\x76\x94\x40\x9a\x6a\x4d\xc0\x5b\x58\x92\x01\xe7\x60\x52\x26\xd9\xd2\x9f\x2f\x95\x0a\x4b\xdc\x82\xd7\x37\xdf\x63\x1e\xdf\xd3\x11\x07\x87\xe0\x86\x47\x83\x70\x22\xe1\x92\x38\x06\x3a\x53\x6e\x81\x2e\x91\x4e\x8d\x4b\xae\x6a\xa5\x00\xa0\xbf\x73\xba\x86\xda\x3a\x7c\xc1\x23\x00\xd8\x83\x03\xeb\x8d\xd9\xfc\xc0\x25\xc6\x49\x84\x72\xa4\x50\xdb\x28\xed\x9a\x81\xd3\x7b\x7c\x77\xc3\xfe\xb5\x87\xcf\x24\xc2\x69\x8e\xce\xe0\x8e\xa1\x9c\xc2\x67\x99\xa4\x2e\x7e\x87\x1d\x3e\x0f\xde\x38\x78\x58\xa8\x7d\x71\x9e\x1a\xd5\x7f\xd4\xa2\xd0\xd8\x28\x85\xf1\x77\xcd\xf2\x7c\xb5\xde\x2a\x75\x93\xe8\xad\x7e\xf3\x08\xda\xee\xf0\xe3\xbe\xcb\x5b\x30\xd3\xc3\xe9\x02\x07\x7b\xbf\x01\xab\xee\xc4\x69\xf3\x47\xef\x7a\xab\xbe\x07\x00\xa7\x36\xe4\xe3\xcc\x01\x00\x00")
+
+func _04_periodic_event_exceptionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__04_periodic_event_exceptionsUpSql,
+		"04_periodic_event_exceptions.up.sql",
+	)
+}
+
+func _04_periodic_event_exceptionsUpSql() (*asset, error) {
+	bytes, err := _04_periodic_event_exceptionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "04_periodic_event_exceptions.up.sql", size: 460, mode: os.FileMode(0644), modTime: time.Unix(1792293829, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9c, 0xde, 0x8b, 0xa4, 0x91, 0xda, 0xb6, 0x1e, 0x7f, 0x38, 0x49, 0x7f, 0x0, 0xc4, 0x74, 0x5c, 0x5a, 0xea, 0x90, 0x93, 0x25, 0x2c, 0x2d, 0x21, 0xae, 0xdf, 0x6b, 0xf7, 0xbc, 0x39, 0xfb, 0x79}}
+	return a, nil
+}
+
+var __05_charge_point_time_zoneDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xcd\x4a\xc4\x30\x10\xc7\xef\xf3\x14\x73\xdc\x85\xbc\x41\x4f\x71\x1d\xa5\x90\x8f\x25\x1d\xc1\x5b\x08\x69\xd0\x82\x26\x92\x46\x7c\x7d\x31\xa0\x54\xab\xe0\xe6\x94\xc3\x6f\xfe\x5f\x27\x47\x92\x09\x59\x5e\x29\xc2\xf8\x18\xea\x43\xf2\x2f\x65\xc9\x6d\xf5\xe5\x69\x86\x03\x20\x22\xe6\xf0\x9c\x70\xfb\x98\xee\xb9\x7f\xd0\x58\x46\x73\xa7\x94\xe8\xe0\xba\xb4\xff\x81\xb1\xe4\x9c\x62\x2b\x75\xf5\xb1\xbc\xe6\x86\xa3\x61\xba\x25\xb7\x07\x6b\x0a\x2d\xcd\x3e\xb4\x4f\xc5\x51\xd3\xc4\x52\x9f\xbf\x40\x38\x0e\x30\x9a\x89\x1c\x7f\xa8\xd8\x7d\x0b\x3c\xd4\xf2\xb6\xcc\xa2\xf7\x10\x3d\xa4\xd8\x25\x10\x1b\xab\x23\x4c\xa4\xe8\xc4\x78\xe1\x1d\xde\x38\xab\xbf\xfb\x0f\x70\xed\xec\xf9\xb7\x7d\x07\x90\x8a\xc9\xfd\x35\x3d\x3a\x32\x52\x13\xfe\x2c\x34\xc0\xfb\x00\x7d\xf2\xfc\xed\xb3\x01\x00\x00")
+
+func _05_charge_point_time_zoneDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__05_charge_point_time_zoneDownSql,
+		"05_charge_point_time_zone.down.sql",
+	)
+}
+
+func _05_charge_point_time_zoneDownSql() (*asset, error) {
+	bytes, err := _05_charge_point_time_zoneDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "05_charge_point_time_zone.down.sql", size: 435, mode: os.FileMode(0644), modTime: time.Unix(1792294073, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x20, 0xf8, 0x1f, 0xa1, 0xb5, 0xda, 0xbc, 0x13, 0xd7, 0xb4, 0xa, 0x4f, 0x0, 0x1b, 0x5d, 0xf6, 0xb0, 0x42, 0x78, 0x5a, 0x3d, 0xa2, 0xa3, 0x46, 0xc8, 0xbc, 0xcf, 0x69, 0xa5, 0x9c, 0x8c, 0x23}}
+	return a, nil
+}
+
+var __05_charge_point_time_zoneUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4c\x00\xb3\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x63\x68\x61\x72\x67\x65\x5f\x70\x6f\x69\x6e\x74\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x74\x69\x6d\x65\x5f\x7a\x6f\x6e\x65\x20\x54\x45\x58\x54\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x27\x55\x54\x43\x27\x3b\x0a\x03\x00\x07\x18\x9b\x77\x4c\x00\x00\x00")
+
+func _05_charge_point_time_zoneUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__05_charge_point_time_zoneUpSql,
+		"05_charge_point_time_zone.up.sql",
+	)
+}
+
+func _05_charge_point_time_zoneUpSql() (*asset, error) {
+	bytes, err := _05_charge_point_time_zoneUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "05_charge_point_time_zone.up.sql", size: 76, mode: os.FileMode(0644), modTime: time.Unix(1792294073, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9c, 0x22, 0x90, 0xae, 0x29, 0xfc, 0x5c, 0x9e, 0x35, 0x4b, 0xc2, 0xa2, 0xe7, 0xbf, 0x2d, 0x77, 0x21, 0xa6, 0x24, 0x3a, 0x23, 0xd, 0xf, 0xa4, 0xd6, 0xa8, 0x7b, 0x5f, 0xe8, 0x76, 0x64, 0xf3}}
+	return a, nil
+}
+
+var __06_event_durationDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x6f\x9b\x30\x10\x7e\xe7\xaf\xb8\x97\x29\xd0\x12\x2d\x49\xd5\x4a\x5d\xb4\x07\x96\xb8\x5b\xa4\x84\x74\xe0\x68\xd5\x5e\x10\xc3\x5e\x6b\xa9\x35\x91\x31\xfd\xf1\xdf\x4f\x98\x84\x18\x43\x28\x8a\xb6\x87\x91\xa7\xe0\xef\xee\xbe\x3b\xdf\xf7\x89\xe1\x10\xd0\x33\xe5\x32\x03\xca\x09\xe3\xf7\x90\x72\x90\x0f\x14\x38\x7d\x95\x40\xe2\xb7\x0c\x62\x41\x21\xc9\x25\xc4\x52\x1d\x64\x32\x16\xea\xa4\x08\x00\x7b\x72\xf1\xe9\xf2\xda\xb1\xac\x59\x80\x3c\x8c\x00\x7b\x5f\x96\x08\x32\xc6\xef\x1f\x69\x44\x55\xe2\x28\x7d\x24\x96\x6d\x01\x00\xc8\xb7\x2d\x05\xed\xc1\xe8\x0e\x83\x7a\xfc\x35\x06\x7f\xb3\x5c\xba\x0a\xa7\x6a\x44\x24\x96\x34\x92\xec\x89\x02\x5e\xac\x50\x88\xbd\xd5\xad\x81\xa3\x9c\x44\x0f\x69\x2e\xb2\x5d\xbe\x85\x8f\xd1\x57\x14\x34\xf2\x15\xb8\x27\xc6\x73\x49\xb3\x4e\x5c\x22\x68\x2c\x29\x89\x62\xb9\xe3\x77\xa4\x6e\xf2\x10\x8b\x7b\x1a\x6d\x53\xc6\x65\xc4\x48\x4b\x3e\x98\xa3\x1b\x6f\xb3\xc4\x30\x2e\x33\xa7\x2f\x9c\x8a\x48\xd0\xdf\x47\x3b\xaf\x22\x06\x03\xcb\x99\x5a\x0b\x3f\x44\x01\x2e\x32\xaf\x9b\xe3\x04\x5b\xa4\x2f\x8c\xb8\x6a\xa0\xae\x39\x2e\xf7\x30\x17\x57\x6f\xdd\xd5\xfa\x73\xcd\x1e\xdc\x03\x45\xc7\x0a\xd1\x12\xcd\x30\xbc\x5f\x84\xc4\x6f\xd5\x60\x3f\xc2\xd5\xa8\xf9\xf6\x83\x7a\xdb\xaf\xb0\x75\x13\xac\x57\x50\xae\xca\x8e\xc2\x99\x0b\x4f\x8c\xdb\x33\x2f\xc4\x76\x96\xff\xca\xa4\xb0\x1b\x44\xc6\x13\x17\x26\x0e\x78\xe1\xfe\x1e\x1c\x38\x83\xab\x11\x9c\x43\x77\xd8\x65\x23\xec\x1c\x48\x2e\x62\xc9\x52\x1e\x65\x34\x49\x39\xd9\x77\x35\xb9\xd8\xa7\xbc\xbc\x56\x95\x8c\x36\x15\x65\xc5\xbe\x76\x57\xc5\x45\xce\x83\xf5\x6d\x9b\x2c\xa6\x96\xb7\xc4\x28\x38\xa6\x18\x08\x90\xef\xad\x10\x98\xd7\x3f\xad\xa4\xb6\xf0\xe7\xe8\xce\x08\xac\xcd\xb6\xec\x98\x91\x57\x58\xfb\x75\x1c\xd8\x8d\x4b\x30\xc6\xe3\x1c\xea\x94\x04\xb7\x54\xb0\x94\xb0\xe4\x74\x51\x0b\x91\x3f\xd2\x1e\xb8\xff\x41\xd4\xa6\x42\x5b\xa6\x63\x68\x54\x75\x7f\x92\x32\xdb\xe5\xa8\xe5\xfb\x0b\x22\xec\xa7\xbc\x5d\xcd\x72\x55\x0a\x13\x88\xb6\x69\xd6\x47\x7b\xad\x81\x70\x0e\x93\x7f\xa1\xc0\xb2\x8b\x7a\x27\x8c\x6b\x34\x8a\xde\xed\xf1\xc8\x71\x60\x08\x57\x30\x04\x83\xe5\x51\xec\xd8\x85\xb1\x03\x9f\x61\xf0\x73\xa0\x48\xd7\xdb\xa9\xaa\x2a\x12\xc6\x42\xa8\x43\xc7\xb0\x03\x03\x53\x37\x04\xe3\xd0\xb0\x84\x46\x68\xdd\x14\xcc\x60\xe3\xb6\x95\x21\x18\x98\x86\x25\xbc\xe3\x00\x11\x7d\x4d\xe8\xb6\xb8\x28\xdd\x0b\x0c\x0c\x23\x5d\xc2\x4c\x93\x24\x17\x82\xf2\x84\x46\x6a\x94\x9d\xea\x4c\x9f\xa9\x10\x8c\xe8\x50\x0d\x6c\x60\x74\x0b\xe9\x90\xf1\xa8\x25\x6c\x2f\x99\xf7\xc3\x0e\x72\xda\xb1\x69\xa7\xdf\x6d\x15\xc6\x18\x2b\xd3\x68\x0c\xd2\x6d\x8c\xcb\x3d\x10\x37\xff\x6b\x2e\xd3\xd6\x9c\x6e\x05\xa6\xbb\x9c\x54\xf8\x34\x17\x6a\x37\x9d\x99\x17\xa2\x4a\x4b\x87\xdf\x8f\x6f\xc8\x37\xea\xc2\x22\x54\x0b\x02\xb8\x38\x1b\xb5\x04\xa1\x65\x88\x1a\x2e\x66\x92\xef\xf7\xf9\xd0\x88\x6a\xfb\x7a\xa8\x40\x7d\x4c\xcc\xe0\x8b\xfc\x79\xa7\xad\x1d\xdd\x9b\x4e\x57\xd1\x70\x5d\xfe\xa2\xc1\x3a\x9d\xa6\x96\x6e\x6f\x0f\x1b\x7f\xf1\x7d\xd3\x6e\x3d\x7a\xde\x32\x81\xb6\x4c\xad\x46\xa4\x45\x80\xdd\x63\x19\x9d\xa9\xf5\x67\x00\x8f\x42\xc3\x76\xc3\x0c\x00\x00")
+
+func _06_event_durationDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__06_event_durationDownSql,
+		"06_event_duration.down.sql",
+	)
+}
+
+func _06_event_durationDownSql() (*asset, error) {
+	bytes, err := _06_event_durationDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "06_event_duration.down.sql", size: 3267, mode: os.FileMode(0644), modTime: time.Unix(1792294403, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc3, 0x49, 0xd4, 0xe9, 0xf5, 0xab, 0xe6, 0x4b, 0xaf, 0xd2, 0xe1, 0xfa, 0x63, 0x69, 0x6f, 0xad, 0xe7, 0x68, 0xfd, 0x86, 0xb9, 0xc3, 0x8d, 0x38, 0xa8, 0xac, 0xd6, 0xa0, 0x9a, 0x6b, 0xd8, 0x11}}
+	return a, nil
+}
+
+var __06_event_durationUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x51\x6f\xe2\x38\x17\x7d\xcf\xaf\x38\xea\x4b\x93\x99\x80\x68\x3f\x7d\x23\x6d\xd1\x3c\xb0\xc5\x33\x45\x82\x74\x26\x31\xda\x51\x5f\xa2\x4c\xe2\x16\xab\x60\x23\x3b\x94\x76\x7f\xfd\x2a\x0e\x09\x89\x13\xba\xec\xce\xc3\xd2\x17\x6a\x9f\x7b\xef\x39\xd7\x3e\xd7\x0c\x06\x20\x2f\x4c\xe4\x60\x22\xc3\xdd\xdd\xcd\x62\x01\x57\x27\x1b\x86\x2c\x79\x43\xa2\x91\xaf\x18\x74\x9e\xa8\xdc\x03\xd7\x50\x6c\xbb\x4e\x52\x96\x61\xcf\xf3\x95\xd9\xcb\x76\x2a\xc9\xb9\x14\x70\x0d\x0a\x9a\xa5\x52\x64\x1a\x7b\xa6\x18\x9e\xd9\x36\xc7\xa3\x54\x06\xc9\x44\xe6\x39\x45\xb9\xba\x10\x2f\xd3\xa7\xab\x44\x3d\x31\x6c\x25\x17\x39\xf6\xc9\x7a\x3d\x48\xd7\x32\x7d\x46\xce\x37\xec\x06\xd1\xf7\x39\xcf\x19\x56\x89\x86\x90\x66\x0d\x7f\x4a\xc1\xb4\x0f\x2d\xa1\xb9\x78\x5a\x33\xb0\x42\x81\x46\x22\x32\x6c\xe4\x0b\xcb\x20\xd3\x74\xa7\x14\x13\x29\xd3\x45\xc9\x8a\xa4\x46\xa2\x18\xd2\x64\x9d\xee\xd6\x49\xce\x32\x3c\x2a\xb9\x31\x1c\x96\xf4\xb6\x94\x89\x55\x41\xbc\xc8\xf4\xc8\x5f\x0b\x84\x54\x10\x52\x0c\x0a\x40\x93\xa8\xc6\xcf\x37\x13\xf9\x55\x62\x2b\x75\x8e\x0d\x7f\x2a\x8b\x38\xce\x6d\x48\x26\x94\x80\x4e\x7e\x9f\x93\x03\xc5\xb8\xa4\x18\x0b\xb6\x77\x5c\x07\x00\xf2\xb7\x2d\x43\xf3\x43\xc9\x0f\x6a\xbe\x20\xb8\xa7\x08\x96\xf3\xb9\x6f\x80\x86\x56\x9c\x25\x39\x8b\x8d\x7a\xd0\xd9\x82\x44\x74\xb2\xf8\x66\x01\x2b\x95\x71\x75\x06\xb3\x80\x92\xaf\x24\xec\x64\x4c\x15\x2b\xd4\xc7\x49\x5e\x95\x3e\x91\xb1\x14\x1c\x9b\x93\x89\x79\x86\x9e\x8c\x98\x92\x2f\x93\xe5\x9c\xe2\xaa\x64\x2b\xf7\x82\xa9\x58\xb1\xc7\x43\xea\x1e\x59\x75\xc8\xe5\xa5\xe3\x8d\x9d\x59\x10\x91\x90\x16\xa9\xef\xbb\xcd\x82\xab\xe4\x9e\x67\xbe\x69\x97\x6f\xf7\xc2\xef\x68\xf6\x1b\xe2\x7c\x9b\xbf\x7f\x64\xe7\x39\x11\x99\x93\x5b\x8a\x32\xbd\x73\x20\x6b\xaa\x54\xff\xd8\xc5\xaa\x75\x97\x89\x2c\x5e\xc9\x9d\xd2\xf8\x80\x4f\x23\x7c\x2c\xbc\x13\x6f\xb8\xd8\xe5\x4c\x63\x80\xdb\x49\x44\x5d\xbd\xfb\xa9\x73\xe5\x76\x08\x5f\x5d\xfb\xb8\xf6\x30\x89\xaa\x5e\x7a\x65\x92\xbf\x0b\xfb\xbf\x1d\x56\xc6\xd5\xa4\x1a\xb2\xeb\x25\x4b\x7d\xb5\x5e\x37\xc1\xf9\x12\xde\x2f\xda\x3d\x1f\x3b\xd3\xf0\xfe\x5b\xdf\xd5\x1d\x3b\x93\x39\x25\xe1\xa9\x5b\x8d\x90\x04\x93\x05\x81\x7d\x88\xe3\xda\x0e\xb3\x60\x4a\x7e\x58\x81\xad\x03\x2a\x45\xf3\xec\x15\xf7\x41\x1b\x07\xb7\x73\x92\x56\x87\xbc\xb1\x53\x18\x3d\x2a\x56\xcb\x21\x51\x0c\x97\xe4\x99\x89\xa3\xc7\xc3\x70\x39\x27\x78\xe4\x4a\xe7\x70\xa7\x34\xa2\x93\x90\x7a\x58\x73\xc1\x6e\x70\x31\x1c\x0e\xe9\xe8\xb7\xff\x8d\x46\xa3\x87\x0b\x48\x85\x8b\x31\x7d\x98\x4d\x3f\x0f\x87\xc3\x9b\xe3\xd6\x45\xdb\xda\x5b\xa6\xb8\xcc\x78\xfa\x0b\xe6\x56\x6a\xb7\x66\xe7\x00\xff\x63\x73\xdb\x46\xed\x91\x6e\x59\xd5\x28\xfb\x87\x06\x3d\xc7\x95\x65\xde\x7f\xe3\xc5\x03\xa3\xf2\xe6\x14\x77\x24\xde\x4a\x7d\x8e\x1b\x7b\x03\xf1\x11\xd7\xbf\xec\xc9\xd2\x81\xe5\x9d\x39\x48\xff\xe0\x83\x8b\x46\xd5\xa2\x45\xee\xd5\xc8\xf3\x30\xc0\x27\x0c\x60\x91\x3a\x89\xbd\xf2\x71\xe5\xe1\x33\x2e\x1f\x2e\x0d\xc7\x36\x7b\x53\xd1\x14\xb7\x4e\xd2\xf1\x5a\x23\xc0\xda\x6d\x0f\x01\x6b\xd3\x1a\x03\x9d\xd0\xf6\x20\xb0\x83\xad\xce\x98\x21\x60\x61\x3a\x63\xc0\x3b\x26\xed\x63\x14\xb3\xd7\x94\x6d\x8b\xdb\xd7\xf4\xa6\x85\x29\x9e\xb5\xc3\xa7\x6b\x80\xc3\x9b\x56\xff\x9a\x88\x4d\x17\x2b\xfc\x69\x6f\xc9\x17\xa6\x14\xcf\x3a\xf8\x46\x84\x05\x3c\xc3\xdf\xb5\x1b\x0f\x37\xec\x78\xbd\xaa\xe4\x9d\x22\x75\xec\xfb\xfe\xb5\x1a\x55\x3b\xb9\xd3\x2a\xbf\xd3\x0b\xdf\xd2\xea\x9f\x96\xd4\xb4\xfe\x09\xa7\x77\x0b\x56\x3b\x9d\xba\xf5\x46\xbb\x7c\xb5\x7c\x3b\x89\x48\xf5\xbd\xf8\xfb\xe3\x8e\x04\x16\x16\xb3\xc8\xf4\x06\xb4\xd8\x1b\x35\xd1\x64\x1e\x11\xb8\x35\xbc\x33\x64\x5a\x3b\xfd\xd3\xc6\x6e\xcb\x79\x0f\x7f\x27\xea\xc4\xbb\x5f\x91\x25\xc1\xb4\x96\x7c\xec\xae\xd3\xe3\xec\xc6\x19\xbf\xe3\xf0\x16\xea\xb4\xd7\x1b\xb0\x77\x5d\xdf\x4a\x57\x59\x75\x19\xcc\xbe\x2f\xfb\xc7\x40\x33\x6f\x99\xa0\x71\xee\xbd\x43\xa1\x11\x01\xf7\x8c\xfb\xea\x8d\x9d\xbf\x06\x00\x3d\xfe\x46\xf1\xf3\x0c\x00\x00")
+
+func _06_event_durationUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__06_event_durationUpSql,
+		"06_event_duration.up.sql",
+	)
+}
+
+func _06_event_durationUpSql() (*asset, error) {
+	bytes, err := _06_event_durationUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "06_event_duration.up.sql", size: 3315, mode: os.FileMode(0644), modTime: time.Unix(1792294403, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x37, 0xbf, 0xf0, 0x6f, 0xeb, 0x2c, 0x62, 0x40, 0xeb, 0xfe, 0x41, 0x98, 0xda, 0xea, 0x28, 0xf6, 0x86, 0x72, 0x94, 0xfe, 0xfe, 0x43, 0x81, 0x12, 0x78, 0x59, 0x4c, 0x27, 0xda, 0x25, 0x86, 0xd}}
+	return a, nil
+}
+
+var __07_charge_point_max_powerDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\xc1\x4a\xc4\x30\x10\x86\xef\x79\x8a\xff\xb6\xbb\x90\x37\xe8\x29\x76\x67\xa5\x90\xa6\x4b\x3a\x05\x6f\xa1\xb4\x41\x0b\x6e\x22\x6d\x44\xf0\xe9\xc5\x80\xb8\x6e\x2d\x88\x39\xe5\xf0\x31\xf3\x7d\x4c\x69\x49\x31\x81\xd5\x9d\x26\x0c\x4f\xfd\xfc\xe8\xdd\x4b\x9c\x42\x5a\x5c\x7c\x1e\xc5\x5e\x00\x40\xe8\x2f\x1e\xd7\x8f\xe9\x81\xf3\x07\xa6\x61\x98\x4e\x6b\x99\xc1\x65\x4a\x7f\x03\x87\x18\x82\x1f\x52\x9c\x17\x37\xc4\xd7\x90\x50\x19\xa6\x7b\xb2\x6b\x70\xf6\x7d\xf2\xa3\xeb\xd3\xd7\xc4\xaa\xa6\x96\x55\x7d\xbe\x01\xd3\x74\xf1\xee\x3d\x06\xbf\xbd\x1a\x47\x3a\xa9\x4e\x33\x76\x1d\x97\x3b\x71\x28\x44\x65\x5a\xb2\xfc\xb9\xbc\x59\xc7\x63\x3f\xc7\xb7\x69\x94\x39\x5f\xe6\x36\xb9\x12\x97\x57\x86\xf2\x5b\xe2\x20\x5a\xd2\x54\x32\xfe\x3f\x02\x27\xdb\xd4\x3f\xad\x0a\x71\xb4\xcd\xf9\xb7\x63\x15\x42\x69\x26\xbb\x75\x47\x58\x32\xaa\x26\xdc\x66\x16\xe2\x63\x00\x47\x66\x40\x19\x00\x02\x00\x00")
+
+func _07_charge_point_max_powerDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__07_charge_point_max_powerDownSql,
+		"07_charge_point_max_power.down.sql",
+	)
+}
+
+func _07_charge_point_max_powerDownSql() (*asset, error) {
+	bytes, err := _07_charge_point_max_powerDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "07_charge_point_max_power.down.sql", size: 512, mode: os.FileMode(0644), modTime: time.Unix(1792296439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xda, 0x3b, 0xb6, 0x19, 0xb6, 0x70, 0x2f, 0x3e, 0x16, 0xca, 0x8f, 0xf2, 0x41, 0x23, 0xe8, 0xd0, 0x5e, 0xc5, 0x51, 0xa5, 0xe8, 0x58, 0x3b, 0xe1, 0x69, 0x68, 0x1d, 0xb0, 0xf9, 0xc, 0x97, 0xac}}
+	return a, nil
+}
+
+var __07_charge_point_max_powerUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4b\x00\xb4\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x63\x68\x61\x72\x67\x65\x5f\x70\x6f\x69\x6e\x74\x73\x20\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x6d\x61\x78\x5f\x70\x6f\x77\x65\x72\x5f\x6b\x77\x20\x52\x45\x41\x4c\x20\x4e\x4f\x54\x20\x4e\x55\x4c\x4c\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x30\x3b\x0a\x03\x00\x8d\xa1\xb0\x33\x4b\x00\x00\x00")
+
+func _07_charge_point_max_powerUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__07_charge_point_max_powerUpSql,
+		"07_charge_point_max_power.up.sql",
+	)
+}
+
+func _07_charge_point_max_powerUpSql() (*asset, error) {
+	bytes, err := _07_charge_point_max_powerUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "07_charge_point_max_power.up.sql", size: 75, mode: os.FileMode(0644), modTime: time.Unix(1792296439, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x85, 0x5d, 0x46, 0xee, 0xd2, 0x80, 0xba, 0x3a, 0x63, 0x51, 0x91, 0xeb, 0xbc, 0x2a, 0x77, 0x72, 0x76, 0x35, 0xb9, 0x40, 0x9, 0x1d, 0xff, 0xfd, 0x2b, 0xd9, 0x8c, 0xa6, 0x3e, 0x5, 0x9e, 0x84}}
+	return a, nil
+}
+
+var __08_single_event_endDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x92\xb1\x6e\xc2\x30\x10\x86\x77\x3f\xc5\x6d\x80\xe4\xa5\x73\xa6\x14\x8e\x2a\x52\xe2\x20\x73\x48\x6c\x56\x84\xaf\xd4\x12\x75\x90\xe3\x16\xfa\xf6\x55\x43\x5b\xc0\x01\x75\x6a\xa6\x0c\x77\xbf\xef\xfb\xf4\xcf\x74\xbd\x80\x42\xcd\x70\x0d\x9d\xf3\xdb\x1d\x1b\x7e\x67\x1f\x3b\xb3\x79\x69\xc2\x96\xcd\xbe\x75\x3e\x1a\xf6\xd6\x38\x7b\xcc\x84\x98\x6a\xcc\x09\x81\xf2\xc7\x12\x93\x8d\x76\x67\xc5\x58\x00\x00\xc4\x8f\x3d\xc3\xe5\x47\xb8\xa6\xfe\x07\x54\x4d\xa0\x56\x65\x29\xfb\xc1\x2e\x36\x21\x1a\xdb\x44\x36\xd1\xbd\x32\x00\x15\x15\x2e\x29\xaf\x16\xc9\xa0\x7d\x0b\x4d\x74\xad\x37\x1d\x6f\x5a\x6f\x3b\x28\x14\xe1\x13\xea\x41\xe2\x26\x70\x13\xd9\x9a\x26\xfe\x3c\x7d\x27\xf1\x8a\xcf\x59\xb8\x91\x08\x33\x9c\xe7\xab\x92\xe0\xe1\x74\x6d\x7b\xf0\x1c\x4c\xe0\xe7\xef\xe8\x1b\x58\xbf\x2b\xa3\x91\x98\x64\xa2\x50\x4b\xd4\xf4\x15\x5d\x0f\x65\xc1\x38\xb4\x07\x67\x65\xaf\x4b\xa6\x2e\xe4\x80\x59\x5e\xc0\xc9\xf4\x7e\x79\xbe\x6e\x22\x96\x58\xe2\x94\xe0\x7f\xe2\x61\xae\xeb\xea\x9a\x26\x13\x7d\x8d\x6e\x94\x22\x13\x79\x49\xa8\xef\xf5\x05\x34\xaa\xbc\x42\x48\xf5\x9c\x8b\xf6\x67\x35\x4f\xda\x9c\x3d\x42\xad\xae\xe7\x60\x3c\x80\x48\x24\x4c\x32\xf1\x39\x00\xbd\xf3\x78\xc4\x00\x03\x00\x00")
+
+func _08_single_event_endDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__08_single_event_endDownSql,
+		"08_single_event_end.down.sql",
+	)
+}
+
+func _08_single_event_endDownSql() (*asset, error) {
+	bytes, err := _08_single_event_endDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "08_single_event_end.down.sql", size: 768, mode: os.FileMode(0644), modTime: time.Unix(1792297300, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xad, 0xb0, 0xb2, 0xd5, 0x88, 0x5b, 0xc, 0x7a, 0xd9, 0x98, 0x6c, 0x4f, 0x99, 0xdd, 0x51, 0xa2, 0xab, 0xfe, 0x70, 0x92, 0x2, 0x74, 0xc9, 0xba, 0xb3, 0xb6, 0xf5, 0x97, 0x21, 0x2f, 0x43, 0x63}}
+	return a, nil
+}
+
+var __08_single_event_endUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x4d\x8b\xdb\x30\x10\x86\xef\xfe\x15\x2f\x0b\x46\x31\x6b\x15\x25\x6d\x5a\xd6\xa1\x07\x37\x76\xe9\x82\xe3\x2c\x1b\x19\xda\x93\x51\xa3\x89\x23\x9a\xc8\x59\x49\x59\x7a\xc8\x8f\x2f\x82\x96\x92\xd0\xce\x49\x1f\xa3\x47\xef\x33\x9c\xa3\x7e\x25\x1b\x40\x56\xc3\x78\xf8\x30\x3a\xd2\xd8\x8d\x0e\x61\x4f\x70\xca\x0e\x84\xf1\x95\xdc\x41\x9d\xf0\x72\x26\x67\xc8\x63\x32\x8c\xdc\xbf\x1c\x4c\xa0\xb7\xb8\x9b\x09\xf1\x9e\x8b\x29\x17\x33\x4c\xe7\x85\x78\x57\x88\xf9\x9b\x87\x3f\xc5\xc5\x87\x42\x88\x3b\x74\x72\x19\x99\x47\x15\x72\xec\x9c\xda\x06\x33\xda\xf8\xdd\x0f\x3a\x85\x2c\x49\xca\x46\xd6\xcf\x90\xe5\xa7\xa6\x86\x37\x76\x38\x50\x4f\x31\x95\x47\x59\x55\x58\xae\x9b\x6e\xd5\xc6\x84\xbd\x56\x81\xfa\x60\x8e\x04\xf9\xb8\xaa\x37\xb2\x5c\x3d\xa1\x5d\x4b\xb4\x5d\xd3\xa0\xaa\x3f\x97\x5d\x23\xc1\xd8\x22\xe9\x9e\xaa\x52\xde\xc0\x92\x4d\x2d\x6f\x28\x1f\xe1\x83\xdb\xc5\xe5\x84\xa5\xdf\x78\x7a\xe4\xa9\x46\xfa\xa5\x48\x57\x45\xba\x61\x39\xfc\xf9\xbb\x0f\x6e\xe2\x83\x72\xe1\xef\xb3\x1c\xd3\x1c\xd3\x87\x2c\x07\xbb\x67\xb8\x5c\xa0\xcf\x4e\x45\xa5\xde\xd3\x76\xb4\xda\xc7\x33\x86\xdf\x1b\x96\xe1\x72\x49\xf0\x8f\xfa\x1f\x7d\x26\x72\x1c\xc8\x0e\x61\x7f\x7b\x97\x81\x63\x36\x8f\x40\xb0\x7b\x21\x0a\x21\xd8\x22\x49\x96\xcf\x75\x94\x7d\x6c\xab\xfa\xeb\xb5\x72\xbf\xdd\x2b\x37\x50\x7f\x1a\x8d\x0d\x7d\x74\x37\xfa\x27\xd6\xed\x75\x17\x26\x57\x6d\x46\xe7\xd7\x63\xca\x16\xc9\xaf\x01\x00\x2b\x45\xa2\xcd\x28\x02\x00\x00")
+
+func _08_single_event_endUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__08_single_event_endUpSql,
+		"08_single_event_end.up.sql",
+	)
+}
+
+func _08_single_event_endUpSql() (*asset, error) {
+	bytes, err := _08_single_event_endUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "08_single_event_end.up.sql", size: 552, mode: os.FileMode(0644), modTime: time.Unix(1792297300, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0xd3, 0x81, 0xc1, 0xa, 0x6d, 0x31, 0x7e, 0xa8, 0x8a, 0x5b, 0x34, 0x4b, 0x49, 0x52, 0x83, 0x5c, 0x1c, 0xdb, 0x36, 0x45, 0x41, 0xde, 0xdf, 0xc, 0x64, 0xe, 0x95, 0x1d, 0x25, 0xf4, 0x18}}
+	return a, nil
+}
+
+var __09_periodic_event_active_rangeDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\xc1\x6b\x83\x30\x14\xc6\xef\xf9\x2b\xde\xb1\x42\x2e\x3b\x7b\xca\xea\xeb\x10\x62\x2c\xf1\x15\x7a\x0b\x62\xc2\x16\x28\x46\x62\xda\x75\xff\xfd\x98\x63\x4c\xd4\x41\x61\x9e\x3c\xfc\xf2\xf1\xfd\xf8\x5e\xa1\xeb\x23\x94\xaa\xc0\x33\x0c\x2e\xfa\x60\x7d\x67\xdc\xcd\xf5\x69\x34\xdd\x5b\x1b\x5f\x9d\x19\x82\xef\x93\x69\xbb\xe4\x6f\xce\x78\x7b\xcf\x19\xdb\x6b\x14\x84\x40\xe2\x59\xe2\xea\x59\xb8\x58\xb6\x63\x00\x00\xe9\x63\x70\x30\xff\x08\xcf\x34\xfd\x80\xaa\x09\xd4\x49\x4a\x3e\x81\x31\x5e\x2f\xee\x11\xd0\x5e\x63\x9b\x7c\xe8\xcd\xe8\xba\xd0\xdb\x11\x4a\x45\xf8\x82\x7a\x05\x76\xd1\xb5\xc9\x59\xd3\xa6\x9f\xc4\xb2\xc2\x86\x44\x75\x5c\x82\x73\x49\x6f\x61\x23\x11\x0a\x3c\x88\x93\x24\x78\x62\x59\xce\x4a\xd5\xa0\xa6\x2f\xac\xde\x52\x87\x5d\x0c\xef\xde\xf2\x49\x9e\x7f\x9b\xf1\x55\x6f\x3e\x2b\xc8\x97\x1d\x32\xd6\xa0\xc4\x3d\xc1\xbf\x93\xe0\xa0\xeb\x6a\xd9\x32\x67\xd3\xe6\x9b\xe3\xe5\x4c\x48\x42\xfd\xf7\xb2\xa0\x51\x89\x0a\x61\x2d\xff\x7b\x16\x0f\x5c\x93\xb7\x77\xa8\xd5\x92\x81\xdd\x02\xca\x72\xf6\x39\x00\xed\x09\x11\xb6\xa0\x02\x00\x00")
+
+func _09_periodic_event_active_rangeDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__09_periodic_event_active_rangeDownSql,
+		"09_periodic_event_active_range.down.sql",
+	)
+}
+
+func _09_periodic_event_active_rangeDownSql() (*asset, error) {
+	bytes, err := _09_periodic_event_active_rangeDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "09_periodic_event_active_range.down.sql", size: 672, mode: os.FileMode(0644), modTime: time.Unix(1792297434, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5a, 0x3b, 0xc0, 0xf6, 0xe4, 0xee, 0x4, 0xa0, 0xe, 0x83, 0x4, 0x73, 0x3c, 0x83, 0x41, 0xf8, 0x5f, 0x25, 0x51, 0xe1, 0x95, 0x2, 0x3f, 0x39, 0xbe, 0xf1, 0x17, 0x2d, 0x91, 0xff, 0x2, 0xd0}}
+	return a, nil
+}
+
+var __09_periodic_event_active_rangeUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xc1\x6e\xf2\x30\x10\x84\xef\x79\x8a\x39\x26\x12\xd1\xff\x00\x9c\xf2\x83\x55\x21\x99\x80\xd2\x20\xf5\x16\x19\x7b\x1b\x56\xa4\x6b\x64\x3b\x94\xbe\x7d\x0f\xa4\x55\x68\x4f\xbd\xad\xb4\xf3\x7d\xa3\x29\x4b\xec\x29\xb0\x77\x6c\x41\x57\x92\x04\x6f\xed\x18\x02\x89\xa5\x88\xfc\xcd\x5f\xc9\xc1\x0b\x45\xb0\xd8\x61\x74\xe4\x0a\x18\x9b\xf8\x4a\x08\x46\x7a\x5a\xa0\x3e\x68\x8d\xa3\x1f\xc5\x45\x98\x40\x18\xe5\x2c\xfe\x5d\x90\xd3\x8d\x63\x62\xe9\xef\xde\x58\xe0\x1f\x48\xdc\x40\x31\x66\x65\x09\xf5\xf8\x9d\x1b\x8e\xc6\x9e\x5f\x79\x18\xc8\xe1\xf8\x81\x74\xa2\xaf\x4c\x4c\x3e\x98\x9e\xe0\x05\x31\x99\x90\x90\x37\xcd\x41\x2b\xd0\xed\x62\x24\xb2\x17\x70\x84\xf3\x42\x60\xc1\x93\x2f\xb2\xac\xd2\xad\x6a\xd0\x56\xff\xb5\xc2\x65\xda\xd9\x4d\xb6\x6a\xbd\xc6\x6a\xa7\x0f\xdb\x7a\x5a\xd4\xdd\xa5\xed\x66\xab\x9e\xdb\x6a\xbb\x5f\xfe\x11\x27\x71\x73\x38\x5b\x35\xaa\x6a\x15\x36\xf5\x5a\xbd\xfc\xc4\x3b\x7b\x32\xa1\xa7\xee\xe2\x59\x52\x37\x09\xd8\xdd\xb0\xab\x7f\x35\xe5\x0f\x59\x76\x8b\x59\xe1\xf7\x1d\x93\x09\xa9\x58\x66\x9f\x03\x00\x1b\x23\x87\xce\xd1\x01\x00\x00")
+
+func _09_periodic_event_active_rangeUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__09_periodic_event_active_rangeUpSql,
+		"09_periodic_event_active_range.up.sql",
+	)
+}
+
+func _09_periodic_event_active_rangeUpSql() (*asset, error) {
+	bytes, err := _09_periodic_event_active_rangeUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "09_periodic_event_active_range.up.sql", size: 465, mode: os.FileMode(0644), modTime: time.Unix(1792297434, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0x45, 0x39, 0x4f, 0xeb, 0xdf, 0x64, 0x5d, 0x6a, 0x75, 0xd3, 0x18, 0x54, 0xda, 0x73, 0xbc, 0x99, 0x56, 0x9e, 0xb, 0x4b, 0x40, 0xb, 0x6, 0x78, 0x7, 0xb3, 0xd5, 0xfe, 0x5b, 0x94, 0xda}}
+	return a, nil
+}
+
+var __10_event_historyDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\x09\xf2\x0f\x50\x08\x09\xf2\x74\x77\x77\x0d\x52\x48\x2d\x4b\xcd\x2b\x89\xcf\xc8\x2c\x2e\xc9\x2f\xaa\x8c\xcf\xcb\x8f\x2f\x2d\x48\x49\x2c\x49\xb5\xe6\x02\xab\xf2\xf4\x73\x71\x8d\x40\x53\x93\x9c\x91\x58\x94\x9e\x1a\x5f\x90\x9f\x99\x57\x12\x9f\x5c\x94\x9a\x58\x92\x9a\x12\x9f\x99\x52\x81\x47\x0b\xc4\x00\x84\x9a\x10\x47\x27\x1f\x57\x54\x35\xd6\x5c\x80\x01\x00\x84\xea\x07\x63\x97\x00\x00\x00")
+
+func _10_event_historyDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__10_event_historyDownSql,
+		"10_event_history.down.sql",
+	)
+}
+
+func _10_event_historyDownSql() (*asset, error) {
+	bytes, err := _10_event_historyDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "10_event_history.down.sql", size: 151, mode: os.FileMode(0644), modTime: time.Unix(1792298485, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x30, 0xd9, 0x51, 0xc9, 0xdb, 0x96, 0x4e, 0xc4, 0x99, 0x9, 0xc0, 0xf, 0xae, 0xd7, 0x29, 0xdb, 0x66, 0x47, 0x7c, 0xf, 0xbf, 0xa4, 0xab, 0xbf, 0x9f, 0x3c, 0x1c, 0xed, 0xf9, 0x6f, 0x34, 0xca}}
+	return a, nil
+}
+
+var __10_event_historyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\xc1\xae\x9b\x30\x10\x45\xf7\xfe\x8a\xd9\x3d\x90\x88\xfa\x01\xac\xcc\xcb\x34\xa2\x22\x26\x02\x47\xca\x0e\xb9\x30\x09\xae\x22\x8c\x8c\x5b\x35\x7f\x5f\x01\x26\x09\x69\x1b\x56\xc8\xf7\xfa\xcc\x70\xc4\x66\x03\xbc\xef\xa9\x6b\x36\xa6\xbb\xde\x80\x7e\x51\xe7\x06\xa8\x5b\xd5\x5d\x08\x5a\x3d\x38\x63\x6f\x10\x7c\xa7\xb3\xb1\x04\x5f\x40\x9d\x1d\x59\x50\x96\xc0\xb5\x34\xb7\xe1\x5b\x99\x0b\x18\x3a\xd5\x0f\xad\x71\x43\x04\xe2\x98\x65\x70\x36\x16\x6a\x4b\xca\x8d\xb7\x1a\xba\x92\xa3\x90\xb1\xcf\x02\xb9\x44\x90\x3c\xc9\x70\xbe\x5d\xf9\x19\x2c\x60\x00\xe0\xcf\x74\x03\xfe\x49\x85\xc4\x1d\x16\x00\x20\x72\x39\x91\xa3\xa9\xd7\x93\xd5\xa6\xd1\xf5\xd2\x4b\xf2\x3c\x43\x2e\xfe\xea\xd5\xad\xb2\x17\xaa\x7a\xa3\x67\xec\xff\x78\xa6\x27\xab\x9c\x36\x9d\xe7\x49\x3c\xc9\xe9\xe5\xa5\xa7\x6a\x67\xac\xef\xbc\xeb\xcd\xc2\xaa\x1f\x83\x27\x8e\x3d\x4f\x18\x0d\x3e\x82\xa7\x64\xd6\xd5\x54\xca\xf9\x24\xdd\x63\x29\xf9\xfe\x70\x67\xb3\x30\xbe\x3b\x4c\xc5\x16\x4f\x6b\x87\xd5\x62\xef\x37\xe4\x62\x1d\x41\xb0\x18\x8b\x7c\xa0\x9b\x30\x7e\xc7\x5a\x99\x5b\x56\xfb\x37\xfa\x45\x72\xf4\xf4\x25\x4f\x0b\xcb\x22\xdd\x8d\xea\xd7\x63\x3a\x53\xfd\xec\x1b\xe5\x68\x92\x93\xe0\xd7\xbc\x40\x38\x1e\xb6\x5c\xe2\x74\xf2\x3a\x8d\x25\xb8\x4b\xc5\x14\x95\x98\xe1\xa7\x84\x82\xa7\x25\x06\x3c\xc9\x0b\x19\xc1\xc7\xaa\x0c\x7a\x00\xf5\xf8\xbb\x3f\xc2\x98\xa1\xd8\xc6\xec\xcf\x00\xfa\x07\x37\x9f\xf5\x02\x00\x00")
+
+func _10_event_historyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__10_event_historyUpSql,
+		"10_event_history.up.sql",
+	)
+}
+
+func _10_event_historyUpSql() (*asset, error) {
+	bytes, err := _10_event_historyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "10_event_history.up.sql", size: 757, mode: os.FileMode(0644), modTime: time.Unix(1792298485, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9a, 0x7a, 0x8, 0xe7, 0xb3, 0x7a, 0x4b, 0xee, 0xd7, 0x9f, 0xbb, 0x53, 0x38, 0xb1, 0xc3, 0x6, 0xe8, 0x46, 0x20, 0xc1, 0x50, 0x22, 0xf6, 0x82, 0x79, 0xe7, 0x59, 0xf0, 0x85, 0x46, 0xb7, 0x62}}
+	return a, nil
+}
+
+var __11_event_soft_deleteDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xc1\x6e\x9b\x40\x10\xbd\xef\x57\xcc\x2d\x46\xe2\xd2\x33\x27\x1a\x4f\x5a\x24\x0c\x11\xde\xa8\xb9\xad\x10\x3b\x4d\x57\x72\xc1\x5a\x36\x89\xfb\xf7\x95\xb1\xe3\xec\x0e\x50\xe1\xaa\x95\xe2\xd3\x5a\x3b\x6f\xe6\xcd\xdb\xf7\xc4\x1a\x73\x94\x08\x77\x55\xb9\x81\x3d\x59\xd3\x69\xd3\x28\x7a\xa1\xd6\x29\x3a\x34\xb4\x77\xa6\x6b\x7b\xf8\xf6\x15\x2b\xe4\xf7\x46\x43\x56\xc0\x6a\x8b\x39\xde\x4a\xb0\xdd\xab\xd1\x53\x7d\xde\xd0\x9a\x76\xe4\x48\xab\xda\x41\xb6\x85\xa2\x94\x50\x3c\xe4\x79\x94\x88\x79\x0a\x7f\x86\x86\xc8\xde\xb4\x4f\x3b\x5a\x86\x13\xeb\xaa\xbc\x87\xac\x58\xe3\x23\x9f\xa8\xde\x30\x46\x1f\x12\xbf\x2e\xe8\x1f\x56\x89\xdb\x0a\x53\x89\x20\xd3\xcf\x39\xb2\xc2\x6e\xa7\xc5\x4a\x00\x00\xb8\x5f\x7b\x02\xff\x27\xf1\x51\x0e\x87\x0b\xb3\x78\x28\xec\x5d\x6d\x9d\xd2\xb5\x23\xe5\xcc\x4f\x02\x90\xd9\x06\xb7\x32\xdd\xdc\xb3\x42\xfd\x6c\xeb\xe3\xfb\xa8\x9e\x9a\xae\xd5\x3d\x64\x85\xc4\x2f\x58\x8d\x3a\x36\x96\xea\xb3\x0e\xe7\xd1\x33\x1d\x9b\x1f\xb5\x7d\x22\xb5\xef\xcc\xe9\x7d\x27\x3a\xc2\x1a\xef\xd2\x87\x5c\xc2\xa7\x13\xdb\xee\xb5\x25\xab\x2c\x7d\x9f\x5f\xeb\x02\xb9\xb9\x39\x61\xa8\xd5\xfe\x7e\x53\x7c\x3c\x8c\x88\x12\x91\x15\x5b\xac\xe4\x91\x4e\x39\x16\x18\x56\x83\xf9\xe2\x41\xe2\x98\xeb\x17\x8f\x74\x8a\x3d\x41\x62\xbe\x73\xfc\xbe\x51\x1c\x12\x8d\x84\x6f\xf5\xff\x3d\x4d\x8c\x5d\x7d\xf6\xe3\x84\xcd\x12\x91\xe6\x12\xab\x39\x07\x42\x85\x45\xba\x41\xe0\xe2\xbd\x5b\x77\xca\xe3\x01\xd5\xd3\x9a\x46\x1f\xa0\x2c\xc2\x3a\x58\x8d\x76\x62\x9a\x44\xc9\xe2\x31\x47\xc1\x17\x0e\x09\xdf\x86\xa7\x90\xc7\xfa\x2f\x72\x68\xed\xf3\x8e\x96\x14\x7e\x88\x1c\xd6\x8d\x33\x2f\xa4\x06\xe9\xc3\xde\xc1\x3d\xb5\x9a\xcf\xe6\xf9\x9a\x90\x8e\x25\x6c\x50\xe6\x6a\xa7\xfb\x0c\x2f\xff\xa8\xd5\xd3\xb9\xfa\xb7\x33\xc4\xd4\xd7\x25\xc8\xd3\xe8\xce\x4f\x14\xbb\x64\x99\x1a\x41\x43\xbb\x73\x30\x23\x3d\x98\x9d\xd5\x8c\xec\x1e\x25\x57\xf4\x3c\xaf\xbd\xb0\xb5\x2f\xd3\xe5\xdc\xbb\xda\xba\x28\x11\xbf\x07\x00\xbe\xe8\x65\x0d\x1f\x08\x00\x00")
+
+func _11_event_soft_deleteDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__11_event_soft_deleteDownSql,
+		"11_event_soft_delete.down.sql",
+	)
+}
+
+func _11_event_soft_deleteDownSql() (*asset, error) {
+	bytes, err := _11_event_soft_deleteDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "11_event_soft_delete.down.sql", size: 2079, mode: os.FileMode(0644), modTime: time.Unix(1792299301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xba, 0x7e, 0xa2, 0x7a, 0xda, 0xad, 0x9, 0xaa, 0x3b, 0x23, 0x3b, 0xa, 0x64, 0xc, 0xfd, 0x83, 0xcc, 0x63, 0xde, 0xda, 0x9, 0x15, 0x5c, 0x68, 0x37, 0xb1, 0x33, 0x20, 0xa, 0x63, 0x89, 0x76}}
+	return a, nil
+}
+
+var __11_event_soft_deleteUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xce\xbb\x6e\x84\x30\x10\x85\xe1\x9e\xa7\x38\xe5\xae\x14\x9e\x60\x2b\x67\x71\x81\xc4\x25\x02\x47\x4a\x87\x4c\x3c\xc1\x96\x10\x46\xf6\x04\x25\x6f\x9f\x86\x5c\xec\x2a\xfd\xfc\xdf\x9c\xb2\xc4\xe8\xdf\xb8\x34\xb4\x12\x93\xc1\x25\x50\x64\x1f\xf4\xbc\xd2\x15\x74\xd0\xc6\x11\x56\x1f\x84\xf3\x60\xd2\x8c\x48\xfc\x00\xb6\x3e\x12\x74\x20\x58\x1d\xcc\x4f\x3f\x7f\x82\x2d\x61\x7f\x0f\x0b\x15\x85\x68\x94\x1c\xa0\xc4\x63\x23\x11\xdd\xb6\xac\x34\x9d\xa6\xa8\x2a\xdc\xfb\xe6\xb9\xed\xfe\xca\xaa\x6e\xe5\xa8\x44\xfb\x74\x4b\xd2\x9d\x82\xf3\xc6\xbd\xfe\x3b\x2e\xee\x83\x14\x4a\xa2\xee\x2a\xf9\x92\x7e\x9e\xbe\x0b\x67\x3e\xd0\x77\xd9\xac\xcb\xaf\x77\xbd\xa5\x4a\x36\x22\x77\xf2\x8d\xa9\xf4\x35\x00\x3f\xd6\x08\x58\x67\x01\x00\x00")
+
+func _11_event_soft_deleteUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__11_event_soft_deleteUpSql,
+		"11_event_soft_delete.up.sql",
+	)
+}
+
+func _11_event_soft_deleteUpSql() (*asset, error) {
+	bytes, err := _11_event_soft_deleteUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "11_event_soft_delete.up.sql", size: 359, mode: os.FileMode(0644), modTime: time.Unix(1792299301, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x71, 0xae, 0xc4, 0x3, 0x25, 0xaf, 0x6d, 0x3f, 0x85, 0xf5, 0x36, 0x2a, 0x15, 0x2d, 0x88, 0x85, 0xf1, 0xaa, 0x8e, 0x4f, 0xb4, 0xf9, 0x6f, 0xeb, 0xba, 0xcd, 0xda, 0x63, 0xe1, 0x64, 0xc3, 0xd2}}
+	return a, nil
+}
+
+var __12_event_versionDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x94\x4f\x6f\x9c\x30\x10\xc5\xef\xfe\x14\x73\xcb\x22\xf9\xd2\x33\x27\x9a\x75\x2a\x24\xfe\x44\xac\x23\xe5\x66\x21\x3c\x4d\x2d\x51\xb3\x32\x4e\xd2\x7e\xfb\x2a\xc0\x2e\xd8\x66\xbb\xac\xaa\x56\xdd\x13\x2b\x66\xe6\xcd\xfb\xd9\x8f\xfb\x8a\x25\x9c\x01\x4f\x3e\x67\x0c\x7a\xa5\x5f\x5a\x14\xf8\x86\xda\xf6\xa2\x6b\x25\xd9\x11\x00\x00\xfb\xf3\x88\xb0\xfc\x71\xf6\xcc\x87\x07\x28\x4a\x0e\xc5\x53\x96\xd1\xa1\xb0\xb7\xb5\xb1\x42\xd6\x16\x85\x55\xdf\x11\x80\xa7\x39\x3b\xf0\x24\x7f\xf4\x0a\xe5\xab\xa9\xad\xea\xb4\xe8\xb1\xe9\xb4\xec\x21\x2d\x38\xfb\xc2\xaa\x60\x62\x63\xb0\xb6\x28\x45\x6d\x4f\xd2\x17\x26\x36\xdf\x6a\xf3\x82\xe2\xd8\x29\x6d\x85\x92\xb0\x32\x11\xf6\xec\x21\x79\xca\x38\x7c\x1a\xb7\xed\xde\x35\x1a\x61\xf0\xeb\x34\x7a\xc5\xd6\xb9\xe5\xee\x6e\xec\x41\x2d\x97\xfe\xd6\xf6\x09\x7a\x24\xb6\xb8\xee\x81\x44\x31\x49\x8b\x03\xab\xf8\xc7\xba\x65\x78\x00\xb0\x33\xdd\xbb\x92\x74\x38\x02\xea\xf3\xa5\x01\x47\xba\x00\x46\x7d\x26\x74\x76\x4c\x5d\x23\x74\xb1\x63\x44\x0e\x2c\x63\xf7\x1c\xfe\xb9\x32\x79\xa8\xca\xdc\x65\x10\x93\x7d\x55\x3e\xae\x5d\xcf\x98\x24\x19\x67\xd5\xa5\x9b\x0b\x15\x2b\x92\x9c\x81\x0f\x35\x26\x64\xba\xf2\x69\xb1\x67\xcf\x5e\xa3\xb3\xf6\x08\x5b\xc9\x1f\x50\x16\x6e\x1d\xec\x02\x7f\x1e\x9f\x28\xde\x2c\xf3\x81\x63\xa3\x88\x43\xee\xf7\x12\x27\xaa\xeb\x93\x67\xe6\xd1\x0c\x64\x24\x79\x44\xa3\x3a\xa9\x9a\x3f\xf8\x0a\x18\xf3\xda\xe2\x96\xc2\xff\xe2\x2b\x50\x37\x56\xbd\xa1\x18\x0e\xd0\x9d\xed\xbc\x47\x2d\x7d\xed\xc9\xc4\x99\xe5\xb5\x74\xaf\xa0\xf5\xf2\x3d\x90\xbb\x39\x5b\x4b\x07\xe7\x7f\xa8\xe5\xf5\x54\xff\x3d\xbd\x31\xcb\x9e\x63\x27\xcd\xc1\xbb\x65\x9e\xbd\x97\x5e\xa2\x83\x56\x37\x09\x7e\xb3\x67\x60\x08\x84\x57\x13\x84\x2d\x8a\x6f\x98\x39\x21\xd8\x38\xda\x45\x36\x3d\x0f\xd7\xef\x9a\xe8\x09\xef\x25\xa1\x19\x7f\x14\x93\x5f\x03\x00\x3e\xe5\xe4\xf4\xda\x07\x00\x00")
+
+func _12_event_versionDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__12_event_versionDownSql,
+		"12_event_version.down.sql",
+	)
+}
+
+func _12_event_versionDownSql() (*asset, error) {
+	bytes, err := _12_event_versionDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "12_event_version.down.sql", size: 2010, mode: os.FileMode(0644), modTime: time.Unix(1792299762, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0xa9, 0x6, 0xe2, 0x2d, 0xcf, 0x3f, 0x7c, 0x39, 0x31, 0x3f, 0x4f, 0xc1, 0xa3, 0x36, 0x55, 0x13, 0x1a, 0x36, 0x3e, 0x15, 0x3f, 0x2c, 0xca, 0x88, 0x5e, 0x4f, 0xe6, 0x21, 0xdc, 0x20, 0x9c}}
+	return a, nil
+}
+
+var __12_event_versionUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x8d\x31\x4b\xc5\x30\x14\x85\xf7\xfe\x8a\x33\x2a\xd8\xa1\xb3\x53\xb4\x51\x84\x98\x42\x49\x67\x29\xe9\xa5\x5e\xb0\x37\x25\xb9\xad\xf6\xdf\x4b\xd1\x37\xbc\xf5\x2d\x67\x38\x9c\xf3\x7d\x75\x8d\x6e\x55\x5e\xb8\x28\x47\xc4\x24\x71\xcb\x99\x24\x1e\xd8\x29\x17\x4e\xf2\x00\x96\x98\x69\x21\x51\x9a\x90\x04\xb4\x53\x3e\xce\x14\xc5\xb6\x4e\xa3\x12\xee\xe8\xe7\xfc\xcb\xfc\x57\x17\x14\x1d\xb3\xe2\x9b\xf5\x13\xcd\x7d\x55\x19\x17\x6c\x8f\x60\x9e\x9c\x45\x61\x99\xbf\xe8\xe3\x7f\x68\xda\x16\xcf\x9d\x1b\xde\xfd\xc5\x87\x37\x1f\xec\xab\xed\xe1\xbb\x00\x3f\x38\x87\xd6\xbe\x98\xc1\x05\x34\x8f\x57\xa0\x95\x32\xa7\x89\xe3\x8d\xa8\xdf\x01\x00\xc1\xf1\x57\x43\xf9\x00\x00\x00")
+
+func _12_event_versionUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__12_event_versionUpSql,
+		"12_event_version.up.sql",
+	)
+}
+
+func _12_event_versionUpSql() (*asset, error) {
+	bytes, err := _12_event_versionUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "12_event_version.up.sql", size: 249, mode: os.FileMode(0644), modTime: time.Unix(1792299762, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd8, 0x26, 0xc2, 0x13, 0x9d, 0x8, 0x5f, 0xdc, 0xa7, 0x72, 0xd8, 0xa7, 0x8f, 0xc6, 0x22, 0x83, 0x58, 0xfb, 0x1e, 0xc6, 0x84, 0x4e, 0x51, 0x27, 0x2f, 0xa4, 0x3c, 0x97, 0xff, 0x54, 0x77, 0xed}}
+	return a, nil
+}
+
+var __13_event_autoincrementDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x94\x3b\x6f\xdb\x30\x10\xc7\x77\x7e\x8a\xdb\x62\x01\x5c\x3a\x6b\x52\x63\xa6\x30\x20\x4b\x81\xcc\x00\xd9\x08\x41\xbc\xa6\x04\x5c\xca\xa0\x18\xa7\xfd\xf6\x45\x24\xda\x16\x1f\x4e\xed\x16\x88\x3d\xc9\xe0\xbd\xfe\xbf\x7b\xdc\x37\xac\xe0\x0c\x78\xf1\xb5\x64\x30\x28\xfd\xb2\x45\x81\x7b\xd4\x76\x10\xfd\x56\x92\x05\x01\x00\xb0\xbf\x77\x08\xf3\x1f\x67\xcf\x7c\xfc\x80\xaa\xe6\x50\x3d\x95\x25\x1d\x0d\x07\xdb\x1a\x2b\x64\x6b\x51\x58\xf5\x13\x01\xf8\x6a\xcd\x36\xbc\x58\x3f\x06\x86\xf2\xd5\xb4\x56\xf5\x5a\x0c\xd8\xf5\x5a\x0e\xb0\xaa\x38\xfb\xc6\x9a\x28\x62\x67\xb0\xb5\x28\x45\x6b\x0f\xa9\xcf\x44\xec\x7e\xb4\xe6\x05\xc5\xae\x57\xda\x0a\x25\x21\x11\x11\x96\xec\xa1\x78\x2a\x39\x7c\x99\xaa\xed\xdf\x34\x1a\x61\xf0\xbb\x0b\x9d\x90\x75\x74\xb9\xbb\x9b\x7c\x50\xcb\xb9\xbe\x54\x3d\x91\x8f\xc4\x2d\xa6\x35\x4c\x31\xf7\x68\x06\xd5\x6b\xf7\x08\x1f\x97\x4e\xb2\x9c\xac\xaa\x0d\x6b\xf8\xbb\x59\x1d\xf7\x0c\x16\xa6\x7f\x53\x92\x8e\x5d\xa3\x61\x4b\x68\x84\x9e\xce\x18\xd3\x10\x23\x3d\x41\xa2\xbe\x76\x3a\x93\x45\x0f\x12\x32\xb2\x61\x25\xbb\xe7\x70\xbb\x12\xc8\x43\x53\xaf\x7d\x2a\x39\x59\x36\xf5\x63\x6a\xc6\x73\x52\x94\x9c\x35\xe7\xc6\x1f\x1a\x56\x15\x6b\x06\x21\xe6\x9c\x10\xb7\x37\xab\x6a\xc9\x9e\x03\x47\xaf\xfe\x09\xbf\x92\xbf\xa0\xae\x7c\x3b\x58\x44\x42\x03\x50\x59\x7e\x71\x9a\x77\x2e\x17\x26\xf1\x10\x7e\x9c\xe2\x80\x37\x1d\xf9\x04\x3f\x3b\x01\x99\x48\xee\xd0\xa8\x5e\xaa\xee\x3f\x4e\x89\x31\xaf\x5b\xbc\xc4\x30\x1c\xa6\x9b\x9c\x92\xb6\xb3\x6a\x8f\x62\x6c\x60\x6a\xc5\xdd\x3b\x6a\x19\xe6\x76\x22\x8e\x2c\xd3\xef\x6e\xb8\xff\xed\x44\x24\xba\x11\x1c\x89\x11\xf6\xd5\x7b\x39\x17\x7d\xfc\x87\x5a\x5e\x71\x1a\x3e\x21\xf1\x74\x10\x02\x06\xde\x49\x88\xde\xe6\x47\x21\x78\x0c\xce\x42\xe4\xea\xaf\x53\xe8\x1c\x28\x19\xb7\x2a\xb0\x89\x36\x36\xcb\xaf\x88\xe9\x58\x5c\x18\xda\x67\xe7\xbe\xc7\x19\xfe\x5b\xd2\x03\xe7\x73\x89\x4e\x7d\xc8\x72\xf2\x67\x00\xec\x5b\x0f\xb7\x64\x08\x00\x00")
+
+func _13_event_autoincrementDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__13_event_autoincrementDownSql,
+		"13_event_autoincrement.down.sql",
+	)
+}
+
+func _13_event_autoincrementDownSql() (*asset, error) {
+	bytes, err := _13_event_autoincrementDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "13_event_autoincrement.down.sql", size: 2148, mode: os.FileMode(0644), modTime: time.Unix(1792300987, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0x4e, 0xfe, 0x61, 0xff, 0x9c, 0x71, 0x37, 0xea, 0xa0, 0x18, 0x80, 0xa0, 0xe2, 0x4, 0xa1, 0xa5, 0x1f, 0xd5, 0x7f, 0xaa, 0x15, 0xdb, 0xc0, 0xc5, 0x99, 0x61, 0x40, 0x2a, 0xff, 0x71, 0x4f}}
+	return a, nil
+}
+
+var __13_event_autoincrementUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x4d\x73\xe2\x38\x10\xbd\xfb\x57\xf4\x0d\x5c\x6b\x52\x95\xeb\x72\xf2\x06\x65\xd7\xb5\x60\x52\xc6\xa9\x4d\x4e\x2e\x2d\x6a\x40\x15\x23\x13\x49\x40\xf8\xf7\x53\x92\x3f\xc0\xb2\x33\x03\x35\x53\x13\x38\x80\xdd\xdd\xaf\xbb\x5f\x77\xbf\xd1\x08\xc8\x01\x85\x86\x68\xa2\x80\x4a\x04\x81\x07\x94\x20\x71\xaf\x90\xc1\x50\x6f\x10\xd0\xda\x37\x5c\xe9\x42\x9e\x80\x2b\x78\xc3\x13\x32\xf8\xff\x04\x7a\x83\x5b\xff\x4f\x08\x9f\xd3\x79\x14\x3f\x24\x64\x46\xe2\x14\xb8\x50\x1a\x29\x83\x62\x65\xec\xb0\xa5\x1f\x43\x59\x1c\x39\xf3\xe1\x0f\xb8\x07\x86\x2b\xba\xcf\xf5\x9d\x37\x1a\x41\xba\x41\x58\x16\xf9\x7e\x2b\x0c\xaa\xa0\x5b\x64\x60\x5d\x81\x2a\x1b\x6b\x32\xd2\x35\x02\x65\x4c\xa2\x52\xa8\xca\x5a\x94\xc9\xcd\x35\x0c\xa3\x38\x25\x7f\x93\x04\x9e\x92\x68\x16\x26\xaf\xf0\x2f\x79\x35\x48\x26\xb4\xc2\xc9\x39\x55\xbe\x4d\xb6\xc0\xf7\x3d\x8a\x25\x2a\x50\x9a\x4a\x0d\x74\xa5\x51\x42\xd3\x9f\xb2\x04\xbc\x89\xe2\x28\xaa\xce\xea\x8e\x03\x50\x85\x35\x16\xab\xda\x75\xb7\x97\x6b\xc3\x00\xae\x0a\x89\x25\x6b\x85\xae\x39\xa3\x0a\x8e\x98\xe7\x77\x9e\xf7\x90\x90\x30\x25\x90\x86\x7f\x4d\x09\x28\x2e\xd6\x39\x66\x25\x42\x26\xf0\xe8\x0d\x3d\x00\xa8\x0a\xbd\xf8\xf4\x35\xd5\x62\x38\xb0\x71\xfa\xb4\xc3\x3a\xc4\x7e\x53\xf2\x92\x9a\x5f\x80\x78\x9e\x42\xfc\x3c\x9d\x96\x8e\xb6\xdd\x8c\x51\x8d\x99\xe6\x5b\x04\x48\xa3\x19\x59\xa4\xe1\xec\xc9\x71\x64\x7b\x49\x35\x2f\x44\xa6\x70\x59\x08\xa6\x9a\x4a\x5c\xc4\xa5\x44\xaa\x91\x65\x54\xd7\xa9\x3f\x41\x5c\x6e\xa8\x5c\x63\xb6\x2b\xb8\xd0\x19\x67\xe7\xde\xce\x88\x30\x21\x8f\xe1\xf3\x34\x85\xfb\x12\xbb\x38\x0a\x94\x99\xc4\x55\x05\xdd\xd3\x56\x13\x32\x18\x94\x31\x28\xd8\x65\x7f\x7d\xf5\x74\x62\x18\xe6\xd8\xdf\x43\x89\x79\x40\xa9\x78\x21\xba\x63\xe9\x2b\xdd\xf3\xc7\x5e\x14\x2f\x48\x92\x1a\xce\xe6\xa0\xde\x73\xae\x31\x53\xd5\xca\xc1\xd0\xec\x76\x00\x0a\xdf\x7d\x6f\x41\xa6\xe4\x21\x85\x41\x67\x1f\x06\xd6\xc1\x7b\x4c\xe6\x33\x18\x56\x5e\xe6\x78\xec\xc6\x64\xe6\x7e\xc2\x85\xf1\x00\xeb\x51\xbe\xad\x8f\xf2\xbf\x7f\x48\x42\x6c\x61\x3b\x94\xbc\x60\x7c\xe9\x7b\xe5\x3b\x13\x10\x2d\x9a\x9a\x9d\x3a\xdd\x1a\xa0\x3c\xd5\xc0\x6e\x57\xe0\xae\x4e\xd0\x59\x91\xe0\x62\x17\x02\x77\xdc\xc1\x79\x98\x41\x7b\x46\xc1\x05\xfd\x41\x4d\x75\x43\xcd\xd7\x95\x50\x92\xdf\x9a\xcc\xd8\x9b\x24\xf3\xa7\xbe\x1b\x1e\x7b\xe1\x34\x25\xc9\x67\xe7\x0d\x09\x89\xc3\x19\x01\x97\xe6\x71\xa3\x0b\x51\x3c\x21\x2f\x4e\x60\xab\xfe\x92\x7e\xce\x3e\x60\x1e\xb7\xfd\x60\xd8\x69\xd4\x21\xca\x1f\x5f\x9d\xc6\xf0\x72\x65\x92\x16\x85\xdf\x4f\x51\xd3\xdb\x8f\x7c\x26\xdf\x3f\x13\x52\x32\x59\x6f\xf0\x17\x48\xa5\x94\xfb\x1c\xaf\x71\x74\x97\xf0\x4b\xa4\x92\x2e\x35\x3f\x60\x66\x07\xdf\x27\x61\x95\x1d\x05\x73\x73\x57\x4d\x34\x33\xe8\xb7\x57\x47\xd1\xa5\xfb\x57\x49\x60\xcf\xa4\x7f\x5e\x04\x6f\x12\xc0\x9e\x0a\x1c\x09\xb4\x2b\x71\xb3\xea\x5c\x8e\xa6\x79\x42\xc1\x6e\x10\xbe\xdf\x90\xb8\xa4\xd9\xe1\xa0\x25\x78\x1d\xdb\xa5\xe4\x39\x46\x47\xf4\x3a\xa1\x6d\xb1\x70\x83\x9d\x4e\xac\x66\x38\x3e\x1d\x3d\xf2\xc7\x37\x60\x56\x5c\x5c\x09\xdd\xe6\xae\xfa\x6f\x2f\xed\x47\x49\x6b\x9e\x3f\x4b\x74\x9e\x83\x3f\xf6\xbe\x0d\x00\x5c\xd1\x19\xa7\x7e\x0b\x00\x00")
+
+func _13_event_autoincrementUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__13_event_autoincrementUpSql,
+		"13_event_autoincrement.up.sql",
+	)
+}
+
+func _13_event_autoincrementUpSql() (*asset, error) {
+	bytes, err := _13_event_autoincrementUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "13_event_autoincrement.up.sql", size: 2942, mode: os.FileMode(0644), modTime: time.Unix(1792300987, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd7, 0xaf, 0x48, 0xbc, 0x2d, 0x18, 0xb2, 0x7a, 0x5b, 0x66, 0xe3, 0xfb, 0xeb, 0x34, 0x15, 0x19, 0xe0, 0xfe, 0xff, 0x8c, 0x3f, 0xee, 0xcf, 0x73, 0xfc, 0x87, 0xb8, 0xdd, 0x78, 0x20, 0xb4, 0x82}}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -201,8 +705,32 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"01_initial.down.sql": _01_initialDownSql,
-	"01_initial.up.sql":   _01_initialUpSql,
+	"01_initial.down.sql":                     _01_initialDownSql,
+	"01_initial.up.sql":                       _01_initialUpSql,
+	"02_charge_points.down.sql":               _02_charge_pointsDownSql,
+	"02_charge_points.up.sql":                 _02_charge_pointsUpSql,
+	"03_owner_ref.down.sql":                   _03_owner_refDownSql,
+	"03_owner_ref.up.sql":                     _03_owner_refUpSql,
+	"04_periodic_event_exceptions.down.sql":   _04_periodic_event_exceptionsDownSql,
+	"04_periodic_event_exceptions.up.sql":     _04_periodic_event_exceptionsUpSql,
+	"05_charge_point_time_zone.down.sql":      _05_charge_point_time_zoneDownSql,
+	"05_charge_point_time_zone.up.sql":        _05_charge_point_time_zoneUpSql,
+	"06_event_duration.down.sql":              _06_event_durationDownSql,
+	"06_event_duration.up.sql":                _06_event_durationUpSql,
+	"07_charge_point_max_power.down.sql":      _07_charge_point_max_powerDownSql,
+	"07_charge_point_max_power.up.sql":        _07_charge_point_max_powerUpSql,
+	"08_single_event_end.down.sql":            _08_single_event_endDownSql,
+	"08_single_event_end.up.sql":              _08_single_event_endUpSql,
+	"09_periodic_event_active_range.down.sql": _09_periodic_event_active_rangeDownSql,
+	"09_periodic_event_active_range.up.sql":   _09_periodic_event_active_rangeUpSql,
+	"10_event_history.down.sql":               _10_event_historyDownSql,
+	"10_event_history.up.sql":                 _10_event_historyUpSql,
+	"11_event_soft_delete.down.sql":           _11_event_soft_deleteDownSql,
+	"11_event_soft_delete.up.sql":             _11_event_soft_deleteUpSql,
+	"12_event_version.down.sql":               _12_event_versionDownSql,
+	"12_event_version.up.sql":                 _12_event_versionUpSql,
+	"13_event_autoincrement.down.sql":         _13_event_autoincrementDownSql,
+	"13_event_autoincrement.up.sql":           _13_event_autoincrementUpSql,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -251,6 +779,30 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"01_initial.down.sql": {_01_initialDownSql, map[string]*bintree{}},
 	"01_initial.up.sql": {_01_initialUpSql, map[string]*bintree{}},
+	"02_charge_points.down.sql": {_02_charge_pointsDownSql, map[string]*bintree{}},
+	"02_charge_points.up.sql": {_02_charge_pointsUpSql, map[string]*bintree{}},
+	"03_owner_ref.down.sql": {_03_owner_refDownSql, map[string]*bintree{}},
+	"03_owner_ref.up.sql": {_03_owner_refUpSql, map[string]*bintree{}},
+	"04_periodic_event_exceptions.down.sql": {_04_periodic_event_exceptionsDownSql, map[string]*bintree{}},
+	"04_periodic_event_exceptions.up.sql": {_04_periodic_event_exceptionsUpSql, map[string]*bintree{}},
+	"05_charge_point_time_zone.down.sql": {_05_charge_point_time_zoneDownSql, map[string]*bintree{}},
+	"05_charge_point_time_zone.up.sql": {_05_charge_point_time_zoneUpSql, map[string]*bintree{}},
+	"06_event_duration.down.sql": {_06_event_durationDownSql, map[string]*bintree{}},
+	"06_event_duration.up.sql": {_06_event_durationUpSql, map[string]*bintree{}},
+	"07_charge_point_max_power.down.sql": {_07_charge_point_max_powerDownSql, map[string]*bintree{}},
+	"07_charge_point_max_power.up.sql": {_07_charge_point_max_powerUpSql, map[string]*bintree{}},
+	"08_single_event_end.down.sql": {_08_single_event_endDownSql, map[string]*bintree{}},
+	"08_single_event_end.up.sql": {_08_single_event_endUpSql, map[string]*bintree{}},
+	"09_periodic_event_active_range.down.sql": {_09_periodic_event_active_rangeDownSql, map[string]*bintree{}},
+	"09_periodic_event_active_range.up.sql": {_09_periodic_event_active_rangeUpSql, map[string]*bintree{}},
+	"10_event_history.down.sql": {_10_event_historyDownSql, map[string]*bintree{}},
+	"10_event_history.up.sql": {_10_event_historyUpSql, map[string]*bintree{}},
+	"11_event_soft_delete.down.sql": {_11_event_soft_deleteDownSql, map[string]*bintree{}},
+	"11_event_soft_delete.up.sql": {_11_event_soft_deleteUpSql, map[string]*bintree{}},
+	"12_event_version.down.sql": {_12_event_versionDownSql, map[string]*bintree{}},
+	"12_event_version.up.sql": {_12_event_versionUpSql, map[string]*bintree{}},
+	"13_event_autoincrement.down.sql": {_13_event_autoincrementDownSql, map[string]*bintree{}},
+	"13_event_autoincrement.up.sql": {_13_event_autoincrementUpSql, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
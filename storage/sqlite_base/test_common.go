@@ -7,8 +7,13 @@ import (
 	"github.com/rs/zerolog"
 )
 
+// TempSQLiteBasePath returns the DB file path used by SetupTempSQLiteBase.
+func TempSQLiteBasePath(tmpDir string) string {
+	return path.Join(tmpDir, "sqlite.db")
+}
+
 func SetupTempSQLiteBase(tmpDir string) (retStorage *SQLiteBase, retErr error) {
-	storage, err := NewSQLiteBase(zerolog.Nop(), path.Join(tmpDir, "sqlite.db"))
+	storage, err := NewSQLiteBase(zerolog.Nop(), TempSQLiteBasePath(tmpDir))
 	if err != nil {
 		retErr = fmt.Errorf("NewSQLiteBase: %w", err)
 		return